@@ -25,6 +25,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 
+	"github.com/evmos/ethermint/app/mempool"
 	ethermint "github.com/evmos/ethermint/types"
 	"github.com/evmos/ethermint/x/evm/keeper"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
@@ -95,6 +96,19 @@ func (avd EthAccountVerificationDecorator) AnteHandle(
 		}
 
 		balance := avd.evmKeeper.GetBalance(ctx, sdk.AccAddress(fromAddr.Bytes()), avd.evmDenom)
+
+		// the fees of a sponsored tx are paid by the granter, the sender only covers the value
+		if _, sponsored := avd.evmKeeper.GetFeePayerTransient(ctx, common.HexToHash(msgEthTx.Hash)); sponsored {
+			value := txData.GetValue()
+			if value != nil && balance.Cmp(value) < 0 {
+				return ctx, errorsmod.Wrapf(
+					errortypes.ErrInsufficientFunds,
+					"sender balance < tx value (%s < %s)", balance, value,
+				)
+			}
+			continue
+		}
+
 		if err := keeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(balance), txData); err != nil {
 			return ctx, errorsmod.Wrap(err, "failed to check sender balance")
 		}
@@ -195,17 +209,21 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 			return ctx, errorsmod.Wrapf(err, "failed to verify the fees")
 		}
 
-		err = egcd.evmKeeper.DeductTxCostsFromUserBalance(ctx, fees, common.BytesToAddress(msgEthTx.From))
+		feePayer, sponsored := egcd.evmKeeper.GetFeePayerTransient(ctx, common.HexToHash(msgEthTx.Hash))
+		if !sponsored {
+			feePayer = common.BytesToAddress(msgEthTx.From)
+		}
+
+		err = egcd.evmKeeper.DeductTxCostsFromUserBalance(ctx, fees, feePayer)
 		if err != nil {
 			return ctx, errorsmod.Wrapf(err, "failed to deduct transaction costs from user balance")
 		}
 
-		events = append(events,
-			sdk.NewEvent(
-				sdk.EventTypeTx,
-				sdk.NewAttribute(sdk.AttributeKeyFee, fees.String()),
-			),
-		)
+		attrs := []sdk.Attribute{sdk.NewAttribute(sdk.AttributeKeyFee, fees.String())}
+		if sponsored {
+			attrs = append(attrs, sdk.NewAttribute(sdk.AttributeKeyFeePayer, sdk.AccAddress(feePayer.Bytes()).String()))
+		}
+		events = append(events, sdk.NewEvent(sdk.EventTypeTx, attrs...))
 	}
 
 	ctx.EventManager().EmitEvents(events)
@@ -310,21 +328,29 @@ func canTransfer(ctx sdk.Context, evmKeeper EVMKeeper, denom string, from common
 
 // EthIncrementSenderSequenceDecorator increments the sequence of the signers.
 type EthIncrementSenderSequenceDecorator struct {
-	ak evmtypes.AccountKeeper
+	ak     evmtypes.AccountKeeper
+	txPool TxPool
 }
 
-// NewEthIncrementSenderSequenceDecorator creates a new EthIncrementSenderSequenceDecorator.
-func NewEthIncrementSenderSequenceDecorator(ak evmtypes.AccountKeeper) EthIncrementSenderSequenceDecorator {
+// NewEthIncrementSenderSequenceDecorator creates a new EthIncrementSenderSequenceDecorator, the
+// app-side mempool is optional.
+func NewEthIncrementSenderSequenceDecorator(ak evmtypes.AccountKeeper, txPool TxPool) EthIncrementSenderSequenceDecorator {
 	return EthIncrementSenderSequenceDecorator{
-		ak: ak,
+		ak:     ak,
+		txPool: txPool,
 	}
 }
 
 // AnteHandle handles incrementing the sequence of the signer (i.e sender). If the transaction is a
 // contract creation, the nonce will be incremented during the transaction execution and not within
 // this AnteHandler decorator.
+//
+// In CheckTx with an app-side mempool, the transactions with a higher nonce are accepted without
+// incrementing the sequence and queued in the mempool until the gap is filled, within the queued
+// limits and the max nonce gap of the mempool, and those with a lower nonce are accepted if they
+// replace a pending transaction.
 func (issd EthIncrementSenderSequenceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
-	for _, msg := range tx.GetMsgs() {
+	for i, msg := range tx.GetMsgs() {
 		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
 		if !ok {
 			return ctx, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid message type %T, expected %T", msg, (*evmtypes.MsgEthereumTx)(nil))
@@ -344,9 +370,26 @@ func (issd EthIncrementSenderSequenceDecorator) AnteHandle(ctx sdk.Context, tx s
 			)
 		}
 		nonce := acc.GetSequence()
+		if i == 0 && ctx.IsCheckTx() && issd.txPool != nil {
+			// the mempool orders the tx by the nonce of its sender before this increment
+			ctx = mempool.WithAccountNonce(ctx, nonce)
+		}
 
 		// we merged the nonce verification to nonce increment, so when tx includes multiple messages
 		// with same sender, they'll be accepted.
+		if txData.GetNonce() != nonce && ctx.IsCheckTx() && issd.txPool != nil {
+			if txData.GetNonce() > nonce {
+				continue
+			}
+			if err := issd.txPool.CanReplace(msgEthTx); err != nil {
+				return ctx, errorsmod.Wrapf(
+					errortypes.ErrInvalidSequence,
+					"invalid nonce; got %d, expected %d: %s", txData.GetNonce(), nonce, err,
+				)
+			}
+			continue
+		}
+
 		if txData.GetNonce() != nonce {
 			return ctx, errorsmod.Wrapf(
 				errortypes.ErrInvalidSequence,
@@ -0,0 +1,187 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package ante
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/evmos/ethermint/x/evm/keeper"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// maxFeeAllowancesPerSender is the maximum number of fee allowances of the sender checked to find
+// the granter paying the fees of a tx.
+const maxFeeAllowancesPerSender = 16
+
+// EthFeeGrantDecorator selects the granter paying the fees of the ethereum txs among the fee
+// allowances granted to their sender.
+type EthFeeGrantDecorator struct {
+	feegrantKeeper EVMFeegrantKeeper
+	evmKeeper      EVMKeeper
+	ethCfg         *params.ChainConfig
+	evmDenom       string
+	baseFee        *big.Int
+}
+
+// NewEthFeeGrantDecorator creates a new EthFeeGrantDecorator, the txs are not sponsored if the
+// feegrant keeper is nil.
+func NewEthFeeGrantDecorator(
+	feegrantKeeper EVMFeegrantKeeper,
+	evmKeeper EVMKeeper,
+	ethCfg *params.ChainConfig,
+	evmDenom string,
+	baseFee *big.Int,
+) EthFeeGrantDecorator {
+	return EthFeeGrantDecorator{
+		feegrantKeeper: feegrantKeeper,
+		evmKeeper:      evmKeeper,
+		ethCfg:         ethCfg,
+		evmDenom:       evmDenom,
+		baseFee:        baseFee,
+	}
+}
+
+// AnteHandle uses the first EVM scoped fee allowance of the sender accepting the fees of the tx,
+// whose granter has enough balance to pay them. The granter is recorded as the fee payer of the tx,
+// the fees are deducted from and refunded to its account instead of the sender's one. The sender
+// only needs to cover the value of the tx.
+//
+// The allowances are used on a cached context, so the fees of all the messages of the tx are
+// accounted for cumulatively and a message is only sponsored if the allowance still accepts its fees
+// after the previous ones. The usage is only persisted for the whole fees during CheckTx, to bound
+// the txs accepted in the mempool. During DeliverTx the allowance is charged for the gas used once
+// the tx is executed, see the evm keeper ApplyTransaction.
+func (fgd EthFeeGrantDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if fgd.feegrantKeeper == nil {
+		return next(ctx, tx, simulate)
+	}
+
+	blockHeight := big.NewInt(ctx.BlockHeight())
+	homestead := fgd.ethCfg.IsHomestead(blockHeight)
+	istanbul := fgd.ethCfg.IsIstanbul(blockHeight)
+	shanghai := fgd.ethCfg.IsShanghai(uint64(ctx.BlockHeader().Time.Unix()))
+
+	cacheCtx, write := ctx.CacheContext()
+	for _, msg := range tx.GetMsgs() {
+		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			return ctx, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid message type %T, expected %T", msg, (*evmtypes.MsgEthereumTx)(nil))
+		}
+
+		txData, err := evmtypes.UnpackTxData(msgEthTx.Data)
+		if err != nil {
+			return ctx, errorsmod.Wrap(err, "failed to unpack tx data")
+		}
+
+		fees, err := keeper.VerifyFee(txData, fgd.evmDenom, fgd.baseFee, homestead, istanbul, shanghai, ctx.IsCheckTx())
+		if err != nil {
+			return ctx, errorsmod.Wrapf(err, "failed to verify the fees")
+		}
+		if fees.IsZero() {
+			continue
+		}
+
+		grantee := msgEthTx.GetFrom()
+		granter, err := fgd.findGranter(cacheCtx, grantee, fees, msg)
+		if err != nil {
+			return ctx, errorsmod.Wrapf(err, "failed to get the fee allowances of %s", grantee)
+		}
+		if granter == nil {
+			continue
+		}
+
+		if err := fgd.feegrantKeeper.UseGrantedFees(cacheCtx, granter, grantee, fees, []sdk.Msg{msg}); err != nil {
+			return ctx, errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", granter, grantee)
+		}
+
+		fgd.evmKeeper.SetFeePayerTransient(ctx, common.HexToHash(msgEthTx.Hash), common.BytesToAddress(granter))
+	}
+
+	if ctx.IsCheckTx() && !ctx.IsReCheckTx() {
+		write()
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// findGranter returns the granter of the first EVM scoped allowance accepting the fees, nil if none
+// is found.
+func (fgd EthFeeGrantDecorator) findGranter(
+	ctx sdk.Context,
+	grantee sdk.AccAddress,
+	fees sdk.Coins,
+	msg sdk.Msg,
+) (sdk.AccAddress, error) {
+	res, err := fgd.feegrantKeeper.Allowances(ctx, &feegrant.QueryAllowancesRequest{
+		Grantee:    grantee.String(),
+		Pagination: &query.PageRequest{Limit: maxFeeAllowancesPerSender},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, grant := range res.Allowances {
+		allowance, err := grant.GetGrant()
+		if err != nil || !isEVMAllowance(allowance) {
+			continue
+		}
+
+		// the allowance is a copy, it is only updated in store when used
+		if _, err := allowance.Accept(ctx, fees, []sdk.Msg{msg}); err != nil {
+			continue
+		}
+
+		granter, err := sdk.AccAddressFromBech32(grant.Granter)
+		if err != nil {
+			continue
+		}
+
+		balance := fgd.evmKeeper.GetBalance(ctx, granter, fgd.evmDenom)
+		if balance.Cmp(fees.AmountOf(fgd.evmDenom).BigInt()) < 0 {
+			continue
+		}
+
+		return granter, nil
+	}
+
+	return nil, nil
+}
+
+// isEVMAllowance returns true if the allowance is explicitly granted to pay the fees of the
+// ethereum txs, the generic allowances granted to the cosmos txs of the sender are not used.
+func isEVMAllowance(allowance feegrant.FeeAllowanceI) bool {
+	switch a := allowance.(type) {
+	case *evmtypes.AllowedContractAllowance:
+		return true
+	case *feegrant.AllowedMsgAllowance:
+		msgTypeURL := sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{})
+		for _, allowed := range a.AllowedMessages {
+			if allowed == msgTypeURL {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,237 @@
+package ante_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/ethermint/app/ante"
+	"github.com/evmos/ethermint/server/config"
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+func (suite *AnteTestSuite) TestEthFeeGrantDecorator() {
+	evmParams := suite.app.EvmKeeper.GetParams(suite.ctx)
+	chainID := suite.app.EvmKeeper.ChainID()
+	chainCfg := evmParams.GetChainConfig()
+	ethCfg := chainCfg.EthereumConfig(chainID)
+	baseFee := suite.app.EvmKeeper.GetBaseFee(suite.ctx, ethCfg)
+
+	anteHandler := sdk.ChainAnteDecorators(
+		ante.NewEthFeeGrantDecorator(suite.app.FeeGrantKeeper, suite.app.EvmKeeper, ethCfg, evmtypes.DefaultEVMDenom, baseFee),
+		ante.NewEthAccountVerificationDecorator(suite.app.AccountKeeper, suite.app.EvmKeeper, evmtypes.DefaultEVMDenom),
+		ante.NewEthGasConsumeDecorator(suite.app.EvmKeeper, config.DefaultMaxTxGasWanted, ethCfg, evmtypes.DefaultEVMDenom, baseFee),
+	)
+
+	gasLimit := uint64(100000)
+	fees := new(big.Int).Mul(baseFee, new(big.Int).SetUint64(gasLimit))
+	feeCoins := sdk.NewCoins(sdk.NewCoin(evmtypes.DefaultEVMDenom, sdk.NewIntFromBigInt(fees)))
+
+	var (
+		vmdb     *statedb.StateDB
+		sender   common.Address
+		granter  common.Address
+		contract common.Address
+	)
+
+	grant := func(allowance feegrant.FeeAllowanceI) {
+		err := suite.app.FeeGrantKeeper.GrantAllowance(suite.ctx, granter.Bytes(), sender.Bytes(), allowance)
+		suite.Require().NoError(err)
+	}
+
+	testCases := []struct {
+		name         string
+		malleate     func()
+		expPass      bool
+		expSponsored bool
+	}{
+		{
+			"no allowance, sender pays",
+			func() {
+				vmdb.AddBalance(sender, fees)
+			},
+			true, false,
+		},
+		{
+			"no allowance, sender can't pay",
+			func() {},
+			false, false,
+		},
+		{
+			"basic allowance, sender pays",
+			func() {
+				vmdb.AddBalance(granter, fees)
+				vmdb.AddBalance(sender, fees)
+				grant(&feegrant.BasicAllowance{SpendLimit: feeCoins})
+			},
+			true, false,
+		},
+		{
+			"allowance restricted to the ethereum txs, granter pays",
+			func() {
+				vmdb.AddBalance(granter, fees)
+				allowance, err := feegrant.NewAllowedMsgAllowance(&feegrant.BasicAllowance{SpendLimit: feeCoins}, []string{sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{})})
+				suite.Require().NoError(err)
+				grant(allowance)
+			},
+			true, true,
+		},
+		{
+			"allowance restricted to other messages, sender pays",
+			func() {
+				vmdb.AddBalance(granter, fees)
+				vmdb.AddBalance(sender, fees)
+				allowance, err := feegrant.NewAllowedMsgAllowance(&feegrant.BasicAllowance{SpendLimit: feeCoins}, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})})
+				suite.Require().NoError(err)
+				grant(allowance)
+			},
+			true, false,
+		},
+		{
+			"allowance restricted to the contract, granter pays",
+			func() {
+				vmdb.AddBalance(granter, fees)
+				allowance, err := evmtypes.NewAllowedContractAllowance(&feegrant.BasicAllowance{}, []common.Address{contract})
+				suite.Require().NoError(err)
+				grant(allowance)
+			},
+			true, true,
+		},
+		{
+			"allowance restricted to another contract, sender pays",
+			func() {
+				vmdb.AddBalance(granter, fees)
+				vmdb.AddBalance(sender, fees)
+				allowance, err := evmtypes.NewAllowedContractAllowance(&feegrant.BasicAllowance{}, []common.Address{tests.GenerateAddress()})
+				suite.Require().NoError(err)
+				grant(allowance)
+			},
+			true, false,
+		},
+		{
+			"spend limit too low, sender pays",
+			func() {
+				vmdb.AddBalance(granter, fees)
+				vmdb.AddBalance(sender, fees)
+				allowance, err := evmtypes.NewAllowedContractAllowance(&feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin(evmtypes.DefaultEVMDenom, 1))}, []common.Address{contract})
+				suite.Require().NoError(err)
+				grant(allowance)
+			},
+			true, false,
+		},
+		{
+			"granter can't pay, sender pays",
+			func() {
+				vmdb.AddBalance(sender, fees)
+				allowance, err := evmtypes.NewAllowedContractAllowance(&feegrant.BasicAllowance{}, []common.Address{contract})
+				suite.Require().NoError(err)
+				grant(allowance)
+			},
+			true, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			sender = tests.GenerateAddress()
+			granter = tests.GenerateAddress()
+			contract = tests.GenerateAddress()
+			vmdb = suite.StateDB()
+			tc.malleate()
+			suite.Require().NoError(vmdb.Commit())
+
+			tx := evmtypes.NewTx(chainID, 0, &contract, nil, gasLimit, baseFee, nil, nil, nil, nil)
+			tx.From = sender.Bytes()
+
+			ctx := suite.ctx.WithIsCheckTx(true).WithGasMeter(sdk.NewInfiniteGasMeter())
+			ctx, err := anteHandler(ctx, tx, false)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			payer, sponsored := suite.app.EvmKeeper.GetFeePayerTransient(ctx, common.HexToHash(tx.Hash))
+			suite.Require().Equal(tc.expSponsored, sponsored)
+			if !sponsored {
+				suite.Require().Equal(int64(0), suite.app.EvmKeeper.GetBalance(ctx, sender.Bytes(), evmtypes.DefaultEVMDenom).Int64())
+				return
+			}
+
+			suite.Require().Equal(granter, payer)
+			suite.Require().Equal(int64(0), suite.app.EvmKeeper.GetBalance(ctx, granter.Bytes(), evmtypes.DefaultEVMDenom).Int64())
+
+			// the allowance is used by the sponsored tx
+			allowance, err := suite.app.FeeGrantKeeper.GetAllowance(ctx, granter.Bytes(), sender.Bytes())
+			if err == nil {
+				basic, ok := allowance.(*feegrant.BasicAllowance)
+				if ok && basic.SpendLimit != nil {
+					suite.Require().True(basic.SpendLimit.IsZero())
+				}
+			}
+		})
+	}
+}
+
+func (suite *AnteTestSuite) TestEthFeeGrantDecoratorMultipleMsgs() {
+	evmParams := suite.app.EvmKeeper.GetParams(suite.ctx)
+	chainID := suite.app.EvmKeeper.ChainID()
+	chainCfg := evmParams.GetChainConfig()
+	ethCfg := chainCfg.EthereumConfig(chainID)
+	baseFee := suite.app.EvmKeeper.GetBaseFee(suite.ctx, ethCfg)
+
+	anteHandler := sdk.ChainAnteDecorators(
+		ante.NewEthFeeGrantDecorator(suite.app.FeeGrantKeeper, suite.app.EvmKeeper, ethCfg, evmtypes.DefaultEVMDenom, baseFee),
+	)
+
+	gasLimit := uint64(100000)
+	fees := new(big.Int).Mul(baseFee, new(big.Int).SetUint64(gasLimit))
+	feeCoins := sdk.NewCoins(sdk.NewCoin(evmtypes.DefaultEVMDenom, sdk.NewIntFromBigInt(fees)))
+
+	for _, checkTx := range []bool{true, false} {
+		sender := tests.GenerateAddress()
+		granter := tests.GenerateAddress()
+		contract := tests.GenerateAddress()
+
+		vmdb := suite.StateDB()
+		vmdb.AddBalance(granter, new(big.Int).Mul(fees, big.NewInt(2)))
+		suite.Require().NoError(vmdb.Commit())
+
+		// the allowance only covers the fees of a single message
+		allowance, err := evmtypes.NewAllowedContractAllowance(&feegrant.BasicAllowance{SpendLimit: feeCoins}, []common.Address{contract})
+		suite.Require().NoError(err)
+		err = suite.app.FeeGrantKeeper.GrantAllowance(suite.ctx, granter.Bytes(), sender.Bytes(), allowance)
+		suite.Require().NoError(err)
+
+		msgs := make([]*evmtypes.MsgEthereumTx, 2)
+		for i := range msgs {
+			msgs[i] = evmtypes.NewTx(chainID, uint64(i), &contract, nil, gasLimit, baseFee, nil, nil, nil, nil)
+			msgs[i].From = sender.Bytes()
+		}
+		tx := suite.CreateTestCosmosTxBuilder(sdk.ZeroInt(), evmtypes.DefaultEVMDenom, msgs[0], msgs[1]).GetTx()
+
+		ctx := suite.ctx.WithIsCheckTx(checkTx).WithGasMeter(sdk.NewInfiniteGasMeter())
+		ctx, err = anteHandler(ctx, tx, false)
+		suite.Require().NoError(err)
+
+		// the second message isn't sponsored as the allowance is used by the first one
+		payer, sponsored := suite.app.EvmKeeper.GetFeePayerTransient(ctx, common.HexToHash(msgs[0].Hash))
+		suite.Require().True(sponsored)
+		suite.Require().Equal(granter, payer)
+		_, sponsored = suite.app.EvmKeeper.GetFeePayerTransient(ctx, common.HexToHash(msgs[1].Hash))
+		suite.Require().False(sponsored, "check tx: %t", checkTx)
+
+		// the allowance is only charged for the whole fees in check tx
+		_, err = suite.app.FeeGrantKeeper.GetAllowance(ctx, granter.Bytes(), sender.Bytes())
+		if checkTx {
+			suite.Require().Error(err)
+		} else {
+			suite.Require().NoError(err)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package ante_test
 
 import (
+	"errors"
 	"math"
 	"math/big"
 
@@ -106,7 +107,7 @@ func (suite *AnteTestSuite) TestNewEthAccountVerificationDecorator() {
 
 func (suite *AnteTestSuite) TestEthNonceVerificationDecorator() {
 	suite.SetupTest()
-	dec := ante.NewEthIncrementSenderSequenceDecorator(suite.app.AccountKeeper)
+	dec := ante.NewEthIncrementSenderSequenceDecorator(suite.app.AccountKeeper, nil)
 
 	addr := tests.GenerateAddress()
 
@@ -408,7 +409,7 @@ func (suite *AnteTestSuite) TestCanTransferDecorator() {
 }
 
 func (suite *AnteTestSuite) TestEthIncrementSenderSequenceDecorator() {
-	dec := ante.NewEthIncrementSenderSequenceDecorator(suite.app.AccountKeeper)
+	dec := ante.NewEthIncrementSenderSequenceDecorator(suite.app.AccountKeeper, nil)
 	addr, privKey := tests.NewAddrKey()
 
 	contract := evmtypes.NewTxContract(suite.app.EvmKeeper.ChainID(), 0, big.NewInt(10), 1000, big.NewInt(1), nil, nil, nil, nil)
@@ -497,3 +498,60 @@ func (suite *AnteTestSuite) TestEthIncrementSenderSequenceDecorator() {
 		})
 	}
 }
+
+type txPoolFn func(*evmtypes.MsgEthereumTx) error
+
+func (fn txPoolFn) CanReplace(msg *evmtypes.MsgEthereumTx) error {
+	return fn(msg)
+}
+
+func (suite *AnteTestSuite) TestEthIncrementSenderSequenceDecoratorTxPool() {
+	replaceErr := errors.New("replacement transaction underpriced")
+	dec := ante.NewEthIncrementSenderSequenceDecorator(suite.app.AccountKeeper, txPoolFn(func(msg *evmtypes.MsgEthereumTx) error {
+		if msg.AsTransaction().GasPrice().Cmp(big.NewInt(2)) < 0 {
+			return replaceErr
+		}
+		return nil
+	}))
+	addr, privKey := tests.NewAddrKey()
+	to := tests.GenerateAddress()
+
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr.Bytes())
+	suite.Require().NoError(acc.SetSequence(1))
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+	newTx := func(nonce uint64, gasPrice int64) *evmtypes.MsgEthereumTx {
+		tx := evmtypes.NewTx(suite.app.EvmKeeper.ChainID(), nonce, &to, big.NewInt(10), 1000, big.NewInt(gasPrice), nil, nil, nil, nil)
+		tx.From = addr.Bytes()
+		suite.Require().NoError(tx.Sign(suite.ethSigner, tests.NewSigner(privKey)))
+		return tx
+	}
+
+	testCases := []struct {
+		name     string
+		tx       sdk.Tx
+		checkTx  bool
+		expPass  bool
+		expNonce uint64
+	}{
+		{"queued nonce gap", newTx(3, 1), true, true, 1},
+		{"replacement", newTx(0, 2), true, true, 1},
+		{"underpriced replacement", newTx(0, 1), true, false, 1},
+		{"nonce gap in deliver tx", newTx(3, 1), false, false, 1},
+		{"replacement in deliver tx", newTx(0, 2), false, false, 1},
+		{"expected nonce", newTx(1, 1), true, true, 2},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx := suite.ctx.WithIsCheckTx(tc.checkTx)
+			_, err := dec.AnteHandle(ctx, tc.tx, false, NextFn)
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+			suite.Require().Equal(tc.expNonce, suite.app.EvmKeeper.GetNonce(ctx, addr))
+		})
+	}
+}
@@ -215,3 +215,55 @@ func (mfd EthMempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulat
 
 	return next(ctx, tx, simulate)
 }
+
+// BurnBaseFeeDecorator burns the base fee portion of the fees paid by the Cosmos txs, the same way the
+// evm keeper does for the ethereum txs. The Cosmos txs are charged for their whole gas limit, so the
+// base fee of the gas limit is burned. It must run after the fees are deducted to the fee collector.
+type BurnBaseFeeDecorator struct {
+	evmKeeper EVMKeeper
+	evmDenom  string
+	baseFee   *big.Int
+}
+
+// NewBurnBaseFeeDecorator creates a new BurnBaseFeeDecorator instance used only for Cosmos
+// transactions.
+func NewBurnBaseFeeDecorator(ek EVMKeeper, evmDenom string, baseFee *big.Int) BurnBaseFeeDecorator {
+	return BurnBaseFeeDecorator{
+		evmKeeper: ek,
+		evmDenom:  evmDenom,
+		baseFee:   baseFee,
+	}
+}
+
+// AnteHandle burns the base fee portion of the deducted fees during DeliverTx, nothing is burned
+// before the London hard fork. The burned price is capped by the gas price of the tx, which can be
+// lower than the base fee when the fees are not checked by the dynamic fee checker.
+func (bfd BurnBaseFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if ctx.IsCheckTx() || bfd.baseFee == nil {
+		return next(ctx, tx, simulate)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrapf(errortypes.ErrInvalidType, "invalid transaction type %T, expected sdk.FeeTx", tx)
+	}
+
+	gas := feeTx.GetGas()
+	if gas == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	baseFee := bfd.baseFee
+	gasPrice := new(big.Int).Quo(feeTx.GetFee().AmountOf(bfd.evmDenom).BigInt(), new(big.Int).SetUint64(gas))
+	if gasPrice.Cmp(baseFee) < 0 {
+		baseFee = gasPrice
+	}
+
+	// the burn is part of the fee payment, its gas isn't charged to the tx
+	infCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	if err := bfd.evmKeeper.BurnBaseFee(infCtx, baseFee, gas, bfd.evmDenom); err != nil {
+		return ctx, errorsmod.Wrap(err, "failed to burn base fee")
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -5,7 +5,9 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/evmos/ethermint/app/ante"
 	"github.com/evmos/ethermint/tests"
@@ -357,3 +359,53 @@ func (s *AnteTestSuite) TestEthMinGasPriceDecorator() {
 func (suite *AnteTestSuite) TestEthMempoolFeeDecorator() {
 	// TODO: add test
 }
+
+func (suite *AnteTestSuite) TestBurnBaseFeeDecorator() {
+	denom := evmtypes.DefaultEVMDenom
+	testMsg := banktypes.MsgSend{
+		FromAddress: "evmos1x8fhpj9nmhqk8z9kpgjt95ck2xwyue0ptzkucp",
+		ToAddress:   "evmos1dx67l23hz9l0k9hcher8xz04uj7wf3yu26l2yn",
+		Amount:      sdk.Coins{sdk.Coin{Amount: sdkmath.NewInt(10), Denom: denom}},
+	}
+	gasLimit := int64(TestGasLimit)
+
+	testCases := []struct {
+		name      string
+		checkTx   bool
+		baseFee   *big.Int
+		gasPrice  int64
+		expBurned int64
+	}{
+		{"check tx, nothing burned", true, big.NewInt(100), 100, 0},
+		{"no base fee, nothing burned", false, nil, 100, 0},
+		{"base fee of the gas limit burned", false, big.NewInt(100), 150, 100 * gasLimit},
+		{"gas price below the base fee burned", false, big.NewInt(100), 50, 50 * gasLimit},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			fmParams := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+			fmParams.BurnBaseFee = true
+			fmParams.BurnRatio = sdk.OneDec()
+			suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(suite.ctx, fmParams))
+
+			fees := sdk.NewCoins(sdk.NewInt64Coin(denom, tc.gasPrice*gasLimit))
+			suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, minttypes.ModuleName, fees))
+			suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToModule(suite.ctx, minttypes.ModuleName, authtypes.FeeCollectorName, fees))
+			feeCollector := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+			balanceBefore := suite.app.BankKeeper.GetBalance(suite.ctx, feeCollector, denom).Amount
+			supplyBefore := suite.app.BankKeeper.GetSupply(suite.ctx, denom).Amount
+
+			tx := suite.CreateTestCosmosTxBuilder(sdkmath.NewInt(tc.gasPrice), denom, &testMsg).GetTx()
+			dec := ante.NewBurnBaseFeeDecorator(suite.app.EvmKeeper, denom, tc.baseFee)
+			_, err := dec.AnteHandle(suite.ctx.WithIsCheckTx(tc.checkTx), tx, false, NextFn)
+			suite.Require().NoError(err)
+
+			burned := sdkmath.NewInt(tc.expBurned)
+			suite.Require().Equal(balanceBefore.Sub(burned).String(), suite.app.BankKeeper.GetBalance(suite.ctx, feeCollector, denom).Amount.String())
+			suite.Require().Equal(supplyBefore.Sub(burned), suite.app.BankKeeper.GetSupply(suite.ctx, denom).Amount)
+		})
+	}
+}
@@ -47,6 +47,8 @@ type HandlerOptions struct {
 	DisabledAuthzMsgs      []string
 	ExtraDecorators        []sdk.AnteDecorator
 	PendingTxListener      PendingTxListener
+	// TxPool is the app-side mempool if enabled, optional.
+	TxPool TxPool
 }
 
 func (options HandlerOptions) validate() error {
@@ -75,16 +77,19 @@ func newEthAnteHandler(ctx sdk.Context, options HandlerOptions, extra ...sdk.Ant
 	chainCfg := evmParams.GetChainConfig()
 	ethCfg := chainCfg.EthereumConfig(chainID)
 	baseFee := options.EvmKeeper.GetBaseFee(ctx, ethCfg)
+	// ethereum txs are only sponsored if the feegrant keeper can list the allowances of the sender
+	feegrantKeeper, _ := options.FeegrantKeeper.(EVMFeegrantKeeper)
 	decorators := []sdk.AnteDecorator{
 		NewEthSetUpContextDecorator(options.EvmKeeper),               // outermost AnteDecorator. SetUpContext must be called first
 		NewEthMempoolFeeDecorator(evmDenom, baseFee),                 // Check eth effective gas price against minimal-gas-prices
 		NewEthMinGasPriceDecorator(options.FeeMarketKeeper, baseFee), // Check eth effective gas price against the global MinGasPrice
 		NewEthValidateBasicDecorator(&evmParams, baseFee),
 		NewEthSigVerificationDecorator(chainID),
+		NewEthFeeGrantDecorator(feegrantKeeper, options.EvmKeeper, ethCfg, evmDenom, baseFee),
 		NewEthAccountVerificationDecorator(options.AccountKeeper, options.EvmKeeper, evmDenom),
 		NewCanTransferDecorator(options.EvmKeeper, baseFee, &evmParams, ethCfg),
 		NewEthGasConsumeDecorator(options.EvmKeeper, options.MaxTxGasWanted, ethCfg, evmDenom, baseFee),
-		NewEthIncrementSenderSequenceDecorator(options.AccountKeeper), // innermost AnteDecorator.
+		NewEthIncrementSenderSequenceDecorator(options.AccountKeeper, options.TxPool), // innermost AnteDecorator.
 		NewGasWantedDecorator(options.FeeMarketKeeper, ethCfg),
 		NewEthEmitEventDecorator(options.EvmKeeper), // emit eth tx hash and index at the very last ante handler.
 	}
@@ -111,6 +116,7 @@ func newCosmosAnteHandler(ctx sdk.Context, options HandlerOptions, extra ...sdk.
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
 		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
+		NewBurnBaseFeeDecorator(options.EvmKeeper, evmDenom, options.EvmKeeper.GetBaseFee(ctx, ethCfg)),
 		// SetPubKeyDecorator must be called before all signature verification decorators
 		ante.NewSetPubKeyDecorator(options.AccountKeeper),
 		ante.NewValidateSigCountDecorator(options.AccountKeeper),
@@ -16,10 +16,13 @@
 package ante
 
 import (
+	"context"
 	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	tx "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/params"
@@ -43,6 +46,22 @@ type EVMKeeper interface {
 	DeductTxCostsFromUserBalance(ctx sdk.Context, fees sdk.Coins, from common.Address) error
 	ResetTransientGasUsed(ctx sdk.Context)
 	GetTxIndexTransient(ctx sdk.Context) uint64
+	GetFeePayerTransient(ctx sdk.Context, txHash common.Hash) (common.Address, bool)
+	SetFeePayerTransient(ctx sdk.Context, txHash common.Hash, payer common.Address)
+	BurnBaseFee(ctx sdk.Context, baseFee *big.Int, gasUsed uint64, denom string) error
+}
+
+// EVMFeegrantKeeper defines the expected feegrant keeper used to pay the fees of the ethereum txs
+// from the allowances granted to their sender.
+type EVMFeegrantKeeper interface {
+	ante.FeegrantKeeper
+	Allowances(c context.Context, req *feegrant.QueryAllowancesRequest) (*feegrant.QueryAllowancesResponse, error)
+}
+
+// TxPool defines the expected app-side mempool accepting the ethereum transactions with a nonce
+// gap or replacing a pending transaction in CheckTx.
+type TxPool interface {
+	CanReplace(msg *evmtypes.MsgEthereumTx) error
 }
 
 type protoTxProvider interface {
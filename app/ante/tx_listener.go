@@ -2,11 +2,11 @@ package ante
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/ethereum/go-ethereum/common"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 )
 
-type PendingTxListener func(common.Hash)
+// PendingTxListener is called with each ethereum transaction accepted in the mempool.
+type PendingTxListener func(*evmtypes.MsgEthereumTx)
 
 type TxListenerDecorator struct {
 	pendingTxListener PendingTxListener
@@ -25,7 +25,7 @@ func (d TxListenerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate boo
 	if ctx.IsCheckTx() && !simulate && d.pendingTxListener != nil {
 		for _, msg := range tx.GetMsgs() {
 			if ethTx, ok := msg.(*evmtypes.MsgEthereumTx); ok {
-				d.pendingTxListener(common.HexToHash(ethTx.Hash))
+				d.pendingTxListener(ethTx)
 			}
 		}
 	}
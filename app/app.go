@@ -123,25 +123,34 @@ import (
 	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
 	ibckeeper "github.com/cosmos/ibc-go/v7/modules/core/keeper"
 	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
+	ibctestingtypes "github.com/cosmos/ibc-go/v7/testing/types"
 
 	"github.com/evmos/ethermint/client/docs"
 
 	"github.com/evmos/ethermint/app/ante"
+	ethmempool "github.com/evmos/ethermint/app/mempool"
 	"github.com/evmos/ethermint/encoding"
 	"github.com/evmos/ethermint/ethereum/eip712"
+	srvconfig "github.com/evmos/ethermint/server/config"
 	srvflags "github.com/evmos/ethermint/server/flags"
 	ethermint "github.com/evmos/ethermint/types"
+	"github.com/evmos/ethermint/x/erc20"
+	erc20keeper "github.com/evmos/ethermint/x/erc20/keeper"
+	erc20types "github.com/evmos/ethermint/x/erc20/types"
 	"github.com/evmos/ethermint/x/evm"
 	evmkeeper "github.com/evmos/ethermint/x/evm/keeper"
 	v0evmtypes "github.com/evmos/ethermint/x/evm/migrations/v0/types"
+	"github.com/evmos/ethermint/x/evm/precompiles"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 	"github.com/evmos/ethermint/x/feemarket"
 	feemarketkeeper "github.com/evmos/ethermint/x/feemarket/keeper"
 	feemarkettypes "github.com/evmos/ethermint/x/feemarket/types"
+	"github.com/evmos/ethermint/x/ibchooks"
 
 	consensusparamkeeper "github.com/cosmos/cosmos-sdk/x/consensus/keeper"
 	consensusparamtypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
-	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	ethparams "github.com/ethereum/go-ethereum/params"
 
 	// Force-load the tracer engines to trigger registration due to Go-Ethereum v1.10.15 changes
 	_ "github.com/ethereum/go-ethereum/eth/tracers/js"
@@ -193,6 +202,7 @@ var (
 		// Ethermint modules
 		evm.AppModuleBasic{},
 		feemarket.AppModuleBasic{},
+		erc20.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -205,6 +215,7 @@ var (
 		govtypes.ModuleName:            {authtypes.Burner},
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
 		evmtypes.ModuleName:            {authtypes.Minter, authtypes.Burner}, // used for secure addition and subtraction of balance using module account
+		erc20types.ModuleName:          {authtypes.Minter, authtypes.Burner},
 	}
 
 	// module accounts that are allowed to receive tokens
@@ -234,6 +245,9 @@ type EthermintApp struct {
 
 	pendingTxListeners []ante.PendingTxListener
 
+	// app-side mempool, nil if disabled
+	evmMempool *ethmempool.EVMMempool
+
 	// keys to access the substores
 	keys    map[string]*storetypes.KVStoreKey
 	tkeys   map[string]*storetypes.TransientStoreKey
@@ -265,6 +279,7 @@ type EthermintApp struct {
 	// Ethermint keepers
 	EvmKeeper       *evmkeeper.Keeper
 	FeeMarketKeeper feemarketkeeper.Keeper
+	Erc20Keeper     erc20keeper.Keeper
 
 	// the module manager
 	mm *module.Manager
@@ -324,7 +339,7 @@ func NewEthermintApp(
 		// ibc keys
 		ibcexported.StoreKey, ibctransfertypes.StoreKey,
 		// ethermint keys
-		evmtypes.StoreKey, feemarkettypes.StoreKey,
+		evmtypes.StoreKey, feemarkettypes.StoreKey, erc20types.StoreKey,
 	)
 
 	// Add the EVM transient store key
@@ -482,12 +497,18 @@ func NewEthermintApp(
 	}
 	app.EvmKeeper = evmkeeper.NewKeeper(
 		appCodec, keys[evmtypes.StoreKey], tkeys[evmtypes.TransientKey], authtypes.NewModuleAddress(govtypes.ModuleName),
-		app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.FeeMarketKeeper,
+		app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.FeeMarketKeeper, app.FeeGrantKeeper,
 		tracer,
-		evmSs, nil,
+		evmSs, app.customContractFns(),
 		allKeys,
 	)
 
+	app.Erc20Keeper = erc20keeper.NewKeeper(
+		appCodec, keys[erc20types.StoreKey], authtypes.NewModuleAddress(govtypes.ModuleName),
+		app.AccountKeeper, app.BankKeeper, app.EvmKeeper,
+	)
+	app.EvmKeeper.SetHooks(evmkeeper.NewMultiEvmHooks(app.Erc20Keeper.Hooks()))
+
 	// Create IBC Keeper
 	app.IBCKeeper = ibckeeper.NewKeeper(
 		appCodec, keys[ibcexported.StoreKey], app.GetSubspace(ibcexported.ModuleName), app.StakingKeeper, app.UpgradeKeeper, scopedIBCKeeper,
@@ -518,18 +539,23 @@ func NewEthermintApp(
 		),
 	)
 
-	// Create Transfer Keepers
+	// Create Transfer Keepers, sending the packets through the ibc hooks middleware
+	hooksICS4Wrapper := ibchooks.NewICS4Middleware(app.IBCKeeper.ChannelKeeper)
 	app.TransferKeeper = ibctransferkeeper.NewKeeper(
 		appCodec, keys[ibctransfertypes.StoreKey], app.GetSubspace(ibctransfertypes.ModuleName),
-		app.IBCKeeper.ChannelKeeper, app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
+		hooksICS4Wrapper, app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
 		app.AccountKeeper, app.BankKeeper, scopedTransferKeeper,
 	)
 	transferModule := transfer.NewAppModule(app.TransferKeeper)
-	transferIBCModule := transfer.NewIBCModule(app.TransferKeeper)
+
+	// The transfer stack executes the EVM calls of the memos of the received packets
+	var transferStack porttypes.IBCModule
+	transferStack = transfer.NewIBCModule(app.TransferKeeper)
+	transferStack = ibchooks.NewIBCMiddleware(transferStack, hooksICS4Wrapper, app.EvmKeeper, app.Erc20Keeper)
 
 	// Create static IBC router, add transfer route, then set and seal it
 	ibcRouter := porttypes.NewRouter()
-	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferIBCModule)
+	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferStack)
 	app.IBCKeeper.SetRouter(ibcRouter)
 
 	// create evidence keeper with router
@@ -583,6 +609,7 @@ func NewEthermintApp(
 		// Ethermint app modules
 		feemarket.NewAppModule(app.FeeMarketKeeper, feeMarketSs),
 		evm.NewAppModule(app.EvmKeeper, app.AccountKeeper, evmSs),
+		erc20.NewAppModule(app.Erc20Keeper, app.AccountKeeper),
 	)
 
 	app.mm.SetOrderPreBlockers(
@@ -618,6 +645,7 @@ func NewEthermintApp(
 		paramstypes.ModuleName,
 		vestingtypes.ModuleName,
 		consensusparamtypes.ModuleName,
+		erc20types.ModuleName,
 	)
 
 	// NOTE: fee market module must go last in order to retrieve the block gas used.
@@ -644,6 +672,7 @@ func NewEthermintApp(
 		upgradetypes.ModuleName,
 		vestingtypes.ModuleName,
 		consensusparamtypes.ModuleName,
+		erc20types.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -668,6 +697,8 @@ func NewEthermintApp(
 		// NOTE: feemarket need to be initialized before genutil module:
 		// gentx transactions use MinGasPriceDecorator.AnteHandle
 		feemarkettypes.ModuleName,
+		// erc20 module token pairs refer to the contracts of the evm genesis state
+		erc20types.ModuleName,
 		genutiltypes.ModuleName,
 		evidencetypes.ModuleName,
 		ibctransfertypes.ModuleName,
@@ -724,6 +755,7 @@ func NewEthermintApp(
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetPreBlocker(app.PreBlocker)
 	app.SetEndBlocker(app.EndBlocker)
+	app.setMempool(txConfig, appOpts)
 	app.setAnteHandler(txConfig, cast.ToUint64(appOpts.Get(srvflags.EVMMaxTxGasWanted)))
 	// In v0.46, the SDK introduces _postHandlers_. PostHandlers are like
 	// antehandlers, but are run _after_ the `runMsgs` execution. They are also
@@ -752,8 +784,75 @@ func NewEthermintApp(
 	return app
 }
 
+// customContractFns returns the stateful precompiled contracts of the native modules, the keepers are
+// resolved when the EVM is created, so the contracts can refer to keepers initialized after the evm keeper.
+func (app *EthermintApp) customContractFns() []evmkeeper.CustomContractFn {
+	return []evmkeeper.CustomContractFn{
+		func(_ sdk.Context, _ ethparams.Rules) vm.PrecompiledContract {
+			return precompiles.NewBankContract(app.BankKeeper)
+		},
+		func(_ sdk.Context, _ ethparams.Rules) vm.PrecompiledContract {
+			return precompiles.NewStakingContract(app.StakingKeeper)
+		},
+		func(_ sdk.Context, _ ethparams.Rules) vm.PrecompiledContract {
+			return precompiles.NewDistributionContract(app.DistrKeeper)
+		},
+		func(_ sdk.Context, _ ethparams.Rules) vm.PrecompiledContract {
+			return precompiles.NewGovContract(&app.GovKeeper)
+		},
+	}
+}
+
+// setMempool installs the app-side mempool and the proposal handler selecting its transactions, if
+// enabled.
+func (app *EthermintApp) setMempool(txConfig client.TxConfig, appOpts servertypes.AppOptions) {
+	if !cast.ToBool(appOpts.Get(srvflags.EVMAppMempool)) {
+		return
+	}
+
+	cfg := ethmempool.Config{
+		MaxTxs:           cast.ToInt(appOpts.Get(srvflags.EVMMempoolMaxTxs)),
+		MaxTxsPerAccount: cast.ToInt(appOpts.Get(srvflags.EVMMempoolMaxTxsPerAccount)),
+		MaxQueuedTxs:     srvconfig.DefaultMempoolMaxQueuedTxs,
+		MaxNonceGap:      srvconfig.DefaultMempoolMaxNonceGap,
+		QueuedLifetime:   srvconfig.DefaultMempoolQueuedLifetime,
+		PriceBump:        srvconfig.DefaultMempoolPriceBump,
+	}
+	if cfg.MaxTxs <= 0 {
+		cfg.MaxTxs = srvconfig.DefaultMempoolMaxTxs
+	}
+	if cfg.MaxTxsPerAccount <= 0 {
+		cfg.MaxTxsPerAccount = srvconfig.DefaultMempoolMaxTxsPerAccount
+	}
+	if appOpts.Get(srvflags.EVMMempoolMaxQueuedTxs) != nil {
+		cfg.MaxQueuedTxs = cast.ToInt(appOpts.Get(srvflags.EVMMempoolMaxQueuedTxs))
+	}
+	if appOpts.Get(srvflags.EVMMempoolMaxNonceGap) != nil {
+		cfg.MaxNonceGap = cast.ToUint64(appOpts.Get(srvflags.EVMMempoolMaxNonceGap))
+	}
+	if appOpts.Get(srvflags.EVMMempoolQueuedLifetime) != nil {
+		cfg.QueuedLifetime = cast.ToDuration(appOpts.Get(srvflags.EVMMempoolQueuedLifetime))
+	}
+	if appOpts.Get(srvflags.EVMMempoolPriceBump) != nil {
+		cfg.PriceBump = cast.ToUint64(appOpts.Get(srvflags.EVMMempoolPriceBump))
+	}
+
+	app.evmMempool = ethmempool.NewEVMMempool(cfg, app.EvmKeeper, txConfig.TxEncoder())
+	app.SetMempool(app.evmMempool)
+	handler := baseapp.NewDefaultProposalHandler(app.evmMempool, app)
+	app.SetPrepareProposal(handler.PrepareProposalHandler())
+	// the proposals are not verified, the nodes without the app-side mempool propose the txs in the
+	// order of the CometBFT mempool
+	app.SetProcessProposal(baseapp.NoOpProcessProposal())
+}
+
 // use Ethermint's custom AnteHandler
 func (app *EthermintApp) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64) {
+	var txPool ante.TxPool
+	if app.evmMempool != nil {
+		txPool = app.evmMempool
+	}
+
 	anteHandler, err := ante.NewAnteHandler(ante.HandlerOptions{
 		AccountKeeper:          app.AccountKeeper,
 		BankKeeper:             app.BankKeeper,
@@ -771,6 +870,7 @@ func (app *EthermintApp) setAnteHandler(txConfig client.TxConfig, maxGasWanted u
 			sdk.MsgTypeURL(&vestingtypes.MsgCreateVestingAccount{}),
 		},
 		PendingTxListener: app.onPendingTx,
+		TxPool:            txPool,
 	})
 	if err != nil {
 		panic(err)
@@ -907,6 +1007,31 @@ func (app *EthermintApp) GetSubspace(moduleName string) paramstypes.Subspace {
 	return subspace
 }
 
+// GetBaseApp implements the ibc-go TestingApp interface.
+func (app *EthermintApp) GetBaseApp() *baseapp.BaseApp {
+	return app.BaseApp
+}
+
+// GetStakingKeeper implements the ibc-go TestingApp interface.
+func (app *EthermintApp) GetStakingKeeper() ibctestingtypes.StakingKeeper {
+	return app.StakingKeeper
+}
+
+// GetIBCKeeper implements the ibc-go TestingApp interface.
+func (app *EthermintApp) GetIBCKeeper() *ibckeeper.Keeper {
+	return app.IBCKeeper
+}
+
+// GetScopedIBCKeeper implements the ibc-go TestingApp interface.
+func (app *EthermintApp) GetScopedIBCKeeper() capabilitykeeper.ScopedKeeper {
+	return app.ScopedIBCKeeper
+}
+
+// GetTxConfig implements the ibc-go TestingApp interface.
+func (app *EthermintApp) GetTxConfig() client.TxConfig {
+	return app.txConfig
+}
+
 // SimulationManager implements the SimulationApp interface
 func (app *EthermintApp) SimulationManager() *module.SimulationManager {
 	return app.sm
@@ -956,9 +1081,9 @@ func (app *EthermintApp) RegisterPendingTxListener(listener ante.PendingTxListen
 	app.pendingTxListeners = append(app.pendingTxListeners, listener)
 }
 
-func (app *EthermintApp) onPendingTx(hash common.Hash) {
+func (app *EthermintApp) onPendingTx(tx *evmtypes.MsgEthereumTx) {
 	for _, listener := range app.pendingTxListeners {
-		listener(hash)
+		listener(tx)
 	}
 }
 
@@ -0,0 +1,70 @@
+package mempool_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+	srvflags "github.com/evmos/ethermint/server/flags"
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/testutil"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+type AppMempoolTestSuite struct {
+	testutil.BaseTestSuiteWithAccount
+	privKey *ethsecp256k1.PrivKey
+}
+
+func TestAppMempoolTestSuite(t *testing.T) {
+	suite.Run(t, new(AppMempoolTestSuite))
+}
+
+func (suite *AppMempoolTestSuite) SetupTest() {
+	suite.BaseTestSuiteWithAccount.SetupTestWithCbAndOpts(suite.T(), nil, simtestutil.AppOptionsMap{
+		srvflags.EVMAppMempool: true,
+	})
+
+	privKey, address := suite.GenerateKey()
+	amount := sdkmath.NewIntWithDecimal(1, 18)
+	err := testutil.FundAccount(suite.App.BankKeeper, suite.Ctx, address, sdk.NewCoins(sdk.NewCoin(evmtypes.DefaultEVMDenom, amount)))
+	suite.Require().NoError(err)
+	suite.Commit()
+	suite.privKey = privKey
+}
+
+func (suite *AppMempoolTestSuite) ethTx(nonce uint64) []byte {
+	to := tests.GenerateAddress()
+	gasPrice := big.NewInt(100_000_000_000)
+	msg := evmtypes.NewTx(suite.App.EvmKeeper.ChainID(), nonce, &to, big.NewInt(1), 21000, gasPrice, nil, nil, nil, nil)
+	msg.From = suite.privKey.PubKey().Address().Bytes()
+	return suite.PrepareEthTx(msg, suite.privKey)
+}
+
+// TestCheckTx checks that the txs are inserted in the mempool once the ante handler incremented the
+// sequence of the sender in the check state, including the ones filling a nonce gap.
+func (suite *AppMempoolTestSuite) TestCheckTx() {
+	txs := [][]byte{suite.ethTx(0), suite.ethTx(1), suite.ethTx(3), suite.ethTx(2)}
+	for i, tx := range txs {
+		res := suite.CheckTx(tx)
+		suite.Require().True(res.IsOK(), "tx %d: %s", i, res.Log)
+	}
+
+	// a tx with a committed nonce which doesn't replace a pending one is rejected
+	res := suite.CheckTx(suite.ethTx(0))
+	suite.Require().False(res.IsOK())
+
+	proposal := suite.App.PrepareProposal(abci.RequestPrepareProposal{
+		MaxTxBytes: 1 << 20,
+		Height:     suite.Ctx.BlockHeight(),
+		Time:       time.Now().UTC(),
+	})
+	suite.Require().Equal([][]byte{txs[0], txs[1], txs[3], txs[2]}, proposal.Txs)
+}
@@ -0,0 +1,566 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package mempool
+
+import (
+	"container/heap"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/ethereum/go-ethereum/common"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+var (
+	// ErrReplaceUnderpriced is returned when a transaction replacing a pending one doesn't increase
+	// the fees enough.
+	ErrReplaceUnderpriced = errors.New("replacement transaction underpriced")
+	// ErrNonceTooLow is returned when a transaction has a nonce lower than the pending ones and
+	// doesn't replace any of them.
+	ErrNonceTooLow = errors.New("nonce too low")
+	// ErrAccountLimit is returned when the account has too many pending transactions.
+	ErrAccountLimit = errors.New("account reached max pending transactions")
+	// ErrQueueLimit is returned when the mempool has too many transactions queued behind a nonce gap.
+	ErrQueueLimit = errors.New("mempool reached max queued transactions")
+	// ErrNonceGap is returned when the nonce of a transaction is too far ahead of the next executable
+	// nonce of the account.
+	ErrNonceGap = errors.New("nonce gap too large")
+)
+
+var _ sdkmempool.Mempool = &EVMMempool{}
+
+// NonceKeeper defines the expected keeper returning the committed nonce of the accounts.
+type NonceKeeper interface {
+	GetNonce(ctx sdk.Context, addr common.Address) uint64
+}
+
+type accountNonceKey struct{}
+
+// WithAccountNonce records in the context the nonce of the sender of the first ethereum message
+// before the ante handler increments it. The sequence of the check state is already incremented
+// when the transaction is inserted, so Insert uses this nonce instead.
+func WithAccountNonce(ctx sdk.Context, nonce uint64) sdk.Context {
+	return ctx.WithValue(accountNonceKey{}, nonce)
+}
+
+// accountNonce returns the nonce recorded by the ante handler, or the nonce of the account if none.
+func (mp *EVMMempool) accountNonce(ctx sdk.Context, sender common.Address) uint64 {
+	if nonce, ok := ctx.Value(accountNonceKey{}).(uint64); ok {
+		return nonce
+	}
+	return mp.keeper.GetNonce(ctx, sender)
+}
+
+// Config defines the limits of the mempool.
+type Config struct {
+	// MaxTxs is the maximum number of transactions in the mempool.
+	MaxTxs int
+	// MaxTxsPerAccount is the maximum number of ethereum transactions pending for an account.
+	MaxTxsPerAccount int
+	// MaxQueuedTxs is the maximum number of ethereum transactions queued behind a nonce gap.
+	MaxQueuedTxs int
+	// MaxNonceGap is the maximum gap between the nonce of a transaction and the next executable nonce
+	// of the account.
+	MaxNonceGap uint64
+	// QueuedLifetime is the maximum time a transaction stays queued behind a nonce gap, by block
+	// time, zero to keep them until the mempool is full.
+	QueuedLifetime time.Duration
+	// PriceBump is the minimum increase of the fees, in percent, to replace a pending transaction.
+	PriceBump uint64
+}
+
+// EVMMempool is an app-side mempool keeping the ethereum transactions in nonce queues for each
+// sender. Transactions with a nonce gap are queued until the missing ones are received, and a
+// pending transaction can be replaced by one with the same nonce paying higher fees. The other
+// transactions are kept in the order they are received.
+//
+// The queued transactions are limited separately from the pending ones, they expire after the
+// queued lifetime and are evicted first when the mempool is full.
+//
+// The transactions selected for the proposals are the executable ones, following the committed
+// nonce of their sender without gap, ordered by priority across the senders.
+type EVMMempool struct {
+	cfg       Config
+	keeper    NonceKeeper
+	txEncoder sdk.TxEncoder
+
+	mtx       sync.Mutex
+	txs       map[string]*mempoolTx
+	senders   map[common.Address]map[uint64]*mempoolTx
+	cosmosTxs []*mempoolTx
+	queued    int
+	seq       uint64
+}
+
+type mempoolTx struct {
+	tx       sdk.Tx
+	key      string
+	priority int64
+	seq      uint64
+
+	// set for the ethereum transactions only
+	eth       bool
+	sender    common.Address
+	nonce     uint64
+	nextNonce uint64
+	gasFeeCap *big.Int
+	gasTipCap *big.Int
+	// queued is set while the transaction waits for a nonce gap to be filled
+	queued   bool
+	received time.Time
+}
+
+// NewEVMMempool creates a new mempool with the given limits.
+func NewEVMMempool(cfg Config, keeper NonceKeeper, txEncoder sdk.TxEncoder) *EVMMempool {
+	return &EVMMempool{
+		cfg:       cfg,
+		keeper:    keeper,
+		txEncoder: txEncoder,
+		txs:       make(map[string]*mempoolTx),
+		senders:   make(map[common.Address]map[uint64]*mempoolTx),
+	}
+}
+
+// Insert adds a transaction to the mempool, replacing the pending ethereum transaction of the
+// sender at the same nonce if the fees are increased by at least the price bump.
+//
+// The check state includes the transactions of the mempool, so the nonce of the sender before the
+// ante handler can be higher than the committed one. The transactions are checked against the
+// lowest of that nonce and the pending ones of the sender, and the committed transactions are only
+// removed in Select and Remove.
+//
+// When the mempool is full, the expired queued transactions are dropped and then the queued
+// transaction with the lowest priority is evicted for a transaction which isn't queued.
+func (mp *EVMMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	mtx, err := mp.newMempoolTx(tx)
+	if err != nil {
+		return err
+	}
+	mtx.priority = sdkCtx.Priority()
+	mtx.received = sdkCtx.BlockTime()
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	if _, found := mp.txs[mtx.key]; found {
+		return nil
+	}
+
+	mp.seq++
+	mtx.seq = mp.seq
+
+	if !mtx.eth {
+		if err := mp.ensureCapacity(sdkCtx.BlockTime()); err != nil {
+			return err
+		}
+		mp.cosmosTxs = append(mp.cosmosTxs, mtx)
+		mp.txs[mtx.key] = mtx
+		return nil
+	}
+
+	queue := mp.senders[mtx.sender]
+	nonce := mp.accountNonce(sdkCtx, mtx.sender)
+	if lowest := lowestNonce(queue); lowest != nil && lowest.nonce < nonce {
+		nonce = lowest.nonce
+	}
+	defer mp.updateQueued(mtx.sender, nonce)
+
+	if old, found := queue[mtx.nonce]; found {
+		if err := mp.checkReplacement(old, mtx.gasFeeCap, mtx.gasTipCap); err != nil {
+			return err
+		}
+		mp.remove(old)
+		mp.add(mtx)
+		return nil
+	}
+
+	if mtx.nonce < nonce {
+		return ErrNonceTooLow
+	}
+
+	for _, pending := range queue {
+		if mtx.nonce < pending.nextNonce && pending.nonce < mtx.nextNonce {
+			return fmt.Errorf("nonces %d to %d overlap with a pending transaction", mtx.nonce, mtx.nextNonce-1)
+		}
+	}
+
+	next := executableNonce(queue, nonce)
+	if mtx.nonce > next && mtx.nonce-next > mp.cfg.MaxNonceGap {
+		return fmt.Errorf("%w: nonce %d, next executable nonce %d", ErrNonceGap, mtx.nonce, next)
+	}
+
+	// the status of the transaction is set once added, by updating the queue of the sender
+	queued := mtx.nonce != next
+	if queued && mp.queued >= mp.cfg.MaxQueuedTxs {
+		if mp.expireQueued(sdkCtx.BlockTime()); mp.queued >= mp.cfg.MaxQueuedTxs {
+			return ErrQueueLimit
+		}
+	}
+
+	switch {
+	case len(queue) >= mp.cfg.MaxTxsPerAccount:
+		// the transaction with the highest nonce is evicted for one filling a gap before it
+		highest := highestNonce(queue)
+		if mtx.nonce > highest.nonce {
+			return ErrAccountLimit
+		}
+		mp.remove(highest)
+	case len(mp.txs) >= mp.cfg.MaxTxs:
+		if queued {
+			mp.expireQueued(sdkCtx.BlockTime())
+		} else if err := mp.ensureCapacity(sdkCtx.BlockTime()); err != nil {
+			return err
+		}
+		if len(mp.txs) >= mp.cfg.MaxTxs {
+			return sdkmempool.ErrMempoolTxMaxCapacity
+		}
+	}
+
+	mp.add(mtx)
+	return nil
+}
+
+// CanReplace returns an error unless the message replaces the pending transaction of its sender
+// at the same nonce, or is already pending. It is used in CheckTx to accept the transactions with
+// a nonce lower than the sequence of the account.
+func (mp *EVMMempool) CanReplace(msg *evmtypes.MsgEthereumTx) error {
+	txData, err := evmtypes.UnpackTxData(msg.Data)
+	if err != nil {
+		return err
+	}
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	old, found := mp.senders[msg.GetSender()][txData.GetNonce()]
+	if !found {
+		return ErrNonceTooLow
+	}
+	if old.key == msg.Hash {
+		return nil
+	}
+	return mp.checkReplacement(old, txData.GetGasFeeCap(), txData.GetGasTipCap())
+}
+
+// Select returns an iterator over the executable transactions, the ethereum transactions with
+// a nonce already committed and the expired queued ones are removed.
+func (mp *EVMMempool) Select(ctx context.Context, _ [][]byte) sdkmempool.Iterator {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	mp.expireQueued(sdkCtx.BlockTime())
+
+	var heads senderHeads
+	for sender, queue := range mp.senders {
+		nonce := mp.keeper.GetNonce(sdkCtx, sender)
+		mp.removeStale(sender, nonce)
+		mp.updateQueued(sender, nonce)
+		if head, found := queue[nonce]; found {
+			heads = append(heads, head)
+		}
+	}
+
+	txs := make([]sdk.Tx, 0, len(mp.txs))
+	cosmosTxs := mp.cosmosTxs
+	heap.Init(&heads)
+	for len(heads) > 0 || len(cosmosTxs) > 0 {
+		if len(heads) == 0 || (len(cosmosTxs) > 0 && cosmosTxs[0].priority > heads[0].priority) {
+			txs = append(txs, cosmosTxs[0].tx)
+			cosmosTxs = cosmosTxs[1:]
+			continue
+		}
+
+		head := heads[0]
+		txs = append(txs, head.tx)
+		if next, found := mp.senders[head.sender][head.nextNonce]; found {
+			heads[0] = next
+			heap.Fix(&heads, 0)
+		} else {
+			heap.Pop(&heads)
+		}
+	}
+
+	return newIterator(txs)
+}
+
+// CountTx returns the number of transactions in the mempool.
+func (mp *EVMMempool) CountTx() int {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	return len(mp.txs)
+}
+
+// Remove removes a transaction from the mempool. The pending ethereum transaction of the sender
+// at the same nonce is removed as well, since it can't be executed anymore once the transaction is
+// committed.
+func (mp *EVMMempool) Remove(tx sdk.Tx) error {
+	mtx, err := mp.newMempoolTx(tx)
+	if err != nil {
+		return err
+	}
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	if found, ok := mp.txs[mtx.key]; ok {
+		mp.remove(found)
+		return nil
+	}
+	if mtx.eth {
+		if stale, ok := mp.senders[mtx.sender][mtx.nonce]; ok {
+			mp.remove(stale)
+		}
+	}
+	return sdkmempool.ErrTxNotFound
+}
+
+// newMempoolTx decodes the fields used to order the transaction in the mempool, the transactions
+// containing ethereum messages are keyed by the hash and ordered by the nonce of the first one.
+func (mp *EVMMempool) newMempoolTx(tx sdk.Tx) (*mempoolTx, error) {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return nil, errors.New("transaction has no messages")
+	}
+
+	msg, ok := msgs[0].(*evmtypes.MsgEthereumTx)
+	if !ok {
+		bz, err := mp.txEncoder(tx)
+		if err != nil {
+			return nil, err
+		}
+		hash := sha256.Sum256(bz)
+		return &mempoolTx{tx: tx, key: string(hash[:])}, nil
+	}
+
+	txData, err := evmtypes.UnpackTxData(msg.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &mempoolTx{
+		tx:        tx,
+		key:       msg.Hash,
+		eth:       true,
+		sender:    msg.GetSender(),
+		nonce:     txData.GetNonce(),
+		nextNonce: txData.GetNonce() + uint64(len(msgs)),
+		gasFeeCap: txData.GetGasFeeCap(),
+		gasTipCap: txData.GetGasTipCap(),
+	}, nil
+}
+
+// removeStale removes the transactions of the sender with a nonce lower than the account nonce.
+func (mp *EVMMempool) removeStale(sender common.Address, nonce uint64) {
+	for _, mtx := range mp.senders[sender] {
+		if mtx.nonce < nonce {
+			mp.remove(mtx)
+		}
+	}
+}
+
+// updateQueued marks the transactions of the sender following the account nonce without gap as
+// pending and the others as queued.
+func (mp *EVMMempool) updateQueued(sender common.Address, nonce uint64) {
+	queue := mp.senders[sender]
+	executable := make(map[uint64]bool, len(queue))
+	for mtx, found := queue[nonce]; found; mtx, found = queue[mtx.nextNonce] {
+		executable[mtx.nonce] = true
+	}
+	for _, mtx := range queue {
+		mp.setQueued(mtx, !executable[mtx.nonce])
+	}
+}
+
+func (mp *EVMMempool) setQueued(mtx *mempoolTx, queued bool) {
+	if mtx.queued == queued {
+		return
+	}
+	mtx.queued = queued
+	if queued {
+		mp.queued++
+	} else {
+		mp.queued--
+	}
+}
+
+// expireQueued removes the queued transactions received before the queued lifetime.
+func (mp *EVMMempool) expireQueued(now time.Time) {
+	if mp.cfg.QueuedLifetime <= 0 || mp.queued == 0 {
+		return
+	}
+	for _, mtx := range mp.txs {
+		if mtx.queued && now.Sub(mtx.received) > mp.cfg.QueuedLifetime {
+			mp.remove(mtx)
+		}
+	}
+}
+
+// ensureCapacity makes room for a transaction when the mempool is full, removing the expired
+// queued transactions or else evicting the queued transaction with the lowest priority.
+func (mp *EVMMempool) ensureCapacity(now time.Time) error {
+	if len(mp.txs) < mp.cfg.MaxTxs {
+		return nil
+	}
+	if mp.expireQueued(now); len(mp.txs) < mp.cfg.MaxTxs {
+		return nil
+	}
+
+	var evicted *mempoolTx
+	for _, mtx := range mp.txs {
+		if !mtx.queued {
+			continue
+		}
+		if evicted == nil || mtx.priority < evicted.priority ||
+			(mtx.priority == evicted.priority && mtx.seq > evicted.seq) {
+			evicted = mtx
+		}
+	}
+	if evicted == nil {
+		return sdkmempool.ErrMempoolTxMaxCapacity
+	}
+	mp.remove(evicted)
+	return nil
+}
+
+// checkReplacement returns an error if the fees don't bump the ones of the pending transaction.
+func (mp *EVMMempool) checkReplacement(old *mempoolTx, gasFeeCap, gasTipCap *big.Int) error {
+	if gasFeeCap.Cmp(bumpPrice(old.gasFeeCap, mp.cfg.PriceBump)) < 0 ||
+		gasTipCap.Cmp(bumpPrice(old.gasTipCap, mp.cfg.PriceBump)) < 0 {
+		return ErrReplaceUnderpriced
+	}
+	return nil
+}
+
+func (mp *EVMMempool) add(mtx *mempoolTx) {
+	mp.txs[mtx.key] = mtx
+	queue, found := mp.senders[mtx.sender]
+	if !found {
+		queue = make(map[uint64]*mempoolTx)
+		mp.senders[mtx.sender] = queue
+	}
+	queue[mtx.nonce] = mtx
+}
+
+func (mp *EVMMempool) remove(mtx *mempoolTx) {
+	delete(mp.txs, mtx.key)
+	if !mtx.eth {
+		for i, cosmosTx := range mp.cosmosTxs {
+			if cosmosTx == mtx {
+				mp.cosmosTxs = append(mp.cosmosTxs[:i:i], mp.cosmosTxs[i+1:]...)
+				break
+			}
+		}
+		return
+	}
+
+	mp.setQueued(mtx, false)
+	queue := mp.senders[mtx.sender]
+	delete(queue, mtx.nonce)
+	if len(queue) == 0 {
+		delete(mp.senders, mtx.sender)
+	}
+}
+
+// bumpPrice returns the price increased by the given percent.
+func bumpPrice(price *big.Int, percent uint64) *big.Int {
+	bumped := new(big.Int).Mul(price, new(big.Int).SetUint64(100+percent))
+	return bumped.Div(bumped, big.NewInt(100))
+}
+
+// executableNonce returns the nonce following the transactions of the queue executable from the
+// account nonce.
+func executableNonce(queue map[uint64]*mempoolTx, nonce uint64) uint64 {
+	for mtx, found := queue[nonce]; found; mtx, found = queue[nonce] {
+		nonce = mtx.nextNonce
+	}
+	return nonce
+}
+
+func lowestNonce(queue map[uint64]*mempoolTx) *mempoolTx {
+	var lowest *mempoolTx
+	for _, mtx := range queue {
+		if lowest == nil || mtx.nonce < lowest.nonce {
+			lowest = mtx
+		}
+	}
+	return lowest
+}
+
+func highestNonce(queue map[uint64]*mempoolTx) *mempoolTx {
+	var highest *mempoolTx
+	for _, mtx := range queue {
+		if highest == nil || mtx.nonce > highest.nonce {
+			highest = mtx
+		}
+	}
+	return highest
+}
+
+// senderHeads is a heap of the next transaction of each sender, by descending priority and then
+// by arrival.
+type senderHeads []*mempoolTx
+
+func (h senderHeads) Len() int      { return len(h) }
+func (h senderHeads) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+func (h senderHeads) Less(i, j int) bool {
+	if h[i].priority != h[j].priority {
+		return h[i].priority > h[j].priority
+	}
+	return h[i].seq < h[j].seq
+}
+
+func (h *senderHeads) Push(x interface{}) {
+	*h = append(*h, x.(*mempoolTx))
+}
+
+func (h *senderHeads) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
+
+// iterator iterates over a snapshot of the selected transactions.
+type iterator struct {
+	txs []sdk.Tx
+}
+
+func newIterator(txs []sdk.Tx) sdkmempool.Iterator {
+	if len(txs) == 0 {
+		return nil
+	}
+	return &iterator{txs: txs}
+}
+
+func (it *iterator) Next() sdkmempool.Iterator {
+	return newIterator(it.txs[1:])
+}
+
+func (it *iterator) Tx() sdk.Tx {
+	return it.txs[0]
+}
@@ -0,0 +1,249 @@
+package mempool_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/app/mempool"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+type nonceKeeper map[common.Address]uint64
+
+func (k nonceKeeper) GetNonce(_ sdk.Context, addr common.Address) uint64 {
+	return k[addr]
+}
+
+type testTx struct {
+	msgs []sdk.Msg
+}
+
+func (tx testTx) GetMsgs() []sdk.Msg   { return tx.msgs }
+func (tx testTx) ValidateBasic() error { return nil }
+
+func encodeTx(tx sdk.Tx) ([]byte, error) {
+	return []byte(fmt.Sprintf("%v", tx.GetMsgs())), nil
+}
+
+var (
+	alice = common.HexToAddress("0x1000000000000000000000000000000000000001")
+	bob   = common.HexToAddress("0x1000000000000000000000000000000000000002")
+)
+
+func ethTx(from common.Address, nonce uint64, gasPrice int64) sdk.Tx {
+	msg := evmtypes.NewTx(big.NewInt(9000), nonce, &bob, big.NewInt(1), 21000, big.NewInt(gasPrice), nil, nil, nil, nil)
+	msg.From = from.Bytes()
+	return testTx{msgs: []sdk.Msg{msg}}
+}
+
+func cosmosTx(amount int64) sdk.Tx {
+	return testTx{msgs: []sdk.Msg{banktypes.NewMsgSend(
+		alice.Bytes(), bob.Bytes(), sdk.NewCoins(sdk.NewInt64Coin("aphoton", amount)),
+	)}}
+}
+
+func newMempool(keeper nonceKeeper) *mempool.EVMMempool {
+	return mempool.NewEVMMempool(mempool.Config{
+		MaxTxs:           10,
+		MaxTxsPerAccount: 5,
+		MaxQueuedTxs:     5,
+		MaxNonceGap:      5,
+		QueuedLifetime:   time.Hour,
+		PriceBump:        10,
+	}, keeper, encodeTx)
+}
+
+// testCtx returns an empty context, the nonce keeper doesn't use the stores
+func testCtx() sdk.Context {
+	return sdk.Context{}.WithContext(context.Background())
+}
+
+func insert(t *testing.T, mp *mempool.EVMMempool, tx sdk.Tx, priority int64) error {
+	t.Helper()
+	return mp.Insert(testCtx().WithPriority(priority), tx)
+}
+
+func selectAll(mp *mempool.EVMMempool) []sdk.Tx {
+	var txs []sdk.Tx
+	for it := mp.Select(testCtx(), nil); it != nil; it = it.Next() {
+		txs = append(txs, it.Tx())
+	}
+	return txs
+}
+
+func TestSelectNonceOrder(t *testing.T) {
+	keeper := nonceKeeper{alice: 1}
+	mp := newMempool(keeper)
+
+	aliceTx1, aliceTx2, aliceTx4 := ethTx(alice, 1, 1), ethTx(alice, 2, 1), ethTx(alice, 4, 1)
+	bobTx0 := ethTx(bob, 0, 5)
+	cosmos := cosmosTx(1)
+
+	require.NoError(t, insert(t, mp, aliceTx2, 1))
+	require.NoError(t, insert(t, mp, aliceTx4, 1))
+	require.NoError(t, insert(t, mp, aliceTx1, 1))
+	require.NoError(t, insert(t, mp, bobTx0, 5))
+	require.NoError(t, insert(t, mp, cosmos, 3))
+	require.Equal(t, 5, mp.CountTx())
+
+	// alice's tx at nonce 4 waits for the gap to be filled
+	require.Equal(t, []sdk.Tx{bobTx0, cosmos, aliceTx1, aliceTx2}, selectAll(mp))
+
+	aliceTx3 := ethTx(alice, 3, 1)
+	require.NoError(t, insert(t, mp, aliceTx3, 1))
+	require.Equal(t, []sdk.Tx{bobTx0, cosmos, aliceTx1, aliceTx2, aliceTx3, aliceTx4}, selectAll(mp))
+}
+
+func TestSelectRemovesCommittedNonces(t *testing.T) {
+	keeper := nonceKeeper{}
+	mp := newMempool(keeper)
+
+	tx0, tx1 := ethTx(alice, 0, 1), ethTx(alice, 1, 1)
+	require.NoError(t, insert(t, mp, tx0, 1))
+	require.NoError(t, insert(t, mp, tx1, 1))
+
+	keeper[alice] = 1
+	require.Equal(t, []sdk.Tx{tx1}, selectAll(mp))
+	require.Equal(t, 1, mp.CountTx())
+}
+
+func TestReplacement(t *testing.T) {
+	mp := newMempool(nonceKeeper{})
+
+	pending := ethTx(alice, 0, 100)
+	require.NoError(t, insert(t, mp, pending, 1))
+
+	underpriced := ethTx(alice, 0, 109)
+	require.ErrorIs(t, insert(t, mp, underpriced, 1), mempool.ErrReplaceUnderpriced)
+	require.ErrorIs(t, mp.CanReplace(underpriced.GetMsgs()[0].(*evmtypes.MsgEthereumTx)), mempool.ErrReplaceUnderpriced)
+	require.NoError(t, mp.CanReplace(pending.GetMsgs()[0].(*evmtypes.MsgEthereumTx)))
+	require.ErrorIs(t, mp.CanReplace(ethTx(alice, 1, 200).GetMsgs()[0].(*evmtypes.MsgEthereumTx)), mempool.ErrNonceTooLow)
+
+	replacement := ethTx(alice, 0, 110)
+	require.NoError(t, mp.CanReplace(replacement.GetMsgs()[0].(*evmtypes.MsgEthereumTx)))
+	require.NoError(t, insert(t, mp, replacement, 2))
+	require.Equal(t, 1, mp.CountTx())
+	require.Equal(t, []sdk.Tx{replacement}, selectAll(mp))
+}
+
+func TestAccountLimit(t *testing.T) {
+	mp := mempool.NewEVMMempool(mempool.Config{MaxTxs: 10, MaxTxsPerAccount: 3, MaxQueuedTxs: 5, MaxNonceGap: 5, PriceBump: 10}, nonceKeeper{}, encodeTx)
+
+	require.NoError(t, insert(t, mp, ethTx(alice, 0, 1), 1))
+	require.NoError(t, insert(t, mp, ethTx(alice, 1, 1), 1))
+	require.NoError(t, insert(t, mp, ethTx(alice, 5, 1), 1))
+	require.ErrorIs(t, insert(t, mp, ethTx(alice, 6, 1), 1), mempool.ErrAccountLimit)
+
+	// the highest nonce is evicted for a tx filling the gap
+	tx2 := ethTx(alice, 2, 1)
+	require.NoError(t, insert(t, mp, tx2, 1))
+	require.Equal(t, 3, mp.CountTx())
+	require.Len(t, selectAll(mp), 3)
+}
+
+func TestMaxTxs(t *testing.T) {
+	mp := mempool.NewEVMMempool(mempool.Config{MaxTxs: 2, MaxTxsPerAccount: 2, MaxQueuedTxs: 2, MaxNonceGap: 5, PriceBump: 10}, nonceKeeper{}, encodeTx)
+
+	require.NoError(t, insert(t, mp, cosmosTx(1), 1))
+	require.NoError(t, insert(t, mp, ethTx(alice, 0, 1), 1))
+	require.ErrorIs(t, insert(t, mp, ethTx(bob, 0, 2), 1), sdkmempool.ErrMempoolTxMaxCapacity)
+	require.ErrorIs(t, insert(t, mp, cosmosTx(2), 1), sdkmempool.ErrMempoolTxMaxCapacity)
+}
+
+func TestNonceGap(t *testing.T) {
+	mp := newMempool(nonceKeeper{alice: 1})
+
+	require.ErrorIs(t, insert(t, mp, ethTx(alice, 0, 1), 1), mempool.ErrNonceTooLow)
+	require.ErrorIs(t, insert(t, mp, ethTx(alice, 7, 1), 1), mempool.ErrNonceGap)
+	require.NoError(t, insert(t, mp, ethTx(alice, 6, 1), 1))
+
+	// the gap is counted from the next executable nonce
+	require.NoError(t, insert(t, mp, ethTx(alice, 1, 1), 1))
+	require.NoError(t, insert(t, mp, ethTx(alice, 7, 1), 1))
+}
+
+func TestQueueLimit(t *testing.T) {
+	mp := mempool.NewEVMMempool(mempool.Config{MaxTxs: 10, MaxTxsPerAccount: 5, MaxQueuedTxs: 2, MaxNonceGap: 5, PriceBump: 10}, nonceKeeper{}, encodeTx)
+
+	require.NoError(t, insert(t, mp, ethTx(alice, 1, 1), 1))
+	require.NoError(t, insert(t, mp, ethTx(alice, 2, 1), 1))
+	require.ErrorIs(t, insert(t, mp, ethTx(bob, 1, 2), 1), mempool.ErrQueueLimit)
+
+	// the pending txs are not limited by the queued ones, and filling the gap promotes them
+	require.NoError(t, insert(t, mp, ethTx(bob, 0, 2), 1))
+	require.NoError(t, insert(t, mp, ethTx(alice, 0, 1), 1))
+	require.NoError(t, insert(t, mp, ethTx(bob, 1, 2), 1))
+	require.NoError(t, insert(t, mp, ethTx(bob, 3, 2), 1))
+	require.Len(t, selectAll(mp), 5)
+}
+
+func TestQueuedLifetime(t *testing.T) {
+	mp := newMempool(nonceKeeper{})
+	now := time.Unix(1000, 0)
+
+	require.NoError(t, mp.Insert(testCtx().WithBlockTime(now), ethTx(alice, 1, 1)))
+	require.NoError(t, mp.Insert(testCtx().WithBlockTime(now), ethTx(bob, 0, 2)))
+
+	var txs []sdk.Tx
+	for it := mp.Select(testCtx().WithBlockTime(now.Add(2*time.Hour)), nil); it != nil; it = it.Next() {
+		txs = append(txs, it.Tx())
+	}
+	require.Len(t, txs, 1)
+	require.Equal(t, 1, mp.CountTx())
+}
+
+func TestEvictQueued(t *testing.T) {
+	mp := mempool.NewEVMMempool(mempool.Config{MaxTxs: 2, MaxTxsPerAccount: 2, MaxQueuedTxs: 5, MaxNonceGap: 5, PriceBump: 10}, nonceKeeper{}, encodeTx)
+
+	require.NoError(t, insert(t, mp, ethTx(alice, 1, 1), 1))
+	require.NoError(t, insert(t, mp, ethTx(alice, 2, 1), 2))
+	require.ErrorIs(t, insert(t, mp, ethTx(bob, 1, 2), 3), sdkmempool.ErrMempoolTxMaxCapacity)
+
+	// the queued tx with the lowest priority is evicted for an executable one
+	bobTx0, cosmos := ethTx(bob, 0, 2), cosmosTx(1)
+	require.NoError(t, insert(t, mp, bobTx0, 1))
+	require.NoError(t, insert(t, mp, cosmos, 1))
+	require.ErrorIs(t, insert(t, mp, cosmosTx(2), 1), sdkmempool.ErrMempoolTxMaxCapacity)
+	require.Equal(t, []sdk.Tx{bobTx0, cosmos}, selectAll(mp))
+}
+
+func TestRemove(t *testing.T) {
+	mp := newMempool(nonceKeeper{})
+
+	tx, cosmos := ethTx(alice, 0, 1), cosmosTx(1)
+	require.NoError(t, insert(t, mp, tx, 1))
+	require.NoError(t, insert(t, mp, cosmos, 1))
+
+	require.NoError(t, mp.Remove(cosmos))
+	require.ErrorIs(t, mp.Remove(cosmos), sdkmempool.ErrTxNotFound)
+
+	// a different tx committed at the same nonce removes the pending one
+	require.ErrorIs(t, mp.Remove(ethTx(alice, 0, 2)), sdkmempool.ErrTxNotFound)
+	require.Equal(t, 0, mp.CountTx())
+	require.Nil(t, mp.Select(testCtx(), nil))
+}
+
+func TestInsertAccountNonce(t *testing.T) {
+	// the check state already includes the txs of the mempool
+	keeper := nonceKeeper{alice: 3}
+	mp := newMempool(keeper)
+
+	tx0, tx1, tx2, tx3 := ethTx(alice, 0, 1), ethTx(alice, 1, 1), ethTx(alice, 2, 1), ethTx(alice, 3, 1)
+	require.NoError(t, mp.Insert(mempool.WithAccountNonce(testCtx(), 0), tx0))
+	require.NoError(t, mp.Insert(mempool.WithAccountNonce(testCtx(), 1), tx1))
+	require.NoError(t, mp.Insert(mempool.WithAccountNonce(testCtx(), 2), tx3))
+	require.NoError(t, mp.Insert(mempool.WithAccountNonce(testCtx(), 2), tx2))
+	require.Equal(t, 4, mp.CountTx())
+
+	keeper[alice] = 0
+	require.Equal(t, []sdk.Tx{tx0, tx1, tx2, tx3}, selectAll(mp))
+}
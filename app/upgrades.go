@@ -39,6 +39,8 @@ import (
 	clientkeeper "github.com/cosmos/ibc-go/v7/modules/core/02-client/keeper"
 	"github.com/cosmos/ibc-go/v7/modules/core/exported"
 	ibctmmigrations "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint/migrations"
+	"github.com/ethereum/go-ethereum/common"
+	erc20types "github.com/evmos/ethermint/x/erc20/types"
 	v0evmtypes "github.com/evmos/ethermint/x/evm/migrations/v0/types"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 	feemarkettypes "github.com/evmos/ethermint/x/feemarket/types"
@@ -46,6 +48,8 @@ import (
 
 func (app *EthermintApp) RegisterUpgradeHandlers(cdc codec.BinaryCodec, clientKeeper clientkeeper.Keeper) {
 	planName := "integration-test-upgrade"
+	erc20PlanName := "erc20-upgrade"
+	stateRootPlanName := "evm-state-root-upgrade"
 	// Set param key table for params module migration
 	for _, subspace := range app.ParamsKeeper.GetSubspaces() {
 		var keyTable paramstypes.KeyTable
@@ -94,12 +98,24 @@ func (app *EthermintApp) RegisterUpgradeHandlers(cdc codec.BinaryCodec, clientKe
 		baseapp.MigrateParams(ctx, baseAppLegacySS, &app.ConsensusParamsKeeper)
 		return app.mm.RunMigrations(ctx, app.configurator, fromVM)
 	})
+	// the erc20 module is added by its own plan, its genesis being initialized by the migrations
+	// as the module is missing from the version map.
+	app.UpgradeKeeper.SetUpgradeHandler(erc20PlanName, func(ctx sdk.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+	})
+	// the EVM state root changes the app hash and the results hash, its tracking is enabled by the
+	// upgrade setting the initial state root.
+	app.UpgradeKeeper.SetUpgradeHandler(stateRootPlanName, func(ctx sdk.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		app.EvmKeeper.SetStateRoot(ctx, common.Hash{})
+		return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+	})
 	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
 	if err != nil {
 		panic(fmt.Sprintf("failed to read upgrade info from disk %s", err))
 	}
 	if !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
-		if upgradeInfo.Name == planName {
+		switch upgradeInfo.Name {
+		case planName:
 			storeUpgrades := storetypes.StoreUpgrades{
 				Added: []string{
 					consensusparamtypes.StoreKey,
@@ -107,6 +123,13 @@ func (app *EthermintApp) RegisterUpgradeHandlers(cdc codec.BinaryCodec, clientKe
 				},
 			}
 			app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
+		case erc20PlanName:
+			storeUpgrades := storetypes.StoreUpgrades{
+				Added: []string{
+					erc20types.StoreKey,
+				},
+			}
+			app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
 		}
 	}
 }
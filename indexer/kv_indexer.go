@@ -16,6 +16,7 @@
 package indexer
 
 import (
+	"errors"
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
@@ -28,6 +29,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	rpctypes "github.com/evmos/ethermint/rpc/types"
 
 	ethermint "github.com/evmos/ethermint/types"
@@ -37,11 +40,19 @@ import (
 const (
 	KeyPrefixTxHash  = 1
 	KeyPrefixTxIndex = 2
+	KeyPrefixAddress = 3
+	// KeyPrefixContractCreator is the prefix of the entries `contract address -> tx hash`
+	KeyPrefixContractCreator = 8
+	// KeyPrefixAddressRange is the prefix of the ranges of contiguous blocks covered by the address index
+	KeyPrefixAddressRange = 9
 
 	// TxIndexKeyLength is the length of tx-index key
 	TxIndexKeyLength = 1 + 8 + 8
 )
 
+// ErrAddressIndexDisabled is returned when querying the address index while it's not enabled.
+var ErrAddressIndexDisabled = errors.New("address index is not enabled")
+
 var _ ethermint.EVMTxIndexer = &KVIndexer{}
 
 // KVIndexer implements a eth tx indexer on a KV db.
@@ -49,11 +60,37 @@ type KVIndexer struct {
 	db        dbm.DB
 	logger    log.Logger
 	clientCtx client.Context
+
+	// addressIndex enables the secondary index: `(address, block number, tx index) -> tx hash`
+	addressIndex bool
+	// logIndex enables the persistence of the logs, indexed by address and topics
+	logIndex bool
+}
+
+// KVIndexerOption configures the optional indexes of the KVIndexer
+type KVIndexerOption func(*KVIndexer)
+
+// WithAddressIndex enables the index of the eth txs by sender, recipient and created contract.
+func WithAddressIndex(enabled bool) KVIndexerOption {
+	return func(kv *KVIndexer) {
+		kv.addressIndex = enabled
+	}
+}
+
+// WithLogIndex enables the persistence of the eth logs, indexed by address and topics.
+func WithLogIndex(enabled bool) KVIndexerOption {
+	return func(kv *KVIndexer) {
+		kv.logIndex = enabled
+	}
 }
 
 // NewKVIndexer creates the KVIndexer
-func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVIndexer {
-	return &KVIndexer{db, logger, clientCtx}
+func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context, opts ...KVIndexerOption) *KVIndexer {
+	kv := &KVIndexer{db: db, logger: logger, clientCtx: clientCtx}
+	for _, opt := range opts {
+		opt(kv)
+	}
+	return kv
 }
 
 // IndexBlock index all the eth txs in a block through the following steps:
@@ -69,6 +106,7 @@ func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.Response
 
 	// record index of valid eth tx during the iteration
 	var ethTxIndex int32
+	var blockLogs []*ethtypes.Log
 	for txIndex, tx := range block.Txs {
 		result := txResults[txIndex]
 		if !rpctypes.TxSuccessOrExceedsBlockGasLimit(result) {
@@ -118,6 +156,15 @@ func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.Response
 				}
 				txResult.GasUsed = parsedTx.GasUsed
 				txResult.Failed = parsedTx.Failed
+
+				if kv.logIndex && !txResult.Failed {
+					logs, err := evmtypes.DecodeMsgLogsFromEvents(result.Data, result.Events, msgIndex, uint64(height))
+					if err != nil {
+						kv.logger.Error("Fail to decode logs", "err", err, "block", height, "txIndex", txIndex, "msgIndex", msgIndex)
+					} else {
+						blockLogs = append(blockLogs, logs...)
+					}
+				}
 			}
 
 			cumulativeGasUsed += txResult.GasUsed
@@ -127,6 +174,24 @@ func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.Response
 			if err := saveTxResult(kv.clientCtx.Codec, batch, txHash, &txResult); err != nil {
 				return errorsmod.Wrapf(err, "IndexBlock %d", height)
 			}
+			if kv.addressIndex {
+				if err := saveAddressIndex(batch, ethMsg, txHash, &txResult); err != nil {
+					return errorsmod.Wrapf(err, "IndexBlock %d", height)
+				}
+			}
+		}
+	}
+	if kv.addressIndex {
+		if err := saveIndexRange(kv.db, batch, KeyPrefixAddressRange, height); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d", height)
+		}
+	}
+	if kv.logIndex {
+		if err := saveLogs(kv.clientCtx.Codec, batch, height, blockLogs); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d", height)
+		}
+		if err := saveIndexRange(kv.db, batch, KeyPrefixLogRange, height); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d", height)
 		}
 	}
 	if err := batch.Write(); err != nil {
@@ -140,6 +205,16 @@ func (kv *KVIndexer) LastIndexedBlock() (int64, error) {
 	return LoadLastBlock(kv.db)
 }
 
+// AddressIndexRange returns the inclusive range of contiguous blocks covered by the address index which ends
+// with the latest indexed block, returns -1 if it's empty
+func (kv *KVIndexer) AddressIndexRange() (int64, int64, error) {
+	first, last, err := loadIndexRange(kv.db, KeyPrefixAddressRange)
+	if err != nil {
+		return 0, 0, errorsmod.Wrap(err, "AddressIndexRange")
+	}
+	return first, last, nil
+}
+
 // FirstIndexedBlock returns the first indexed block number, returns -1 if db is empty
 func (kv *KVIndexer) FirstIndexedBlock() (int64, error) {
 	return LoadFirstBlock(kv.db)
@@ -173,6 +248,90 @@ func (kv *KVIndexer) GetByBlockAndIndex(blockNumber int64, txIndex int32) (*ethe
 	return kv.GetByTxHash(common.BytesToHash(bz))
 }
 
+// GetByAddress returns the hashes of the eth txs sent by, sent to or creating the address, in the inclusive
+// block range, ordered by block number and tx index, at most `limit` results are returned after skipping `offset`.
+func (kv *KVIndexer) GetByAddress(address common.Address, fromBlock, toBlock int64, offset, limit int) ([]common.Hash, error) {
+	if !kv.addressIndex {
+		return nil, ErrAddressIndexDisabled
+	}
+	if fromBlock < 0 || toBlock < fromBlock {
+		return nil, fmt.Errorf("invalid block range [%d, %d]", fromBlock, toBlock)
+	}
+
+	start := append(AddressIndexPrefix(address), sdk.Uint64ToBigEndian(uint64(fromBlock))...)
+	end := append(AddressIndexPrefix(address), sdk.Uint64ToBigEndian(uint64(toBlock)+1)...)
+	it, err := kv.db.Iterator(start, end)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetByAddress %s", address.Hex())
+	}
+	defer it.Close()
+
+	var hashes []common.Hash
+	for ; it.Valid() && len(hashes) < limit; it.Next() {
+		if offset > 0 {
+			offset--
+			continue
+		}
+		hashes = append(hashes, common.BytesToHash(it.Value()))
+	}
+	return hashes, it.Error()
+}
+
+// SearchByAddress returns the hashes of the eth txs related to the address in the blocks before (reverse) or after
+// the given block number, which is excluded. Blocks are never split so the number of the last block can be used as
+// the cursor of the next page, so more than pageSize hashes can be returned. hasMore reports if there are more txs
+// to search in that direction.
+func (kv *KVIndexer) SearchByAddress(
+	address common.Address, blockNumber int64, reverse bool, pageSize int,
+) (hashes []common.Hash, hasMore bool, err error) {
+	if !kv.addressIndex {
+		return nil, false, ErrAddressIndexDisabled
+	}
+	if blockNumber < 0 {
+		return nil, false, fmt.Errorf("invalid block number %d", blockNumber)
+	}
+
+	prefix := AddressIndexPrefix(address)
+	var it dbm.Iterator
+	if reverse {
+		it, err = kv.db.ReverseIterator(prefix, append(prefix, sdk.Uint64ToBigEndian(uint64(blockNumber))...))
+	} else {
+		it, err = kv.db.Iterator(append(prefix, sdk.Uint64ToBigEndian(uint64(blockNumber)+1)...), sdk.PrefixEndBytes(prefix))
+	}
+	if err != nil {
+		return nil, false, errorsmod.Wrapf(err, "SearchByAddress %s", address.Hex())
+	}
+	defer it.Close()
+
+	lastBlock := int64(-1)
+	for ; it.Valid(); it.Next() {
+		height := int64(sdk.BigEndianToUint64(it.Key()[len(prefix) : len(prefix)+8]))
+		if len(hashes) >= pageSize && height != lastBlock {
+			return hashes, true, nil
+		}
+		hashes = append(hashes, common.BytesToHash(it.Value()))
+		lastBlock = height
+	}
+	return hashes, false, it.Error()
+}
+
+// GetContractCreation returns the hash of the eth tx which created the contract, nil if not found. Only the
+// contracts deployed by contract creation txs are indexed, not the ones created by other contracts.
+func (kv *KVIndexer) GetContractCreation(address common.Address) (*common.Hash, error) {
+	if !kv.addressIndex {
+		return nil, ErrAddressIndexDisabled
+	}
+	bz, err := kv.db.Get(ContractCreatorKey(address))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetContractCreation %s", address.Hex())
+	}
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	hash := common.BytesToHash(bz)
+	return &hash, nil
+}
+
 // TxHashKey returns the key for db entry: `tx hash -> tx result struct`
 func TxHashKey(hash common.Hash) []byte {
 	return append([]byte{KeyPrefixTxHash}, hash.Bytes()...)
@@ -185,6 +344,23 @@ func TxIndexKey(blockNumber int64, txIndex int32) []byte {
 	return append(append([]byte{KeyPrefixTxIndex}, bz1...), bz2...)
 }
 
+// AddressIndexPrefix returns the prefix of the address index entries of an address
+func AddressIndexPrefix(address common.Address) []byte {
+	return append([]byte{KeyPrefixAddress}, address.Bytes()...)
+}
+
+// AddressIndexKey returns the key for db entry: `(address, block number, tx index) -> tx hash`
+func AddressIndexKey(address common.Address, blockNumber int64, txIndex int32) []byte {
+	bz1 := sdk.Uint64ToBigEndian(uint64(blockNumber))
+	bz2 := sdk.Uint64ToBigEndian(uint64(txIndex))
+	return append(append(AddressIndexPrefix(address), bz1...), bz2...)
+}
+
+// ContractCreatorKey returns the key for db entry: `contract address -> tx hash`
+func ContractCreatorKey(address common.Address) []byte {
+	return append([]byte{KeyPrefixContractCreator}, address.Bytes()...)
+}
+
 // LoadLastBlock returns the latest indexed block number, returns -1 if db is empty
 func LoadLastBlock(db dbm.DB) (int64, error) {
 	it, err := db.ReverseIterator([]byte{KeyPrefixTxIndex}, []byte{KeyPrefixTxIndex + 1})
@@ -236,6 +412,44 @@ func saveTxResult(codec codec.Codec, batch dbm.Batch, txHash common.Hash, txResu
 	return nil
 }
 
+// saveAddressIndex index the tx by the sender, the recipient and the created contract into the kv db batch,
+// the creation tx of the contract is indexed too.
+func saveAddressIndex(batch dbm.Batch, ethMsg *evmtypes.MsgEthereumTx, txHash common.Hash, txResult *ethermint.TxResult) error {
+	tx := ethMsg.AsTransaction()
+	from, err := ethTxSender(ethMsg, tx)
+	if err != nil {
+		return errorsmod.Wrap(err, "recover tx sender")
+	}
+
+	addresses := []common.Address{from}
+	if to := tx.To(); to != nil {
+		if *to != from {
+			addresses = append(addresses, *to)
+		}
+	} else if !txResult.Failed {
+		contract := crypto.CreateAddress(from, tx.Nonce())
+		addresses = append(addresses, contract)
+		if err := batch.Set(ContractCreatorKey(contract), txHash.Bytes()); err != nil {
+			return errorsmod.Wrap(err, "set contract creator key")
+		}
+	}
+
+	for _, address := range addresses {
+		if err := batch.Set(AddressIndexKey(address, txResult.Height, txResult.EthTxIndex), txHash.Bytes()); err != nil {
+			return errorsmod.Wrap(err, "set address key")
+		}
+	}
+	return nil
+}
+
+// ethTxSender returns the sender of the eth tx, recovered from the signature if the msg don't carry it
+func ethTxSender(ethMsg *evmtypes.MsgEthereumTx, tx *ethtypes.Transaction) (common.Address, error) {
+	if len(ethMsg.From) > 0 {
+		return ethMsg.GetSender(), nil
+	}
+	return ethtypes.Sender(ethtypes.LatestSignerForChainID(tx.ChainId()), tx)
+}
+
 func parseBlockNumberFromKey(key []byte) (int64, error) {
 	if len(key) != TxIndexKeyLength {
 		return 0, fmt.Errorf("wrong tx index key length, expect: %d, got: %d", TxIndexKeyLength, len(key))
@@ -243,3 +457,77 @@ func parseBlockNumberFromKey(key []byte) (int64, error) {
 
 	return int64(sdk.BigEndianToUint64(key[1:9])), nil
 }
+
+// indexRangeKey returns the key for db entry: `(range prefix, first block) -> last block`
+func indexRangeKey(prefix byte, first int64) []byte {
+	return append([]byte{prefix}, sdk.Uint64ToBigEndian(uint64(first))...)
+}
+
+// loadIndexRange returns the inclusive range of contiguous blocks stored under the prefix which ends with
+// the highest indexed block, returns -1 if it's empty
+func loadIndexRange(db dbm.DB, prefix byte) (int64, int64, error) {
+	it, err := db.ReverseIterator(indexRangeKey(prefix, 0), sdk.PrefixEndBytes([]byte{prefix}))
+	if err != nil {
+		return 0, 0, err
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return -1, -1, it.Error()
+	}
+	return int64(sdk.BigEndianToUint64(it.Key()[1:])), int64(sdk.BigEndianToUint64(it.Value())), nil
+}
+
+// findIndexRange returns the range of contiguous blocks stored under the prefix which starts at or before the
+// height, returns -1 if there is none
+func findIndexRange(db dbm.DB, prefix byte, height int64) (int64, int64, error) {
+	it, err := db.ReverseIterator(indexRangeKey(prefix, 0), indexRangeKey(prefix, height+1))
+	if err != nil {
+		return 0, 0, err
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return -1, -1, it.Error()
+	}
+	return int64(sdk.BigEndianToUint64(it.Key()[1:])), int64(sdk.BigEndianToUint64(it.Value())), nil
+}
+
+// isIndexRangeCovered checks that every block of the inclusive range is indexed under the prefix
+func isIndexRangeCovered(db dbm.DB, prefix byte, fromBlock, toBlock int64) (bool, error) {
+	first, last, err := findIndexRange(db, prefix, fromBlock)
+	if err != nil {
+		return false, err
+	}
+	return first != -1 && last >= toBlock, nil
+}
+
+// saveIndexRange adds the height to the ranges of contiguous blocks stored under the prefix, the range ending
+// right before or starting right after the height is extended, and merged with the other one if they become
+// contiguous, otherwise a new range is started, so the gaps between the indexed blocks are never covered.
+func saveIndexRange(db dbm.DB, batch dbm.Batch, prefix byte, height int64) error {
+	first, last, err := findIndexRange(db, prefix, height)
+	if err != nil {
+		return errorsmod.Wrap(err, "get index range")
+	}
+	if first != -1 && last >= height {
+		return nil
+	}
+	if first == -1 || last < height-1 {
+		first = height
+	}
+	last = height
+
+	bz, err := db.Get(indexRangeKey(prefix, height+1))
+	if err != nil {
+		return errorsmod.Wrap(err, "get index range")
+	}
+	if len(bz) > 0 {
+		last = int64(sdk.BigEndianToUint64(bz))
+		if err := batch.Delete(indexRangeKey(prefix, height+1)); err != nil {
+			return errorsmod.Wrap(err, "delete index range")
+		}
+	}
+	if err := batch.Set(indexRangeKey(prefix, first), sdk.Uint64ToBigEndian(uint64(last))); err != nil {
+		return errorsmod.Wrap(err, "set index range")
+	}
+	return nil
+}
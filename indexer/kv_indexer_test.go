@@ -1,6 +1,7 @@
 package indexer_test
 
 import (
+	"encoding/json"
 	"math/big"
 	"testing"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/evmos/ethermint/app"
 	"github.com/evmos/ethermint/crypto/ethsecp256k1"
 	evmenc "github.com/evmos/ethermint/encoding"
@@ -181,3 +183,287 @@ func TestKVIndexer(t *testing.T) {
 		})
 	}
 }
+
+func TestKVIndexerAddressIndex(t *testing.T) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	signer := tests.NewSigner(priv)
+	ethSigner := ethtypes.LatestSignerForChainID(nil)
+
+	encodingConfig := evmenc.MakeConfig(app.ModuleBasics)
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	// buildBlock builds a block containing a single successful eth tx
+	buildBlock := func(height int64, nonce uint64, to *common.Address) (*tmtypes.Block, []*abci.ResponseDeliverTx, common.Hash) {
+		tx := types.NewTx(nil, nonce, to, big.NewInt(1000), 100000, nil, nil, nil, nil, nil)
+		tx.From = from.Bytes()
+		require.NoError(t, tx.Sign(ethSigner, signer))
+		txHash := tx.AsTransaction().Hash()
+		tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), "maal")
+		require.NoError(t, err)
+		txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+		require.NoError(t, err)
+		block := &tmtypes.Block{Header: tmtypes.Header{Height: height}, Data: tmtypes.Data{Txs: []tmtypes.Tx{txBz}}}
+		results := []*abci.ResponseDeliverTx{{
+			Code: 0,
+			Events: []abci.Event{
+				{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "txGasUsed", Value: "21000"},
+				}},
+			},
+		}}
+		return block, results, txHash
+	}
+
+	to := common.BigToAddress(big.NewInt(1))
+	block1, results1, hash1 := buildBlock(1, 0, &to)
+	block3, results3, hash3 := buildBlock(3, 1, nil)
+	contract := crypto.CreateAddress(from, 1)
+
+	idxer := indexer.NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), clientCtx, indexer.WithAddressIndex(true))
+	require.NoError(t, idxer.IndexBlock(block3, results3))
+	require.NoError(t, idxer.IndexBlock(block1, results1))
+	// the gap at block 2 isn't covered
+	first, last, err := idxer.AddressIndexRange()
+	require.NoError(t, err)
+	require.Equal(t, int64(3), first)
+	require.Equal(t, int64(3), last)
+	require.NoError(t, idxer.IndexBlock(&tmtypes.Block{Header: tmtypes.Header{Height: 2}}, nil))
+	first, last, err = idxer.AddressIndexRange()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), first)
+	require.Equal(t, int64(3), last)
+
+	testCases := []struct {
+		name      string
+		address   common.Address
+		fromBlock int64
+		toBlock   int64
+		offset    int
+		limit     int
+		expHashes []common.Hash
+	}{
+		{"sender", from, 0, 10, 0, 10, []common.Hash{hash1, hash3}},
+		{"recipient", to, 0, 10, 0, 10, []common.Hash{hash1}},
+		{"created contract", contract, 0, 10, 0, 10, []common.Hash{hash3}},
+		{"block range", from, 2, 3, 0, 10, []common.Hash{hash3}},
+		{"block range, inclusive", from, 1, 1, 0, 10, []common.Hash{hash1}},
+		{"limit", from, 0, 10, 0, 1, []common.Hash{hash1}},
+		{"offset", from, 0, 10, 1, 1, []common.Hash{hash3}},
+		{"offset out of range", from, 0, 10, 2, 1, nil},
+		{"unknown address", common.BigToAddress(big.NewInt(2)), 0, 10, 0, 10, nil},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			hashes, err := idxer.GetByAddress(tc.address, tc.fromBlock, tc.toBlock, tc.offset, tc.limit)
+			require.NoError(t, err)
+			require.Equal(t, tc.expHashes, hashes)
+		})
+	}
+
+	_, err = idxer.GetByAddress(from, 3, 1, 0, 10)
+	require.Error(t, err)
+
+	searchCases := []struct {
+		name       string
+		address    common.Address
+		block      int64
+		reverse    bool
+		pageSize   int
+		expHashes  []common.Hash
+		expHasMore bool
+	}{
+		{"before latest", from, 4, true, 10, []common.Hash{hash3, hash1}, false},
+		{"before, paginated", from, 4, true, 1, []common.Hash{hash3}, true},
+		{"before, block excluded", from, 3, true, 10, []common.Hash{hash1}, false},
+		{"after earliest", from, 0, false, 10, []common.Hash{hash1, hash3}, false},
+		{"after, paginated", from, 0, false, 1, []common.Hash{hash1}, true},
+		{"after, block excluded", from, 1, false, 10, []common.Hash{hash3}, false},
+		{"created contract", contract, 4, true, 10, []common.Hash{hash3}, false},
+		{"unknown address", common.BigToAddress(big.NewInt(2)), 4, true, 10, nil, false},
+	}
+	for _, tc := range searchCases {
+		t.Run(tc.name, func(t *testing.T) {
+			hashes, hasMore, err := idxer.SearchByAddress(tc.address, tc.block, tc.reverse, tc.pageSize)
+			require.NoError(t, err)
+			require.Equal(t, tc.expHashes, hashes)
+			require.Equal(t, tc.expHasMore, hasMore)
+		})
+	}
+
+	creation, err := idxer.GetContractCreation(contract)
+	require.NoError(t, err)
+	require.Equal(t, hash3, *creation)
+	creation, err = idxer.GetContractCreation(to)
+	require.NoError(t, err)
+	require.Nil(t, creation)
+
+	// the address index is disabled by default
+	idxer = indexer.NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), clientCtx)
+	require.NoError(t, idxer.IndexBlock(block1, results1))
+	first, _, err = idxer.AddressIndexRange()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), first)
+	_, err = idxer.GetByAddress(from, 0, 10, 0, 10)
+	require.ErrorIs(t, err, indexer.ErrAddressIndexDisabled)
+	_, _, err = idxer.SearchByAddress(from, 0, false, 10)
+	require.ErrorIs(t, err, indexer.ErrAddressIndexDisabled)
+	_, err = idxer.GetContractCreation(contract)
+	require.ErrorIs(t, err, indexer.ErrAddressIndexDisabled)
+}
+
+func TestKVIndexerLogIndex(t *testing.T) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	signer := tests.NewSigner(priv)
+	ethSigner := ethtypes.LatestSignerForChainID(nil)
+
+	encodingConfig := evmenc.MakeConfig(app.ModuleBasics)
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	contract1 := common.BigToAddress(big.NewInt(1))
+	contract2 := common.BigToAddress(big.NewInt(2))
+	topicA := common.BigToHash(big.NewInt(0xa))
+	topicB := common.BigToHash(big.NewInt(0xb))
+	topicC := common.BigToHash(big.NewInt(0xc))
+
+	// buildBlock builds a block containing a single successful eth tx emitting the logs
+	buildBlock := func(height int64, nonce uint64, logs []*ethtypes.Log) (*tmtypes.Block, []*abci.ResponseDeliverTx) {
+		tx := types.NewTx(nil, nonce, &contract1, big.NewInt(0), 100000, nil, nil, nil, nil, nil)
+		tx.From = from.Bytes()
+		require.NoError(t, tx.Sign(ethSigner, signer))
+		txHash := tx.AsTransaction().Hash()
+		tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), "maal")
+		require.NoError(t, err)
+		txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+		require.NoError(t, err)
+
+		var logAttrs []abci.EventAttribute
+		for i, log := range logs {
+			log.BlockNumber = uint64(height)
+			log.TxHash = txHash
+			log.Index = uint(i)
+			bz, err := json.Marshal(types.NewLogFromEth(log))
+			require.NoError(t, err)
+			logAttrs = append(logAttrs, abci.EventAttribute{Key: types.AttributeKeyTxLog, Value: string(bz)})
+		}
+		block := &tmtypes.Block{Header: tmtypes.Header{Height: height}, Data: tmtypes.Data{Txs: []tmtypes.Tx{txBz}}}
+		results := []*abci.ResponseDeliverTx{{
+			Code: 0,
+			Events: []abci.Event{
+				{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "txGasUsed", Value: "21000"},
+				}},
+				{Type: types.EventTypeTxLog, Attributes: logAttrs},
+			},
+		}}
+		return block, results
+	}
+
+	block1, results1 := buildBlock(1, 0, []*ethtypes.Log{
+		{Address: contract1, Topics: []common.Hash{topicA, topicB}},
+		{Address: contract2, Topics: []common.Hash{topicB}},
+	})
+	block2, results2 := buildBlock(2, 1, nil)
+	block3, results3 := buildBlock(3, 2, []*ethtypes.Log{
+		{Address: contract2, Topics: []common.Hash{topicA, topicC}, Data: []byte{1}},
+	})
+
+	idxer := indexer.NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), clientCtx, indexer.WithLogIndex(true))
+	require.NoError(t, idxer.IndexBlock(block2, results2))
+	require.NoError(t, idxer.IndexBlock(block3, results3))
+
+	// block 1 is not indexed yet
+	_, err = idxer.GetLogs(1, 3, nil, nil, 10)
+	require.ErrorIs(t, err, indexer.ErrLogRangeNotIndexed)
+
+	// backfill
+	require.NoError(t, idxer.IndexBlock(block1, results1))
+	first, last, err := idxer.LogIndexRange()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), first)
+	require.Equal(t, int64(3), last)
+
+	type logID struct {
+		height uint64
+		index  uint
+	}
+	testCases := []struct {
+		name      string
+		fromBlock int64
+		toBlock   int64
+		addresses []common.Address
+		topics    [][]common.Hash
+		expLogs   []logID
+	}{
+		{"all", 1, 3, nil, nil, []logID{{1, 0}, {1, 1}, {3, 0}}},
+		{"block range", 2, 3, nil, nil, []logID{{3, 0}}},
+		{"address", 1, 3, []common.Address{contract2}, nil, []logID{{1, 1}, {3, 0}}},
+		{"addresses", 1, 3, []common.Address{contract2, contract1}, nil, []logID{{1, 0}, {1, 1}, {3, 0}}},
+		{"first topic", 1, 3, nil, [][]common.Hash{{topicA}}, []logID{{1, 0}, {3, 0}}},
+		{"positional topics", 1, 3, nil, [][]common.Hash{{topicB}}, []logID{{1, 1}}},
+		{"wildcard topic", 1, 3, nil, [][]common.Hash{{}, {topicB, topicC}}, []logID{{1, 0}, {3, 0}}},
+		{"address and topics", 1, 3, []common.Address{contract2}, [][]common.Hash{{topicA}, {topicC}}, []logID{{3, 0}}},
+		{"no match", 1, 3, []common.Address{contract1}, [][]common.Hash{{topicC}}, nil},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			logs, err := idxer.GetLogs(tc.fromBlock, tc.toBlock, tc.addresses, tc.topics, 10)
+			require.NoError(t, err)
+			require.Len(t, logs, len(tc.expLogs))
+			for i, exp := range tc.expLogs {
+				require.Equal(t, exp.height, logs[i].BlockNumber)
+				require.Equal(t, exp.index, logs[i].Index)
+			}
+		})
+	}
+
+	logs, err := idxer.GetLogs(3, 3, nil, nil, 10)
+	require.NoError(t, err)
+	require.Equal(t, contract2, logs[0].Address)
+	require.Equal(t, []common.Hash{topicA, topicC}, logs[0].Topics)
+	require.Equal(t, []byte{1}, logs[0].Data)
+
+	_, err = idxer.GetLogs(1, 3, nil, nil, 2)
+	require.Error(t, err)
+	_, err = idxer.GetLogs(1, 4, nil, nil, 10)
+	require.ErrorIs(t, err, indexer.ErrLogRangeNotIndexed)
+
+	// the blocks indexed around a gap don't cover it
+	idxer = indexer.NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), clientCtx, indexer.WithLogIndex(true))
+	require.NoError(t, idxer.IndexBlock(block1, results1))
+	require.NoError(t, idxer.IndexBlock(block3, results3))
+	first, last, err = idxer.LogIndexRange()
+	require.NoError(t, err)
+	require.Equal(t, int64(3), first)
+	require.Equal(t, int64(3), last)
+	_, err = idxer.GetLogs(1, 3, nil, nil, 10)
+	require.ErrorIs(t, err, indexer.ErrLogRangeNotIndexed)
+	_, err = idxer.GetLogs(2, 2, nil, nil, 10)
+	require.ErrorIs(t, err, indexer.ErrLogRangeNotIndexed)
+	logs, err = idxer.GetLogs(1, 1, nil, nil, 10)
+	require.NoError(t, err)
+	require.Len(t, logs, 2)
+
+	// filling the gap merges the ranges
+	require.NoError(t, idxer.IndexBlock(block2, results2))
+	first, last, err = idxer.LogIndexRange()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), first)
+	require.Equal(t, int64(3), last)
+	logs, err = idxer.GetLogs(1, 3, nil, nil, 10)
+	require.NoError(t, err)
+	require.Len(t, logs, 3)
+
+	// the log index is disabled by default
+	idxer = indexer.NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), clientCtx)
+	require.NoError(t, idxer.IndexBlock(block1, results1))
+	_, err = idxer.GetLogs(1, 1, nil, nil, 10)
+	require.ErrorIs(t, err, indexer.ErrLogIndexDisabled)
+}
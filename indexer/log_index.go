@@ -0,0 +1,279 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package indexer
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+const (
+	// KeyPrefixLog is the prefix of the entries `(block number, log index) -> log`
+	KeyPrefixLog = 4
+	// KeyPrefixLogAddress is the prefix of the entries `(address, block number, log index) -> nil`
+	KeyPrefixLogAddress = 5
+	// KeyPrefixLogTopic is the prefix of the entries `(topic position, topic, block number, log index) -> nil`
+	KeyPrefixLogTopic = 6
+	// KeyPrefixLogRange is the prefix of the ranges of contiguous blocks covered by the log index
+	KeyPrefixLogRange = 7
+
+	// maxTopics is the maximum number of topics of a log
+	maxTopics = 4
+)
+
+var (
+	// ErrLogIndexDisabled is returned when querying the log index while it's not enabled.
+	ErrLogIndexDisabled = errors.New("log index is not enabled")
+	// ErrLogRangeNotIndexed is returned when the queried block range is not fully covered by the log index.
+	ErrLogRangeNotIndexed = errors.New("block range is not covered by the log index")
+)
+
+// logPosition identifies a log in the chain
+type logPosition struct {
+	height int64
+	index  uint64
+}
+
+func (p logPosition) bytes() []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(p.height)), sdk.Uint64ToBigEndian(p.index)...)
+}
+
+func parseLogPosition(bz []byte) logPosition {
+	return logPosition{
+		height: int64(sdk.BigEndianToUint64(bz[:8])),
+		index:  sdk.BigEndianToUint64(bz[8:16]),
+	}
+}
+
+// GetLogs returns the logs matching the filter criteria in the inclusive block range, with the same
+// semantic as `eth_getLogs`: the addresses are OR-ed, the topics are positional, each position is OR-ed
+// and an empty position matches any topic. It returns an error if there are more than `limit` results.
+func (kv *KVIndexer) GetLogs(
+	fromBlock, toBlock int64,
+	addresses []common.Address,
+	topics [][]common.Hash,
+	limit int,
+) ([]*ethtypes.Log, error) {
+	if !kv.logIndex {
+		return nil, ErrLogIndexDisabled
+	}
+	if fromBlock < 0 || toBlock < fromBlock {
+		return nil, fmt.Errorf("invalid block range [%d, %d]", fromBlock, toBlock)
+	}
+	if len(topics) > maxTopics {
+		return []*ethtypes.Log{}, nil
+	}
+	covered, err := isIndexRangeCovered(kv.db, KeyPrefixLogRange, fromBlock, toBlock)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "GetLogs")
+	}
+	if !covered {
+		return nil, ErrLogRangeNotIndexed
+	}
+
+	// the postings of each criteria, the first one drives the iteration, the others are checked by lookups.
+	var criteria [][][]byte
+	if len(addresses) > 0 {
+		prefixes := make([][]byte, len(addresses))
+		for i, address := range addresses {
+			prefixes[i] = LogAddressPrefix(address)
+		}
+		criteria = append(criteria, prefixes)
+	}
+	for i, alternatives := range topics {
+		if len(alternatives) == 0 {
+			continue
+		}
+		prefixes := make([][]byte, len(alternatives))
+		for j, topic := range alternatives {
+			prefixes[j] = LogTopicPrefix(i, topic)
+		}
+		criteria = append(criteria, prefixes)
+	}
+
+	var positions []logPosition
+	if len(criteria) == 0 {
+		positions, err = kv.scanPositions([]byte{KeyPrefixLog}, fromBlock, toBlock)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		for _, prefix := range criteria[0] {
+			found, err := kv.scanPositions(prefix, fromBlock, toBlock)
+			if err != nil {
+				return nil, err
+			}
+			positions = append(positions, found...)
+		}
+		if len(criteria[0]) > 1 {
+			positions = sortPositions(positions)
+		}
+	}
+
+	logs := []*ethtypes.Log{}
+	for _, pos := range positions {
+		match, err := kv.matchCriteria(criteria, pos)
+		if err != nil {
+			return nil, err
+		}
+		if !match {
+			continue
+		}
+		if len(logs) == limit {
+			return nil, fmt.Errorf("query returned more than %d results", limit)
+		}
+		log, err := kv.getLog(pos)
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, log)
+	}
+	return logs, nil
+}
+
+// LogIndexRange returns the inclusive range of contiguous blocks covered by the log index which ends with the
+// latest indexed block, returns -1 if it's empty
+func (kv *KVIndexer) LogIndexRange() (int64, int64, error) {
+	first, last, err := loadIndexRange(kv.db, KeyPrefixLogRange)
+	if err != nil {
+		return 0, 0, errorsmod.Wrap(err, "LogIndexRange")
+	}
+	return first, last, nil
+}
+
+// scanPositions returns the log positions of the entries under the prefix, in the block range
+func (kv *KVIndexer) scanPositions(prefix []byte, fromBlock, toBlock int64) ([]logPosition, error) {
+	start := append(append([]byte{}, prefix...), sdk.Uint64ToBigEndian(uint64(fromBlock))...)
+	end := append(append([]byte{}, prefix...), sdk.Uint64ToBigEndian(uint64(toBlock)+1)...)
+	it, err := kv.db.Iterator(start, end)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "scan log index")
+	}
+	defer it.Close()
+
+	var positions []logPosition
+	for ; it.Valid(); it.Next() {
+		positions = append(positions, parseLogPosition(it.Key()[len(prefix):]))
+	}
+	return positions, it.Error()
+}
+
+// matchCriteria checks the log position against the criteria except the first one, which drives the scan
+func (kv *KVIndexer) matchCriteria(criteria [][][]byte, pos logPosition) (bool, error) {
+	if len(criteria) < 2 {
+		return true, nil
+	}
+	suffix := pos.bytes()
+	for _, prefixes := range criteria[1:] {
+		found := false
+		for _, prefix := range prefixes {
+			has, err := kv.db.Has(append(append([]byte{}, prefix...), suffix...))
+			if err != nil {
+				return false, errorsmod.Wrap(err, "lookup log index")
+			}
+			if has {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (kv *KVIndexer) getLog(pos logPosition) (*ethtypes.Log, error) {
+	bz, err := kv.db.Get(LogKey(pos.height, pos.index))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "get log %d %d", pos.height, pos.index)
+	}
+	if len(bz) == 0 {
+		return nil, fmt.Errorf("log not found, block: %d, index: %d", pos.height, pos.index)
+	}
+	var log evmtypes.Log
+	if err := kv.clientCtx.Codec.Unmarshal(bz, &log); err != nil {
+		return nil, errorsmod.Wrapf(err, "get log %d %d", pos.height, pos.index)
+	}
+	return log.ToEthereum(), nil
+}
+
+// sortPositions sorts the positions by block number and log index and removes the duplicates
+func sortPositions(positions []logPosition) []logPosition {
+	sort.Slice(positions, func(i, j int) bool {
+		return bytes.Compare(positions[i].bytes(), positions[j].bytes()) < 0
+	})
+	result := positions[:0]
+	for i, pos := range positions {
+		if i > 0 && pos == positions[i-1] {
+			continue
+		}
+		result = append(result, pos)
+	}
+	return result
+}
+
+// LogKey returns the key for db entry: `(block number, log index) -> log`
+func LogKey(blockNumber int64, logIndex uint64) []byte {
+	return append([]byte{KeyPrefixLog}, logPosition{blockNumber, logIndex}.bytes()...)
+}
+
+// LogAddressPrefix returns the prefix of the log index entries of an address
+func LogAddressPrefix(address common.Address) []byte {
+	return append([]byte{KeyPrefixLogAddress}, address.Bytes()...)
+}
+
+// LogTopicPrefix returns the prefix of the log index entries of a topic at a position
+func LogTopicPrefix(position int, topic common.Hash) []byte {
+	return append([]byte{KeyPrefixLogTopic, byte(position)}, topic.Bytes()...)
+}
+
+// saveLogs index the logs of a block into the kv db batch
+func saveLogs(codec codec.Codec, batch dbm.Batch, height int64, logs []*ethtypes.Log) error {
+	for _, log := range logs {
+		pos := logPosition{height, uint64(log.Index)}.bytes()
+		bz, err := codec.Marshal(evmtypes.NewLogFromEth(log))
+		if err != nil {
+			return errorsmod.Wrap(err, "marshal log")
+		}
+		if err := batch.Set(append([]byte{KeyPrefixLog}, pos...), bz); err != nil {
+			return errorsmod.Wrap(err, "set log key")
+		}
+		if err := batch.Set(append(LogAddressPrefix(log.Address), pos...), []byte{}); err != nil {
+			return errorsmod.Wrap(err, "set log address key")
+		}
+		for i, topic := range log.Topics {
+			if i >= maxTopics {
+				break
+			}
+			if err := batch.Set(append(LogTopicPrefix(i, topic), pos...), []byte{}); err != nil {
+				return errorsmod.Wrap(err, "set log topic key")
+			}
+		}
+	}
+	return nil
+}
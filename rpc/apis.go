@@ -24,12 +24,15 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/evmos/ethermint/rpc/backend"
+	"github.com/evmos/ethermint/rpc/namespaces/cosmos"
 	"github.com/evmos/ethermint/rpc/namespaces/ethereum/debug"
 	"github.com/evmos/ethermint/rpc/namespaces/ethereum/eth"
 	"github.com/evmos/ethermint/rpc/namespaces/ethereum/eth/filters"
 	"github.com/evmos/ethermint/rpc/namespaces/ethereum/miner"
 	"github.com/evmos/ethermint/rpc/namespaces/ethereum/net"
+	"github.com/evmos/ethermint/rpc/namespaces/ethereum/ots"
 	"github.com/evmos/ethermint/rpc/namespaces/ethereum/personal"
+	"github.com/evmos/ethermint/rpc/namespaces/ethereum/trace"
 	"github.com/evmos/ethermint/rpc/namespaces/ethereum/txpool"
 	"github.com/evmos/ethermint/rpc/namespaces/ethereum/web3"
 	"github.com/evmos/ethermint/rpc/stream"
@@ -51,6 +54,8 @@ const (
 	TxPoolNamespace   = "txpool"
 	DebugNamespace    = "debug"
 	MinerNamespace    = "miner"
+	TraceNamespace    = "trace"
+	OtsNamespace      = "ots"
 
 	apiVersion = "1.0"
 )
@@ -62,6 +67,7 @@ type APICreator = func(
 	stream *stream.RPCStream,
 	allowUnprotectedTxs bool,
 	indexer ethermint.EVMTxIndexer,
+	cache *backend.Cache,
 ) []rpc.API
 
 // apiCreators defines the JSON-RPC API namespaces.
@@ -74,8 +80,9 @@ func init() {
 			stream *stream.RPCStream,
 			allowUnprotectedTxs bool,
 			indexer ethermint.EVMTxIndexer,
+			cache *backend.Cache,
 		) []rpc.API {
-			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, cache)
 			return []rpc.API{
 				{
 					Namespace: EthNamespace,
@@ -91,7 +98,7 @@ func init() {
 				},
 			}
 		},
-		Web3Namespace: func(*server.Context, client.Context, *stream.RPCStream, bool, ethermint.EVMTxIndexer) []rpc.API {
+		Web3Namespace: func(*server.Context, client.Context, *stream.RPCStream, bool, ethermint.EVMTxIndexer, *backend.Cache) []rpc.API {
 			return []rpc.API{
 				{
 					Namespace: Web3Namespace,
@@ -101,7 +108,7 @@ func init() {
 				},
 			}
 		},
-		NetNamespace: func(_ *server.Context, clientCtx client.Context, _ *stream.RPCStream, _ bool, _ ethermint.EVMTxIndexer) []rpc.API {
+		NetNamespace: func(_ *server.Context, clientCtx client.Context, _ *stream.RPCStream, _ bool, _ ethermint.EVMTxIndexer, _ *backend.Cache) []rpc.API {
 			return []rpc.API{
 				{
 					Namespace: NetNamespace,
@@ -116,8 +123,9 @@ func init() {
 			_ *stream.RPCStream,
 			allowUnprotectedTxs bool,
 			indexer ethermint.EVMTxIndexer,
+			cache *backend.Cache,
 		) []rpc.API {
-			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, cache)
 			return []rpc.API{
 				{
 					Namespace: PersonalNamespace,
@@ -127,12 +135,36 @@ func init() {
 				},
 			}
 		},
-		TxPoolNamespace: func(ctx *server.Context, _ client.Context, _ *stream.RPCStream, _ bool, _ ethermint.EVMTxIndexer) []rpc.API {
+		CosmosNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *stream.RPCStream,
+			allowUnprotectedTxs bool,
+			indexer ethermint.EVMTxIndexer,
+			cache *backend.Cache,
+		) []rpc.API {
+			cosmosBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, cache)
+			return []rpc.API{
+				{
+					Namespace: CosmosNamespace,
+					Version:   apiVersion,
+					Service:   cosmos.NewPublicAPI(ctx.Logger, cosmosBackend),
+					Public:    true,
+				},
+			}
+		},
+		TxPoolNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *stream.RPCStream,
+			allowUnprotectedTxs bool,
+			indexer ethermint.EVMTxIndexer,
+			cache *backend.Cache,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, cache)
 			return []rpc.API{
 				{
 					Namespace: TxPoolNamespace,
 					Version:   apiVersion,
-					Service:   txpool.NewPublicAPI(ctx.Logger),
+					Service:   txpool.NewPublicAPI(ctx.Logger, evmBackend),
 					Public:    true,
 				},
 			}
@@ -142,8 +174,9 @@ func init() {
 			_ *stream.RPCStream,
 			allowUnprotectedTxs bool,
 			indexer ethermint.EVMTxIndexer,
+			cache *backend.Cache,
 		) []rpc.API {
-			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, cache)
 			return []rpc.API{
 				{
 					Namespace: DebugNamespace,
@@ -153,13 +186,48 @@ func init() {
 				},
 			}
 		},
+		TraceNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *stream.RPCStream,
+			allowUnprotectedTxs bool,
+			indexer ethermint.EVMTxIndexer,
+			cache *backend.Cache,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, cache)
+			return []rpc.API{
+				{
+					Namespace: TraceNamespace,
+					Version:   apiVersion,
+					Service:   trace.NewAPI(ctx.Logger, evmBackend),
+					Public:    true,
+				},
+			}
+		},
+		OtsNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *stream.RPCStream,
+			allowUnprotectedTxs bool,
+			indexer ethermint.EVMTxIndexer,
+			cache *backend.Cache,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, cache)
+			return []rpc.API{
+				{
+					Namespace: OtsNamespace,
+					Version:   apiVersion,
+					Service:   ots.NewAPI(ctx.Logger, evmBackend),
+					Public:    true,
+				},
+			}
+		},
 		MinerNamespace: func(ctx *server.Context,
 			clientCtx client.Context,
 			_ *stream.RPCStream,
 			allowUnprotectedTxs bool,
 			indexer ethermint.EVMTxIndexer,
+			cache *backend.Cache,
 		) []rpc.API {
-			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, cache)
 			return []rpc.API{
 				{
 					Namespace: MinerNamespace,
@@ -178,13 +246,14 @@ func GetRPCAPIs(ctx *server.Context,
 	stream *stream.RPCStream,
 	allowUnprotectedTxs bool,
 	indexer ethermint.EVMTxIndexer,
+	cache *backend.Cache,
 	selectedAPIs []string,
 ) []rpc.API {
 	var apis []rpc.API
 
 	for _, ns := range selectedAPIs {
 		if creator, ok := apiCreators[ns]; ok {
-			apis = append(apis, creator(ctx, clientCtx, stream, allowUnprotectedTxs, indexer)...)
+			apis = append(apis, creator(ctx, clientCtx, stream, allowUnprotectedTxs, indexer, cache)...)
 		} else {
 			ctx.Logger.Error("invalid namespace value", "namespace", ns)
 		}
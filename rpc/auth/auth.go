@@ -0,0 +1,168 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/evmos/ethermint/rpc/ratelimit"
+)
+
+// maxIATDelta is the accepted difference between the issued-at claim of a token and the local time,
+// it's the same as the go-ethereum authenticated endpoints.
+const maxIATDelta = 60 * time.Second
+
+// Authenticator authenticates the requests with a JWT bearer token signed with a shared HS256 secret,
+// or with a static API key.
+type Authenticator struct {
+	secret  []byte
+	apiKeys [][]byte
+	now     func() time.Time
+}
+
+// New creates an Authenticator, the secret is read from the jwtSecretPath file if set. A request is
+// accepted if it's authenticated by any of the configured methods.
+func New(jwtSecretPath string, apiKeys []string) (*Authenticator, error) {
+	a := &Authenticator{now: time.Now}
+	if jwtSecretPath != "" {
+		bz, err := os.ReadFile(jwtSecretPath) // #nosec G304 -- the path is set by the node operator
+		if err != nil {
+			return nil, fmt.Errorf("failed to read jwt secret: %w", err)
+		}
+		secret, err := hexutil.Decode("0x" + strings.TrimPrefix(strings.TrimSpace(string(bz)), "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid jwt secret in %s: %w", jwtSecretPath, err)
+		}
+		if len(secret) < 32 {
+			return nil, fmt.Errorf("jwt secret in %s must be at least 32 bytes", jwtSecretPath)
+		}
+		a.secret = secret
+	}
+	for _, key := range apiKeys {
+		a.apiKeys = append(a.apiKeys, []byte(key))
+	}
+	return a, nil
+}
+
+// Enabled returns true if the requests need to be authenticated.
+func (a *Authenticator) Enabled() bool {
+	return len(a.secret) > 0 || len(a.apiKeys) > 0
+}
+
+// Middleware rejects the requests that are not authenticated.
+func (a *Authenticator) Middleware(next http.Handler) http.Handler {
+	if !a.Enabled() {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := a.Authenticate(r); err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// Authenticate returns an error if the request doesn't carry a valid token or API key.
+func (a *Authenticator) Authenticate(r *http.Request) error {
+	if key := r.Header.Get(ratelimit.APIKeyHeader); key != "" {
+		for _, apiKey := range a.apiKeys {
+			if subtle.ConstantTimeCompare([]byte(key), apiKey) == 1 {
+				return nil
+			}
+		}
+		if len(a.secret) == 0 {
+			return errors.New("invalid api key")
+		}
+	}
+
+	if len(a.secret) == 0 {
+		return errors.New("missing api key")
+	}
+	auth := r.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "Bearer ") {
+		return errors.New("missing bearer token")
+	}
+	return a.verifyToken(strings.TrimPrefix(auth, "Bearer "))
+}
+
+// verifyToken checks the HS256 signature and the issued-at claim of the token.
+func (a *Authenticator) verifyToken(token string) error {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return errors.New("malformed token")
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return fmt.Errorf("invalid token header: %w", err)
+	}
+	if header.Alg != "HS256" {
+		return fmt.Errorf("unsupported token algorithm %s", header.Alg)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return errors.New("invalid token signature")
+	}
+	mac := hmac.New(sha256.New, a.secret)
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return errors.New("invalid token signature")
+	}
+
+	var claims struct {
+		IssuedAt  *int64 `json:"iat"`
+		ExpiresAt *int64 `json:"exp"`
+	}
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return fmt.Errorf("invalid token claims: %w", err)
+	}
+	now := a.now()
+	if claims.IssuedAt == nil {
+		return errors.New("missing issued-at claim")
+	}
+	if delta := now.Sub(time.Unix(*claims.IssuedAt, 0)); delta > maxIATDelta || delta < -maxIATDelta {
+		return errors.New("stale token")
+	}
+	if claims.ExpiresAt != nil && !now.Before(time.Unix(*claims.ExpiresAt, 0)) {
+		return errors.New("expired token")
+	}
+	return nil
+}
+
+// decodeSegment decodes a base64url encoded JSON segment of a token.
+func decodeSegment(segment string, v interface{}) error {
+	bz, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bz, v)
+}
@@ -0,0 +1,153 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/rpc/ratelimit"
+)
+
+var secret = []byte("0123456789abcdef0123456789abcdef")
+
+func signToken(header, claims string, key []byte) string {
+	payload := base64.RawURLEncoding.EncodeToString([]byte(header)) + "." +
+		base64.RawURLEncoding.EncodeToString([]byte(claims))
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(payload))
+	return payload + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func newTestAuthenticator(t *testing.T, withSecret bool, apiKeys []string) *Authenticator {
+	path := ""
+	if withSecret {
+		path = filepath.Join(t.TempDir(), "jwt.hex")
+		require.NoError(t, os.WriteFile(path, []byte("0x"+hex.EncodeToString(secret)+"\n"), 0o600))
+	}
+	a, err := New(path, apiKeys)
+	require.NoError(t, err)
+	a.now = func() time.Time { return time.Unix(1700000000, 0) }
+	return a
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(filepath.Join(t.TempDir(), "missing"), nil)
+	require.Error(t, err)
+
+	path := filepath.Join(t.TempDir(), "jwt.hex")
+	require.NoError(t, os.WriteFile(path, []byte("0x1234"), 0o600))
+	_, err = New(path, nil)
+	require.Error(t, err, "short secret")
+
+	a, err := New("", nil)
+	require.NoError(t, err)
+	require.False(t, a.Enabled())
+}
+
+func TestAuthenticate(t *testing.T) {
+	header := `{"alg":"HS256","typ":"JWT"}`
+
+	testCases := []struct {
+		name      string
+		withJWT   bool
+		apiKeys   []string
+		setHeader func(r *http.Request)
+		expPass   bool
+	}{
+		{
+			"valid token",
+			true, nil,
+			func(r *http.Request) {
+				r.Header.Set("Authorization", "Bearer "+signToken(header, `{"iat":1700000010}`, secret))
+			},
+			true,
+		},
+		{
+			"missing token",
+			true, nil,
+			func(r *http.Request) {},
+			false,
+		},
+		{
+			"invalid signature",
+			true, nil,
+			func(r *http.Request) {
+				r.Header.Set("Authorization", "Bearer "+signToken(header, `{"iat":1700000010}`, []byte("other")))
+			},
+			false,
+		},
+		{
+			"unsupported algorithm",
+			true, nil,
+			func(r *http.Request) {
+				r.Header.Set("Authorization", "Bearer "+signToken(`{"alg":"none"}`, `{"iat":1700000010}`, secret))
+			},
+			false,
+		},
+		{
+			"stale token",
+			true, nil,
+			func(r *http.Request) {
+				r.Header.Set("Authorization", "Bearer "+signToken(header, `{"iat":1699999000}`, secret))
+			},
+			false,
+		},
+		{
+			"expired token",
+			true, nil,
+			func(r *http.Request) {
+				r.Header.Set("Authorization", "Bearer "+signToken(header, `{"iat":1700000000,"exp":1700000000}`, secret))
+			},
+			false,
+		},
+		{
+			"valid api key",
+			false, []string{"key1", "key2"},
+			func(r *http.Request) { r.Header.Set(ratelimit.APIKeyHeader, "key2") },
+			true,
+		},
+		{
+			"invalid api key",
+			false, []string{"key1"},
+			func(r *http.Request) { r.Header.Set(ratelimit.APIKeyHeader, "key2") },
+			false,
+		},
+		{
+			"token accepted when api keys are configured too",
+			true, []string{"key1"},
+			func(r *http.Request) {
+				r.Header.Set("Authorization", "Bearer "+signToken(header, `{"iat":1700000000}`, secret))
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			a := newTestAuthenticator(t, tc.withJWT, tc.apiKeys)
+			require.True(t, a.Enabled())
+
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			tc.setHeader(req)
+			rec := httptest.NewRecorder()
+			a.Middleware(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})).ServeHTTP(rec, req)
+
+			if tc.expPass {
+				require.Equal(t, http.StatusOK, rec.Code)
+			} else {
+				require.Equal(t, http.StatusUnauthorized, rec.Code)
+			}
+		})
+	}
+}
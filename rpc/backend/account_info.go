@@ -25,6 +25,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	rpctypes "github.com/evmos/ethermint/rpc/types"
@@ -132,6 +133,124 @@ func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNr
 	}, nil
 }
 
+// GetVerifiableProof returns an account object with the proofs of its balance, nonce, code hash and
+// storage slots against the app hash committing to the state at the block, which is part of the header of
+// the next block. Hence the latest verifiable state is the one of the block preceding the latest block.
+func (b *Backend) GetVerifiableProof(
+	address common.Address,
+	storageKeys []string,
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+) (*rpctypes.VerifiableAccountResult, error) {
+	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	height := blockNum.Int64()
+	if height == 0 {
+		bn, err := b.BlockNumber()
+		if err != nil {
+			return nil, err
+		}
+
+		if bn > math.MaxInt64 {
+			return nil, fmt.Errorf("not able to query block number greater than MaxInt64")
+		}
+
+		height = int64(bn) - 1
+	}
+
+	resBlock, err := b.TendermintBlockByNumber(rpctypes.BlockNumber(height + 1))
+	if err != nil || resBlock == nil {
+		return nil, fmt.Errorf("app hash of the state at height %d not found", height)
+	}
+
+	ctx := rpctypes.ContextWithHeight(height)
+	clientCtx := b.clientCtx.WithHeight(height)
+
+	params, err := b.queryClient.Params(ctx, &evmtypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := b.queryClient.Account(ctx, &evmtypes.QueryAccountRequest{Address: address.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	balance, ok := sdkmath.NewIntFromString(res.Balance)
+	if !ok {
+		return nil, errors.New("invalid balance")
+	}
+
+	// query the proofs of the auth account and of the bank balance
+	accAddr := sdk.AccAddress(address.Bytes())
+	accountBz, accountProof, err := b.queryClient.GetProof(clientCtx, authtypes.StoreKey, authtypes.AddressStoreKey(accAddr))
+	if err != nil {
+		return nil, err
+	}
+
+	balanceKey := append(banktypes.CreateAccountBalancesPrefix(accAddr), []byte(params.Params.EvmDenom)...)
+	balanceBz, balanceProof, err := b.queryClient.GetProof(clientCtx, banktypes.StoreKey, balanceKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// query storage proofs
+	storageProofs := make([]rpctypes.VerifiableStorageResult, len(storageKeys))
+	for i, key := range storageKeys {
+		hexKey := common.HexToHash(key)
+		valueBz, proof, err := b.queryClient.GetProof(clientCtx, evmtypes.StoreKey, evmtypes.StateKey(address, hexKey.Bytes()))
+		if err != nil {
+			return nil, err
+		}
+
+		storageProofs[i] = rpctypes.VerifiableStorageResult{
+			Key:   hexKey,
+			Value: common.BytesToHash(valueBz),
+			Proof: rpctypes.NewStoreProof(valueBz, proof),
+		}
+	}
+
+	return &rpctypes.VerifiableAccountResult{
+		Address:      address,
+		Height:       hexutil.Uint64(height),
+		AppHash:      hexutil.Bytes(resBlock.Block.AppHash),
+		Balance:      (*hexutil.Big)(balance.BigInt()),
+		Denom:        params.Params.EvmDenom,
+		CodeHash:     common.HexToHash(res.CodeHash),
+		Nonce:        hexutil.Uint64(res.Nonce),
+		AccountProof: rpctypes.NewStoreProof(accountBz, accountProof),
+		BalanceProof: rpctypes.NewStoreProof(balanceBz, balanceProof),
+		StorageProof: storageProofs,
+	}, nil
+}
+
+// VerifyProof checks the proofs of a verifiable account result against the app hash found in the header
+// of the block following the height of the result. The app hash returned along the proofs isn't trusted.
+func (b *Backend) VerifyProof(res *rpctypes.VerifiableAccountResult) (bool, error) {
+	if res == nil {
+		return false, errors.New("empty account result")
+	}
+
+	resBlock, err := b.TendermintBlockByNumber(rpctypes.BlockNumber(res.Height + 1))
+	if err != nil || resBlock == nil {
+		return false, fmt.Errorf("app hash of the state at height %d not found", res.Height)
+	}
+
+	// the denom of the result isn't trusted, the balance of the evm denom is verified
+	params, err := b.queryClient.Params(rpctypes.ContextWithHeight(int64(res.Height)), &evmtypes.QueryParamsRequest{})
+	if err != nil {
+		return false, err
+	}
+
+	if err := rpctypes.VerifyAccountProof(b.clientCtx.Codec, resBlock.Block.AppHash, params.Params.EvmDenom, res); err != nil {
+		b.logger.Debug("account proof verification failed", "address", res.Address.Hex(), "height", res.Height, "error", err.Error())
+		return false, nil
+	}
+	return true, nil
+}
+
 // GetStorageAt returns the contract storage at the given address, block number, and key.
 func (b *Backend) GetStorageAt(address common.Address, key string, blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error) {
 	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
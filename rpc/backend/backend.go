@@ -53,6 +53,10 @@ type CosmosBackend interface { // TODO: define
 	// GetAccounts()
 	// SignDirect()
 	// SignAmino()
+
+	// Verifiable state proofs
+	GetVerifiableProof(address common.Address, storageKeys []string, blockNrOrHash rpctypes.BlockNumberOrHash) (*rpctypes.VerifiableAccountResult, error)
+	VerifyProof(res *rpctypes.VerifiableAccountResult) (bool, error)
 }
 
 // EVMBackend implements the functionality shared within ethereum namespaces
@@ -91,6 +95,7 @@ type EVMBackend interface {
 	BlockNumberFromTendermintByHash(blockHash common.Hash) (*big.Int, error)
 	EthMsgsFromTendermintBlock(block *tmrpctypes.ResultBlock, blockRes *tmrpctypes.ResultBlockResults) []*evmtypes.MsgEthereumTx
 	BlockBloom(blockRes *tmrpctypes.ResultBlockResults) (ethtypes.Bloom, error)
+	BlockStateRoot(blockRes *tmrpctypes.ResultBlockResults) (common.Hash, error)
 	HeaderByNumber(blockNum rpctypes.BlockNumber) (*ethtypes.Header, error)
 	HeaderByHash(blockHash common.Hash) (*ethtypes.Header, error)
 	RPCBlockFromTendermintBlock(resBlock *tmrpctypes.ResultBlock, blockRes *tmrpctypes.ResultBlockResults, fullTx bool) (map[string]interface{}, error)
@@ -115,32 +120,56 @@ type EVMBackend interface {
 	FeeHistory(blockCount math.HexOrDecimal64, lastBlock rpc.BlockNumber, rewardPercentiles []float64) (*rpctypes.FeeHistoryResult, error)
 	SuggestGasTipCap(baseFee *big.Int) (*big.Int, error)
 
+	// TxPool
+	TxPoolContent() (pending, queued map[common.Address]map[uint64]*rpctypes.RPCTransaction, err error)
+	TxPoolContentFrom(address common.Address) (pending, queued map[uint64]*rpctypes.RPCTransaction, err error)
+	TxPoolStatus() (pending, queued uint64, err error)
+
 	// Tx Info
 	GetTransactionByHash(txHash common.Hash) (*rpctypes.RPCTransaction, error)
 	GetTxByEthHash(txHash common.Hash) (*ethermint.TxResult, error)
 	GetTxByTxIndex(height int64, txIndex uint) (*ethermint.TxResult, error)
 	GetTransactionByBlockAndIndex(block *tmrpctypes.ResultBlock, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
 	GetTransactionReceipt(hash common.Hash) (map[string]interface{}, error)
+	GetBlockReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error)
 	GetTransactionByBlockHashAndIndex(hash common.Hash, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
 	GetTransactionByBlockNumberAndIndex(blockNum rpctypes.BlockNumber, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
+	GetTransactionsByAddress(address common.Address, fromBlock, toBlock rpctypes.BlockNumber, page uint64) ([]*rpctypes.RPCTransaction, error)
 
 	// Send Transaction
 	Resend(args evmtypes.TransactionArgs, gasPrice *hexutil.Big, gasLimit *hexutil.Uint64) (common.Hash, error)
 	SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
 	SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.TransactionArgs, error)
-	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error)
-	DoCall(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber, overrides *json.RawMessage) (*evmtypes.MsgEthereumTxResponse, error)
+	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber, overrides, blockOverrides *json.RawMessage) (hexutil.Uint64, error)
+	DoCall(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber, overrides, blockOverrides *json.RawMessage) (*evmtypes.MsgEthereumTxResponse, error)
+	DoCallBundle(args []evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber, overrides *json.RawMessage) ([]*evmtypes.MsgEthereumTxResponse, error)
+	CallBundle(args rpctypes.CallBundleArgs) (*rpctypes.CallBundleResult, error)
+	CreateAccessList(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber, overrides *json.RawMessage) (*rpctypes.AccessListResult, error)
+	SimulateV1(opts rpctypes.SimulateArgs, blockNrOrHash rpctypes.BlockNumberOrHash) ([]*rpctypes.SimulateBlockResult, error)
 	GasPrice() (*hexutil.Big, error)
 
 	// Filter API
 	GetLogs(hash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error)
+	GetLogsFromIndex(fromBlock, toBlock int64, addresses []common.Address, topics [][]common.Hash, limit int) ([]*ethtypes.Log, error)
 	BloomStatus() (uint64, uint64)
 
 	// Tracing
 	TraceTransaction(hash common.Hash, config *rpctypes.TraceConfig) (interface{}, error)
 	TraceBlock(height rpctypes.BlockNumber, config *rpctypes.TraceConfig, block *tmrpctypes.ResultBlock) ([]*evmtypes.TxTraceResult, error)
 	TraceCall(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumberOrHash, config *rpctypes.TraceConfig) (interface{}, error)
+	FlatTraceTransaction(hash common.Hash) ([]*rpctypes.FlatTrace, error)
+	FlatTraceBlock(blockNum rpctypes.BlockNumber) ([]*rpctypes.FlatTrace, error)
+	TraceFilter(args rpctypes.TraceFilterArgs) ([]*rpctypes.FlatTrace, error)
+	ReplayTransaction(hash common.Hash, traceTypes []string) (*rpctypes.ReplayTransactionResult, error)
+
+	// Otterscan
+	GetInternalOperations(hash common.Hash) ([]*rpctypes.InternalOperation, error)
+	GetTransactionError(hash common.Hash) (hexutil.Bytes, error)
+	OtsTraceTransaction(hash common.Hash) ([]*rpctypes.OtsTraceEntry, error)
+	SearchTransactions(address common.Address, blockNum, pageSize uint64, before bool) (*rpctypes.TransactionsWithReceipts, error)
+	GetContractCreator(address common.Address) (*rpctypes.ContractCreator, error)
+	GetBlockDetails(blockNum rpctypes.BlockNumber) (*rpctypes.BlockDetails, error)
 }
 
 var _ BackendI = (*Backend)(nil)
@@ -166,6 +195,7 @@ type Backend struct {
 	allowUnprotectedTxs bool
 	indexer             ethermint.EVMTxIndexer
 	processBlocker      ProcessBlocker
+	cache               *Cache
 }
 
 // NewBackend creates a new Backend instance for cosmos and ethereum namespaces
@@ -175,6 +205,7 @@ func NewBackend(
 	clientCtx client.Context,
 	allowUnprotectedTxs bool,
 	indexer ethermint.EVMTxIndexer,
+	cache *Cache,
 ) *Backend {
 	chainID, err := ethermint.ParseChainID(clientCtx.ChainID)
 	if err != nil {
@@ -195,6 +226,7 @@ func NewBackend(
 		cfg:                 appConf,
 		allowUnprotectedTxs: allowUnprotectedTxs,
 		indexer:             indexer,
+		cache:               cache,
 	}
 	b.processBlocker = b.processBlock
 	return b
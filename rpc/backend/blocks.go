@@ -181,6 +181,9 @@ func (b *Backend) TendermintBlockByNumber(blockNum rpctypes.BlockNumber) (*tmrpc
 		}
 		height = int64(n)
 	}
+	if resBlock, ok := b.cache.blocks.Get(height); ok {
+		return resBlock, nil
+	}
 	resBlock, err := b.clientCtx.Client.Block(b.ctx, &height)
 	if err != nil {
 		b.logger.Debug("tendermint client failed to get block", "height", height, "error", err.Error())
@@ -192,6 +195,7 @@ func (b *Backend) TendermintBlockByNumber(blockNum rpctypes.BlockNumber) (*tmrpc
 		return nil, nil
 	}
 
+	b.cache.addBlock(resBlock)
 	return resBlock, nil
 }
 
@@ -202,7 +206,19 @@ func (b *Backend) TendermintBlockResultByNumber(height *int64) (*tmrpctypes.Resu
 	if !ok {
 		return nil, errors.New("invalid rpc client")
 	}
-	return sc.BlockResults(b.ctx, height)
+	if height != nil {
+		if res, ok := b.cache.blockResults.Get(*height); ok {
+			return res, nil
+		}
+	}
+	res, err := sc.BlockResults(b.ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	if res != nil {
+		b.cache.blockResults.Add(res.Height, res)
+	}
+	return res, nil
 }
 
 // TendermintBlockByHash returns a Tendermint-formatted block by block number
@@ -211,6 +227,11 @@ func (b *Backend) TendermintBlockByHash(blockHash common.Hash) (*tmrpctypes.Resu
 	if !ok {
 		return nil, errors.New("invalid rpc client")
 	}
+	if height, ok := b.cache.blockHashes.Get(blockHash); ok {
+		if resBlock, ok := b.cache.blocks.Get(height); ok {
+			return resBlock, nil
+		}
+	}
 	resBlock, err := sc.BlockByHash(b.ctx, blockHash.Bytes())
 	if err != nil {
 		b.logger.Debug("tendermint client failed to get block", "blockHash", blockHash.Hex(), "error", err.Error())
@@ -222,6 +243,7 @@ func (b *Backend) TendermintBlockByHash(blockHash common.Hash) (*tmrpctypes.Resu
 		return nil, nil
 	}
 
+	b.cache.addBlock(resBlock)
 	return resBlock, nil
 }
 
@@ -262,8 +284,12 @@ func (b *Backend) EthMsgsFromTendermintBlock(
 	resBlock *tmrpctypes.ResultBlock,
 	blockRes *tmrpctypes.ResultBlockResults,
 ) []*evmtypes.MsgEthereumTx {
-	var result []*evmtypes.MsgEthereumTx
 	block := resBlock.Block
+	if result, ok := b.cache.ethMsgs.Get(block.Height); ok {
+		return result
+	}
+
+	var result []*evmtypes.MsgEthereumTx
 
 	txResults := blockRes.TxsResults
 
@@ -293,6 +319,7 @@ func (b *Backend) EthMsgsFromTendermintBlock(
 		}
 	}
 
+	b.cache.ethMsgs.Add(block.Height, result)
 	return result
 }
 
@@ -359,6 +386,9 @@ func (b *Backend) HeaderByHash(blockHash common.Hash) (*ethtypes.Header, error)
 
 // BlockBloom query block bloom filter from block results
 func (b *Backend) BlockBloom(blockRes *tmrpctypes.ResultBlockResults) (ethtypes.Bloom, error) {
+	if bloom, ok := b.cache.blooms.Get(blockRes.Height); ok {
+		return bloom, nil
+	}
 	for _, event := range blockRes.EndBlockEvents {
 		if event.Type != evmtypes.EventTypeBlockBloom {
 			continue
@@ -366,13 +396,31 @@ func (b *Backend) BlockBloom(blockRes *tmrpctypes.ResultBlockResults) (ethtypes.
 
 		for _, attr := range event.Attributes {
 			if bytes.Equal([]byte(attr.Key), bAttributeKeyEthereumBloom) {
-				return ethtypes.BytesToBloom([]byte(attr.Value)), nil
+				bloom := ethtypes.BytesToBloom([]byte(attr.Value))
+				b.cache.blooms.Add(blockRes.Height, bloom)
+				return bloom, nil
 			}
 		}
 	}
 	return ethtypes.Bloom{}, errors.New("block bloom event is not found")
 }
 
+// BlockStateRoot query the EVM state root reached at the end of the block from block results
+func (b *Backend) BlockStateRoot(blockRes *tmrpctypes.ResultBlockResults) (common.Hash, error) {
+	for _, event := range blockRes.EndBlockEvents {
+		if event.Type != evmtypes.EventTypeBlockStateRoot {
+			continue
+		}
+
+		for _, attr := range event.Attributes {
+			if attr.Key == evmtypes.AttributeKeyStateRoot {
+				return common.HexToHash(attr.Value), nil
+			}
+		}
+	}
+	return common.Hash{}, errors.New("block state root event is not found")
+}
+
 // RPCBlockFromTendermintBlock returns a JSON-RPC compatible Ethereum block from a
 // given Tendermint block and its block result.
 func (b *Backend) RPCBlockFromTendermintBlock(
@@ -465,6 +513,11 @@ func (b *Backend) RPCBlockFromTendermintBlock(
 		gasLimit, new(big.Int).SetUint64(gasUsed),
 		ethRPCTxs, bloom, validatorAddr, baseFee,
 	)
+
+	// the EVM state root is only available for the blocks executed after it was recorded
+	if stateRoot, err := b.BlockStateRoot(blockRes); err == nil {
+		formattedBlock["evmStateRoot"] = stateRoot
+	}
 	return formattedBlock, nil
 }
 
@@ -873,6 +873,65 @@ func (suite *BackendTestSuite) TestBlockBloom() {
 	}
 }
 
+func (suite *BackendTestSuite) TestBlockStateRoot() {
+	root := common.BytesToHash([]byte("root"))
+	testCases := []struct {
+		name         string
+		blockRes     *tmrpctypes.ResultBlockResults
+		expStateRoot common.Hash
+		expPass      bool
+	}{
+		{
+			"fail - empty block result",
+			&tmrpctypes.ResultBlockResults{},
+			common.Hash{},
+			false,
+		},
+		{
+			"fail - block bloom event only",
+			&tmrpctypes.ResultBlockResults{
+				EndBlockEvents: []types.Event{
+					{
+						Type: evmtypes.EventTypeBlockBloom,
+						Attributes: []types.EventAttribute{
+							{Key: string(bAttributeKeyEthereumBloom)},
+						},
+					},
+				},
+			},
+			common.Hash{},
+			false,
+		},
+		{
+			"pass - block state root event",
+			&tmrpctypes.ResultBlockResults{
+				EndBlockEvents: []types.Event{
+					{
+						Type: evmtypes.EventTypeBlockStateRoot,
+						Attributes: []types.EventAttribute{
+							{Key: evmtypes.AttributeKeyStateRoot, Value: root.Hex()},
+						},
+					},
+				},
+			},
+			root,
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			stateRoot, err := suite.backend.BlockStateRoot(tc.blockRes)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expStateRoot, stateRoot)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestGetEthBlockFromTendermint() {
 	msgEthereumTx, bz := suite.buildEthereumTx()
 	emptyBlock := tmtypes.MakeBlock(1, []tmtypes.Tx{}, nil, nil)
@@ -0,0 +1,134 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package backend
+
+import (
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/lru"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/metrics"
+	"github.com/evmos/ethermint/server/config"
+	ethermint "github.com/evmos/ethermint/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// Cache keeps the finalized blocks and the data derived from them in memory. Blocks are final
+// as soon as they are committed, so the entries are only evicted and never need to be invalidated.
+// It's safe for concurrent use, so a single cache is shared by the backends of the JSON-RPC server.
+type Cache struct {
+	blocks       *lruCache[int64, *tmrpctypes.ResultBlock]
+	blockHashes  *lruCache[common.Hash, int64]
+	blockResults *lruCache[int64, *tmrpctypes.ResultBlockResults]
+	ethMsgs      *lruCache[int64, []*evmtypes.MsgEthereumTx]
+	blooms       *lruCache[int64, ethtypes.Bloom]
+	txResults    *lruCache[common.Hash, *ethermint.TxResult]
+	receipts     *lruCache[common.Hash, map[string]interface{}]
+}
+
+// NewCache creates the caches with the sizes defined in the JSON-RPC config.
+func NewCache(cfg config.JSONRPCConfig) *Cache {
+	return &Cache{
+		blocks:       newLRUCache[int64, *tmrpctypes.ResultBlock]("block", cfg.BlockCacheSize),
+		blockHashes:  newLRUCache[common.Hash, int64]("blockhash", cfg.BlockCacheSize),
+		blockResults: newLRUCache[int64, *tmrpctypes.ResultBlockResults]("blockresults", cfg.BlockCacheSize),
+		ethMsgs:      newLRUCache[int64, []*evmtypes.MsgEthereumTx]("ethmsgs", cfg.BlockCacheSize),
+		blooms:       newLRUCache[int64, ethtypes.Bloom]("bloom", cfg.BlockCacheSize),
+		txResults:    newLRUCache[common.Hash, *ethermint.TxResult]("txresult", cfg.ReceiptCacheSize),
+		receipts:     newLRUCache[common.Hash, map[string]interface{}]("receipt", cfg.ReceiptCacheSize),
+	}
+}
+
+// addBlock caches the block by height and indexes its hash.
+func (c *Cache) addBlock(resBlock *tmrpctypes.ResultBlock) {
+	c.blocks.Add(resBlock.Block.Height, resBlock)
+	c.blockHashes.Add(common.BytesToHash(resBlock.BlockID.Hash), resBlock.Block.Height)
+}
+
+// getTxResult returns a copy of the cached tx result, so callers are free to fill its missing fields.
+func (c *Cache) getTxResult(hash common.Hash) (*ethermint.TxResult, bool) {
+	txResult, ok := c.txResults.Get(hash)
+	if !ok {
+		return nil, false
+	}
+	res := *txResult
+	return &res, true
+}
+
+// addTxResult caches a copy of the tx result, the caller keeping the ownership of the original.
+func (c *Cache) addTxResult(hash common.Hash, txResult *ethermint.TxResult) {
+	res := *txResult
+	c.txResults.Add(hash, &res)
+}
+
+// getReceipt returns a copy of the cached receipt, so callers are free to extend it.
+func (c *Cache) getReceipt(hash common.Hash) (map[string]interface{}, bool) {
+	receipt, ok := c.receipts.Get(hash)
+	if !ok {
+		return nil, false
+	}
+	return copyReceipt(receipt), true
+}
+
+// copyReceipt returns a shallow copy of the receipt fields.
+func copyReceipt(receipt map[string]interface{}) map[string]interface{} {
+	res := make(map[string]interface{}, len(receipt))
+	for k, v := range receipt {
+		res[k] = v
+	}
+	return res
+}
+
+// lruCache is a size limited cache counting its hits and misses in the rpc metrics, a nil cache is
+// disabled and never returns any entry.
+type lruCache[K comparable, V any] struct {
+	cache  *lru.Cache[K, V]
+	hits   metrics.Counter
+	misses metrics.Counter
+}
+
+func newLRUCache[K comparable, V any](name string, size int) *lruCache[K, V] {
+	if size <= 0 {
+		return nil
+	}
+	return &lruCache[K, V]{
+		cache:  lru.NewCache[K, V](size),
+		hits:   metrics.GetOrRegisterCounter("rpc/backend/cache/"+name+"/hit", nil),
+		misses: metrics.GetOrRegisterCounter("rpc/backend/cache/"+name+"/miss", nil),
+	}
+}
+
+// Get returns the entry of the key and records the lookup.
+func (c *lruCache[K, V]) Get(key K) (value V, ok bool) {
+	if c == nil {
+		return value, false
+	}
+	value, ok = c.cache.Get(key)
+	if ok {
+		c.hits.Inc(1)
+	} else {
+		c.misses.Inc(1)
+	}
+	return value, ok
+}
+
+// Add inserts the entry, evicting the least recently used one if the cache is full.
+func (c *lruCache[K, V]) Add(key K, value V) {
+	if c == nil {
+		return
+	}
+	c.cache.Add(key, value)
+}
@@ -0,0 +1,88 @@
+package backend
+
+import (
+	"fmt"
+
+	"github.com/evmos/ethermint/rpc/backend/mocks"
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+	"github.com/evmos/ethermint/server/config"
+	"github.com/evmos/ethermint/tests"
+	ethermint "github.com/evmos/ethermint/types"
+)
+
+func (suite *BackendTestSuite) TestBackendCache() {
+	height := int64(1)
+
+	testCases := []struct {
+		name     string
+		size     int
+		expCalls int
+	}{
+		{"cache disabled", 0, 2},
+		{"cache enabled", 16, 1},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			suite.backend.cache = NewCache(config.JSONRPCConfig{BlockCacheSize: tc.size, ReceiptCacheSize: tc.size})
+
+			client := suite.backend.clientCtx.Client.(*mocks.Client)
+			_, err := RegisterBlock(client, height, nil)
+			suite.Require().NoError(err)
+			_, err = RegisterBlockResults(client, height)
+			suite.Require().NoError(err)
+
+			for i := 0; i < 2; i++ {
+				resBlock, err := suite.backend.TendermintBlockByNumber(rpctypes.BlockNumber(height))
+				suite.Require().NoError(err)
+				suite.Require().Equal(height, resBlock.Block.Height)
+
+				blockRes, err := suite.backend.TendermintBlockResultByNumber(&height)
+				suite.Require().NoError(err)
+				suite.Require().Equal(height, blockRes.Height)
+			}
+
+			client.AssertNumberOfCalls(suite.T(), "Block", tc.expCalls)
+			client.AssertNumberOfCalls(suite.T(), "BlockResults", tc.expCalls)
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestBackendCacheReceipt() {
+	hash := tests.GenerateAddress().Hash()
+
+	disabled := NewCache(config.JSONRPCConfig{})
+	disabled.receipts.Add(hash, map[string]interface{}{"status": 1})
+	_, ok := disabled.getReceipt(hash)
+	suite.Require().False(ok)
+
+	cache := NewCache(config.JSONRPCConfig{ReceiptCacheSize: 16})
+	cache.receipts.Add(hash, map[string]interface{}{"status": 1})
+	receipt, ok := cache.getReceipt(hash)
+	suite.Require().True(ok)
+
+	// extending the returned receipt doesn't alter the cached one
+	receipt["timestamp"] = 1
+	receipt, ok = cache.getReceipt(hash)
+	suite.Require().True(ok)
+	suite.Require().Len(receipt, 1)
+}
+
+func (suite *BackendTestSuite) TestCacheTxResult() {
+	hash := tests.GenerateAddress().Hash()
+
+	cache := NewCache(config.JSONRPCConfig{ReceiptCacheSize: 16})
+	txResult := &ethermint.TxResult{Height: 1, EthTxIndex: -1}
+	cache.addTxResult(hash, txResult)
+
+	// filling the fields of the added and the returned tx results doesn't alter the cached one
+	txResult.EthTxIndex = 0
+	res, ok := cache.getTxResult(hash)
+	suite.Require().True(ok)
+	suite.Require().Equal(int32(-1), res.EthTxIndex)
+	res.EthTxIndex = 1
+	res, ok = cache.getTxResult(hash)
+	suite.Require().True(ok)
+	suite.Require().Equal(int32(-1), res.EthTxIndex)
+}
@@ -23,8 +23,10 @@ import (
 	"math/big"
 
 	errorsmod "cosmossdk.io/errors"
+	tmtypes "github.com/cometbft/cometbft/types"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -289,7 +291,7 @@ func (b *Backend) SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.Transac
 		}
 
 		blockNr := rpctypes.NewBlockNumber(big.NewInt(0))
-		estimated, err := b.EstimateGas(callArgs, &blockNr)
+		estimated, err := b.EstimateGas(callArgs, &blockNr, nil, nil)
 		if err != nil {
 			return args, err
 		}
@@ -318,8 +320,12 @@ func (b *Backend) handleRevertError(vmError string, ret []byte) error {
 	return nil
 }
 
-// EstimateGas returns an estimate of gas usage for the given smart contract call.
-func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error) {
+// EstimateGas returns an estimate of gas usage for the given smart contract call, the state and block
+// overrides are optional.
+func (b *Backend) EstimateGas(
+	args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber,
+	overrides, blockOverrides *json.RawMessage,
+) (hexutil.Uint64, error) {
 	blockNr := rpctypes.EthPendingBlockNumber
 	if blockNrOptional != nil {
 		blockNr = *blockNrOptional
@@ -342,6 +348,12 @@ func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rp
 		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
 		ChainId:         b.chainID.Int64(),
 	}
+	if overrides != nil {
+		req.Overrides = *overrides
+	}
+	if blockOverrides != nil {
+		req.BlockOverrides = *blockOverrides
+	}
 
 	// From ContextWithHeight: if the provided height is 0,
 	// it will return an empty context and the gRPC query will use
@@ -357,10 +369,10 @@ func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rp
 }
 
 // DoCall performs a simulated call operation through the evmtypes. It returns the
-// estimated gas used on the operation or an error if fails.
+// estimated gas used on the operation or an error if fails. The state and block overrides are optional.
 func (b *Backend) DoCall(
 	args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber,
-	overrides *json.RawMessage,
+	overrides, blockOverrides *json.RawMessage,
 ) (*evmtypes.MsgEthereumTxResponse, error) {
 	bz, err := json.Marshal(&args)
 	if err != nil {
@@ -372,10 +384,13 @@ func (b *Backend) DoCall(
 		return nil, errors.New("header not found")
 	}
 
-	var bzOverrides []byte
+	var bzOverrides, bzBlockOverrides []byte
 	if overrides != nil {
 		bzOverrides = *overrides
 	}
+	if blockOverrides != nil {
+		bzBlockOverrides = *blockOverrides
+	}
 
 	req := evmtypes.EthCallRequest{
 		Args:            bz,
@@ -383,6 +398,7 @@ func (b *Backend) DoCall(
 		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
 		ChainId:         b.chainID.Int64(),
 		Overrides:       bzOverrides,
+		BlockOverrides:  bzBlockOverrides,
 	}
 
 	// From ContextWithHeight: if the provided height is 0,
@@ -419,6 +435,239 @@ func (b *Backend) DoCall(
 	return res, nil
 }
 
+// CreateAccessList creates an EIP-2930 access list for the given transaction, based on the state of
+// the given block. It returns the access list, the gas used by the transaction with that list applied
+// and the vm error, if any.
+func (b *Backend) CreateAccessList(
+	args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber,
+	overrides *json.RawMessage,
+) (*rpctypes.AccessListResult, error) {
+	bz, err := json.Marshal(&args)
+	if err != nil {
+		return nil, err
+	}
+	header, err := b.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	var bzOverrides []byte
+	if overrides != nil {
+		bzOverrides = *overrides
+	}
+
+	req := evmtypes.EthCallRequest{
+		Args:            bz,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+		Overrides:       bzOverrides,
+	}
+
+	ctx := rpctypes.ContextWithHeight(blockNr.Int64())
+	timeout := b.RPCEVMTimeout()
+
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	res, err := b.queryClient.CreateAccessList(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	// an empty access list is returned as [] instead of null, like geth
+	accessList := res.AccessList.ToEthAccessList()
+	if *accessList == nil {
+		accessList = &ethtypes.AccessList{}
+	}
+
+	return &rpctypes.AccessListResult{
+		Accesslist: accessList,
+		Error:      res.VmError,
+		GasUsed:    hexutil.Uint64(res.GasUsed),
+	}, nil
+}
+
+// DoCallBundle performs the ordered calls on top of the state of the block, each call sees the state
+// changes of the previous ones. The failures of the calls are reported in their responses.
+func (b *Backend) DoCallBundle(
+	args []evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber,
+	overrides *json.RawMessage,
+) ([]*evmtypes.MsgEthereumTxResponse, error) {
+	bzArgs := make([][]byte, len(args))
+	for i := range args {
+		bz, err := json.Marshal(&args[i])
+		if err != nil {
+			return nil, err
+		}
+		bzArgs[i] = bz
+	}
+	header, err := b.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	var bzOverrides []byte
+	if overrides != nil {
+		bzOverrides = *overrides
+	}
+
+	req := evmtypes.EthCallBundleRequest{
+		Args:            bzArgs,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+		Overrides:       bzOverrides,
+	}
+
+	ctx := rpctypes.ContextWithHeight(blockNr.Int64())
+	timeout := b.RPCEVMTimeout()
+
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	res, err := b.queryClient.EthCallBundle(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	length := 0
+	for _, result := range res.Results {
+		length += len(result.Ret)
+	}
+	if length > int(b.cfg.JSONRPC.ReturnDataLimit) && b.cfg.JSONRPC.ReturnDataLimit != 0 {
+		return nil, fmt.Errorf("call retuned result on length %d exceeding limit %d", length, b.cfg.JSONRPC.ReturnDataLimit)
+	}
+	return res.Results, nil
+}
+
+// CallBundle simulates the bundle of signed or unsigned transactions in order on top of the state of the
+// block, it returns the return data, gas used, logs and revert reason of each transaction.
+func (b *Backend) CallBundle(args rpctypes.CallBundleArgs) (*rpctypes.CallBundleResult, error) {
+	latest := rpctypes.EthLatestBlockNumber
+	blockNrOrHash := rpctypes.BlockNumberOrHash{BlockNumber: &latest}
+	if args.StateBlockNumber != nil {
+		blockNrOrHash = *args.StateBlockNumber
+	}
+	header, err := b.bundleHeader(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	callArgs := make([]evmtypes.TransactionArgs, len(args.Txs))
+	hashes := make([]*common.Hash, len(args.Txs))
+	for i, tx := range args.Txs {
+		if callArgs[i], hashes[i], err = tx.ToArgs(b.chainID); err != nil {
+			return nil, fmt.Errorf("invalid transaction %d: %w", i, err)
+		}
+	}
+
+	responses, err := b.DoCallBundle(callArgs, rpctypes.BlockNumber(header.Height), args.StateOverrides)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &rpctypes.CallBundleResult{
+		Results:          make([]rpctypes.CallBundleTxResult, len(responses)),
+		StateBlockNumber: hexutil.Uint64(header.Height),
+	}
+	for i, res := range responses {
+		txResult := rpctypes.CallBundleTxResult{
+			TxHash:      hashes[i],
+			FromAddress: callArgs[i].GetFrom(),
+			ToAddress:   callArgs[i].To,
+			Value:       callArgs[i].Value,
+			GasUsed:     hexutil.Uint64(res.GasUsed),
+			ReturnData:  res.Ret,
+			Logs:        evmtypes.LogsToEthereum(res.Logs),
+		}
+		if res.Failed() {
+			txResult.Error = res.VmError
+			if reason, err := abi.UnpackRevert(res.Ret); err == nil {
+				txResult.Revert = reason
+			}
+		}
+		result.Results[i] = txResult
+		result.TotalGasUsed += txResult.GasUsed
+	}
+	return result, nil
+}
+
+// SimulateV1 simulates the calls on top of the state of the block, only a single block of calls is
+// supported as the calls can't be simulated in the context of the following blocks.
+func (b *Backend) SimulateV1(opts rpctypes.SimulateArgs, blockNrOrHash rpctypes.BlockNumberOrHash) ([]*rpctypes.SimulateBlockResult, error) {
+	if len(opts.BlockStateCalls) != 1 {
+		return nil, errors.New("only a single block of calls is supported")
+	}
+	header, err := b.bundleHeader(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	block := opts.BlockStateCalls[0]
+	responses, err := b.DoCallBundle(block.Calls, rpctypes.BlockNumber(header.Height), block.StateOverrides)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &rpctypes.SimulateBlockResult{
+		Number:    hexutil.Uint64(header.Height),
+		Hash:      common.BytesToHash(header.Hash()),
+		Timestamp: hexutil.Uint64(header.Time.Unix()),
+		Calls:     make([]rpctypes.SimulateCallResult, len(responses)),
+	}
+	for i, res := range responses {
+		callResult := rpctypes.SimulateCallResult{
+			ReturnData: res.Ret,
+			Logs:       evmtypes.LogsToEthereum(res.Logs),
+			GasUsed:    hexutil.Uint64(res.GasUsed),
+			Status:     hexutil.Uint64(ethtypes.ReceiptStatusSuccessful),
+		}
+		if res.Failed() {
+			callResult.Status = hexutil.Uint64(ethtypes.ReceiptStatusFailed)
+			// the error codes imitate geth behavior
+			callResult.Error = &rpctypes.SimulateCallError{Code: -32015, Message: res.VmError}
+			if res.VmError == vm.ErrExecutionReverted.Error() {
+				revertErr := evmtypes.NewExecErrorWithReason(res.Ret)
+				callResult.Error = &rpctypes.SimulateCallError{
+					Code:    revertErr.ErrorCode(),
+					Message: revertErr.Error(),
+					Data:    revertErr.ErrorData().(string),
+				}
+			}
+		}
+		result.Calls[i] = callResult
+		result.GasUsed += callResult.GasUsed
+	}
+	return []*rpctypes.SimulateBlockResult{result}, nil
+}
+
+// bundleHeader returns the header of the block the bundle is simulated on
+func (b *Backend) bundleHeader(blockNrOrHash rpctypes.BlockNumberOrHash) (*tmtypes.Header, error) {
+	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	resBlock, err := b.TendermintBlockByNumber(blockNum)
+	if err != nil || resBlock == nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+	return &resBlock.Block.Header, nil
+}
+
 // GasPrice returns the current gas price based on Ethermint's gas price oracle.
 func (b *Backend) GasPrice() (*hexutil.Big, error) {
 	var (
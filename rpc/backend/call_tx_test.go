@@ -8,6 +8,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/evmos/ethermint/rpc/backend/mocks"
 	rpctypes "github.com/evmos/ethermint/rpc/types"
@@ -207,7 +209,7 @@ func (suite *BackendTestSuite) TestResend() {
 				RegisterEstimateGas(queryClient, callArgs)
 				RegisterParams(queryClient, &header, 1)
 				RegisterParamsWithoutHeader(queryClient, 1)
-				RegisterUnconfirmedTxsError(client, nil)
+				RegisterUnconfirmedTxsError(client)
 			},
 			evmtypes.TransactionArgs{
 				Nonce:                &txNonce,
@@ -235,7 +237,7 @@ func (suite *BackendTestSuite) TestResend() {
 				RegisterEstimateGas(queryClient, callArgs)
 				RegisterParams(queryClient, &header, 1)
 				RegisterParamsWithoutHeader(queryClient, 1)
-				RegisterUnconfirmedTxsEmpty(client, nil)
+				RegisterUnconfirmedTxsEmpty(client)
 			},
 			evmtypes.TransactionArgs{
 				Nonce:                &txNonce,
@@ -424,7 +426,7 @@ func (suite *BackendTestSuite) TestDoCall() {
 			suite.SetupTest() // reset test and queries
 			tc.registerMock()
 
-			msgEthTx, err := suite.backend.DoCall(tc.callArgs, tc.blockNum, nil)
+			msgEthTx, err := suite.backend.DoCall(tc.callArgs, tc.blockNum, nil, nil)
 
 			if tc.expPass {
 				suite.Require().Equal(tc.expEthTx, msgEthTx)
@@ -435,6 +437,160 @@ func (suite *BackendTestSuite) TestDoCall() {
 	}
 }
 
+func (suite *BackendTestSuite) TestCreateAccessList() {
+	_, bz := suite.buildEthereumTx()
+	toAddr := tests.GenerateAddress()
+	callArgs := evmtypes.TransactionArgs{To: &toAddr}
+	argsBz, err := json.Marshal(callArgs)
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		blockNum     rpctypes.BlockNumber
+		expResult    *rpctypes.AccessListResult
+		expPass      bool
+	}{
+		{
+			"fail - block not found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			rpctypes.BlockNumber(1),
+			nil,
+			false,
+		},
+		{
+			"fail - invalid request",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterBlock(client, 1, bz)
+				RegisterCreateAccessListError(queryClient, &evmtypes.EthCallRequest{Args: argsBz, ChainId: suite.backend.chainID.Int64()})
+			},
+			rpctypes.BlockNumber(1),
+			nil,
+			false,
+		},
+		{
+			"pass - returned access list",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterBlock(client, 1, bz)
+				RegisterCreateAccessList(queryClient, &evmtypes.EthCallRequest{Args: argsBz, ChainId: suite.backend.chainID.Int64()})
+			},
+			rpctypes.BlockNumber(1),
+			&rpctypes.AccessListResult{Accesslist: &ethtypes.AccessList{}, GasUsed: 21000},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			res, err := suite.backend.CreateAccessList(callArgs, tc.blockNum, nil)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResult, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestCallBundle() {
+	msgEthTx, bz := suite.buildEthereumTx()
+	rawTx, err := msgEthTx.AsTransaction().MarshalBinary()
+	suite.Require().NoError(err)
+	from := common.BytesToAddress(suite.signerAddress)
+	toAddr := tests.GenerateAddress()
+
+	bundleTxs := []rpctypes.BundleTx{
+		{Raw: rawTx},
+		{Args: &evmtypes.TransactionArgs{From: &from, To: &toAddr}},
+	}
+	argsBz := make([][]byte, len(bundleTxs))
+	for i, tx := range bundleTxs {
+		args, _, err := tx.ToArgs(suite.backend.chainID)
+		suite.Require().NoError(err)
+		argsBz[i], err = json.Marshal(&args)
+		suite.Require().NoError(err)
+	}
+	revertData, err := hexutil.Decode("0x08c379a0" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000006" +
+		"6661696c65640000000000000000000000000000000000000000000000000000")
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expPass      bool
+	}{
+		{
+			"fail - block not found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			false,
+		},
+		{
+			"pass - per tx results",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterBlock(client, 1, bz)
+				RegisterEthCallBundle(
+					queryClient,
+					&evmtypes.EthCallBundleRequest{Args: argsBz, ChainId: suite.backend.chainID.Int64()},
+					[]*evmtypes.MsgEthereumTxResponse{
+						{GasUsed: 21000},
+						{GasUsed: 30000, VmError: vm.ErrExecutionReverted.Error(), Ret: revertData},
+					},
+				)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			blockNum := rpctypes.BlockNumber(1)
+			res, err := suite.backend.CallBundle(rpctypes.CallBundleArgs{
+				Txs:              bundleTxs,
+				StateBlockNumber: &rpctypes.BlockNumberOrHash{BlockNumber: &blockNum},
+			})
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(hexutil.Uint64(1), res.StateBlockNumber)
+			suite.Require().Equal(hexutil.Uint64(51000), res.TotalGasUsed)
+			suite.Require().Len(res.Results, 2)
+
+			txHash := msgEthTx.AsTransaction().Hash()
+			suite.Require().Equal(&txHash, res.Results[0].TxHash)
+			suite.Require().Equal(from, res.Results[0].FromAddress)
+			suite.Require().Empty(res.Results[0].Error)
+
+			suite.Require().Nil(res.Results[1].TxHash)
+			suite.Require().Equal(&toAddr, res.Results[1].ToAddress)
+			suite.Require().Equal(vm.ErrExecutionReverted.Error(), res.Results[1].Error)
+			suite.Require().Equal("failed", res.Results[1].Revert)
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestGasPrice() {
 	defaultGasPrice := (*hexutil.Big)(big.NewInt(1))
 
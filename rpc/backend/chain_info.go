@@ -114,14 +114,20 @@ func (b *Backend) CurrentHeader() *ethtypes.Header {
 	return header
 }
 
+// maxUnconfirmedTxs is the maximum number of transactions returned by the unconfirmed_txs
+// endpoint of CometBFT, which doesn't support pagination. The default limit is only 30.
+const maxUnconfirmedTxs = 100
+
 // PendingTransactions returns the transactions that are in the transaction pool
-// and have a from address that is one of the accounts this node manages.
+// and have a from address that is one of the accounts this node manages. At most
+// maxUnconfirmedTxs transactions are returned.
 func (b *Backend) PendingTransactions() ([]*sdk.Tx, error) {
 	mc, ok := b.clientCtx.Client.(tmrpcclient.MempoolClient)
 	if !ok {
 		return nil, errors.New("invalid rpc client")
 	}
-	res, err := mc.UnconfirmedTxs(b.ctx, nil)
+	limit := maxUnconfirmedTxs
+	res, err := mc.UnconfirmedTxs(b.ctx, &limit)
 	if err != nil {
 		return nil, err
 	}
@@ -60,20 +60,23 @@ func RegisterBroadcastTxError(client *mocks.Client, tx types.Tx) {
 }
 
 // Unconfirmed Transactions
-func RegisterUnconfirmedTxs(client *mocks.Client, limit *int, txs []types.Tx) {
-	client.On("UnconfirmedTxs", rpc.ContextWithHeight(1), limit).
+func RegisterUnconfirmedTxs(client *mocks.Client, txs []types.Tx) {
+	limit := maxUnconfirmedTxs
+	client.On("UnconfirmedTxs", rpc.ContextWithHeight(1), &limit).
 		Return(&tmrpctypes.ResultUnconfirmedTxs{Txs: txs}, nil)
 }
 
-func RegisterUnconfirmedTxsEmpty(client *mocks.Client, limit *int) {
-	client.On("UnconfirmedTxs", rpc.ContextWithHeight(1), limit).
+func RegisterUnconfirmedTxsEmpty(client *mocks.Client) {
+	limit := maxUnconfirmedTxs
+	client.On("UnconfirmedTxs", rpc.ContextWithHeight(1), &limit).
 		Return(&tmrpctypes.ResultUnconfirmedTxs{
 			Txs: make([]types.Tx, 2),
 		}, nil)
 }
 
-func RegisterUnconfirmedTxsError(client *mocks.Client, limit *int) {
-	client.On("UnconfirmedTxs", rpc.ContextWithHeight(1), limit).
+func RegisterUnconfirmedTxsError(client *mocks.Client) {
+	limit := maxUnconfirmedTxs
+	client.On("UnconfirmedTxs", rpc.ContextWithHeight(1), &limit).
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
@@ -136,6 +136,16 @@ func TestRegisterParamsError(t *testing.T) {
 	require.Error(t, err)
 }
 
+// heightContext matches the query contexts at the height, the backend wrapping them with a
+// cancel func or a timeout.
+func heightContext(height int64) interface{} {
+	return mock.MatchedBy(func(ctx context.Context) bool {
+		md, ok := metadata.FromOutgoingContext(ctx)
+		return ok && len(md.Get(grpctypes.GRPCBlockHeightHeader)) == 1 &&
+			md.Get(grpctypes.GRPCBlockHeightHeader)[0] == strconv.FormatInt(height, 10)
+	})
+}
+
 // ETH Call
 func RegisterEthCall(queryClient *mocks.EVMQueryClient, request *evmtypes.EthCallRequest) {
 	ctx, _ := context.WithCancel(rpc.ContextWithHeight(1))
@@ -149,6 +159,25 @@ func RegisterEthCallError(queryClient *mocks.EVMQueryClient, request *evmtypes.E
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+func RegisterCreateAccessList(queryClient *mocks.EVMQueryClient, request *evmtypes.EthCallRequest) {
+	queryClient.On("CreateAccessList", heightContext(1), request).
+		Return(&evmtypes.CreateAccessListResponse{GasUsed: 21000}, nil)
+}
+
+func RegisterCreateAccessListError(queryClient *mocks.EVMQueryClient, request *evmtypes.EthCallRequest) {
+	queryClient.On("CreateAccessList", heightContext(1), request).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
+func RegisterEthCallBundle(
+	queryClient *mocks.EVMQueryClient,
+	request *evmtypes.EthCallBundleRequest,
+	results []*evmtypes.MsgEthereumTxResponse,
+) {
+	queryClient.On("EthCallBundle", heightContext(1), request).
+		Return(&evmtypes.EthCallBundleResponse{Results: results}, nil)
+}
+
 // Estimate Gas
 func RegisterEstimateGas(queryClient *mocks.EVMQueryClient, args evmtypes.TransactionArgs) {
 	bz, _ := json.Marshal(args)
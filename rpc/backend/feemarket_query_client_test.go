@@ -1,6 +1,7 @@
 package backend
 
 import (
+	sdkmath "cosmossdk.io/math"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/evmos/ethermint/rpc/backend/mocks"
 	rpc "github.com/evmos/ethermint/rpc/types"
@@ -15,6 +16,18 @@ func RegisterFeeMarketParams(feeMarketClient *mocks.FeeMarketQueryClient, height
 		Return(&feemarkettypes.QueryParamsResponse{Params: feemarkettypes.DefaultParams()}, nil)
 }
 
+func RegisterFeeMarketTimeWeightedParams(feeMarketClient *mocks.FeeMarketQueryClient, height int64) {
+	params := feemarkettypes.DefaultParams()
+	params.BaseFeeAlgorithm = feemarkettypes.BaseFeeAlgorithmTimeWeighted
+	feeMarketClient.On("Params", rpc.ContextWithHeight(height), &feemarkettypes.QueryParamsRequest{}).
+		Return(&feemarkettypes.QueryParamsResponse{Params: params}, nil)
+}
+
+func RegisterBaseFeeHistory(feeMarketClient *mocks.FeeMarketQueryClient, height int64, nextBaseFee sdkmath.Int) {
+	feeMarketClient.On("BaseFeeHistory", rpc.ContextWithHeight(height), &feemarkettypes.QueryBaseFeeHistoryRequest{}).
+		Return(&feemarkettypes.QueryBaseFeeHistoryResponse{NextBaseFee: &nextBaseFee}, nil)
+}
+
 func RegisterFeeMarketParamsError(feeMarketClient *mocks.FeeMarketQueryClient, height int64) {
 	feeMarketClient.On("Params", rpc.ContextWithHeight(height), &feemarkettypes.QueryParamsRequest{}).
 		Return(nil, sdkerrors.ErrInvalidRequest)
@@ -19,6 +19,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
+
+	"github.com/evmos/ethermint/indexer"
 )
 
 // GetLogs returns all the logs from all the ethereum transactions in a block.
@@ -44,6 +46,21 @@ func (b *Backend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
 	return GetLogsFromBlockResults(blockRes)
 }
 
+// GetLogsFromIndex returns the logs matching the criteria in the inclusive block range from the
+// persistent log index of the custom tx indexer, it returns `indexer.ErrLogIndexDisabled` or
+// `indexer.ErrLogRangeNotIndexed` when the query can't be served from the index.
+func (b *Backend) GetLogsFromIndex(
+	fromBlock, toBlock int64,
+	addresses []common.Address,
+	topics [][]common.Hash,
+	limit int,
+) ([]*ethtypes.Log, error) {
+	if b.indexer == nil {
+		return nil, indexer.ErrLogIndexDisabled
+	}
+	return b.indexer.GetLogs(fromBlock, toBlock, addresses, topics, limit)
+}
+
 // BloomStatus returns the BloomBitsBlocks and the number of processed sections maintained
 // by the chain indexer.
 func (b *Backend) BloomStatus() (uint64, uint64) {
@@ -167,6 +167,36 @@ func (_m *EVMQueryClient) CosmosAccount(ctx context.Context, in *types.QueryCosm
 	return r0, r1
 }
 
+// CreateAccessList provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) CreateAccessList(ctx context.Context, in *types.EthCallRequest, opts ...grpc.CallOption) (*types.CreateAccessListResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.CreateAccessListResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.EthCallRequest, ...grpc.CallOption) *types.CreateAccessListResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.CreateAccessListResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.EthCallRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // EstimateGas provides a mock function with given fields: ctx, in, opts
 func (_m *EVMQueryClient) EstimateGas(ctx context.Context, in *types.EthCallRequest, opts ...grpc.CallOption) (*types.EstimateGasResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -227,6 +257,36 @@ func (_m *EVMQueryClient) EthCall(ctx context.Context, in *types.EthCallRequest,
 	return r0, r1
 }
 
+// EthCallBundle provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) EthCallBundle(ctx context.Context, in *types.EthCallBundleRequest, opts ...grpc.CallOption) (*types.EthCallBundleResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.EthCallBundleResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.EthCallBundleRequest, ...grpc.CallOption) *types.EthCallBundleResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.EthCallBundleResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.EthCallBundleRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Params provides a mock function with given fields: ctx, in, opts
 func (_m *EVMQueryClient) Params(ctx context.Context, in *types.QueryParamsRequest, opts ...grpc.CallOption) (*types.QueryParamsResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -0,0 +1,194 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package backend
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+)
+
+// GetInternalOperations returns the value transfers, contract creations and self destructs executed by the
+// contracts called by the transaction.
+func (b *Backend) GetInternalOperations(hash common.Hash) ([]*rpctypes.InternalOperation, error) {
+	frame, err := b.traceCallFrame(hash)
+	if err != nil {
+		return nil, err
+	}
+	return rpctypes.InternalOperations(frame), nil
+}
+
+// GetTransactionError returns the revert data of the transaction, empty if it didn't fail.
+func (b *Backend) GetTransactionError(hash common.Hash) (hexutil.Bytes, error) {
+	frame, err := b.traceCallFrame(hash)
+	if err != nil {
+		return nil, err
+	}
+	if frame.Error == "" {
+		return hexutil.Bytes{}, nil
+	}
+	return frame.Output, nil
+}
+
+// OtsTraceTransaction returns the call frames of the transaction in the format of ots_traceTransaction.
+func (b *Backend) OtsTraceTransaction(hash common.Hash) ([]*rpctypes.OtsTraceEntry, error) {
+	frame, err := b.traceCallFrame(hash)
+	if err != nil {
+		return nil, err
+	}
+	return rpctypes.OtsTraceEntries(frame), nil
+}
+
+// SearchTransactions returns a page of the transactions sent by, sent to or creating the address, before or after
+// the given block which is excluded, 0 starts the search from the latest or the earliest block. The transactions
+// are ordered from the newest to the oldest and the receipts carry the block timestamp. It requires the address
+// index of the custom tx indexer.
+func (b *Backend) SearchTransactions(
+	address common.Address, blockNum, pageSize uint64, before bool,
+) (*rpctypes.TransactionsWithReceipts, error) {
+	if b.indexer == nil {
+		return nil, errors.New("the custom tx indexer is not enabled")
+	}
+
+	cursor := int64(blockNum)
+	if before && blockNum == 0 {
+		latest, err := b.BlockNumber()
+		if err != nil {
+			return nil, err
+		}
+		cursor = int64(latest) + 1
+	}
+	hashes, hasMore, err := b.indexer.SearchByAddress(address, cursor, before, int(pageSize))
+	if err != nil {
+		return nil, err
+	}
+
+	res := &rpctypes.TransactionsWithReceipts{
+		Txs:      make([]*rpctypes.RPCTransaction, 0, len(hashes)),
+		Receipts: make([]map[string]interface{}, 0, len(hashes)),
+	}
+	if before {
+		res.FirstPage, res.LastPage = blockNum == 0, !hasMore
+	} else {
+		res.FirstPage, res.LastPage = !hasMore, blockNum == 0
+		// the search after the block is ascending
+		for i, j := 0, len(hashes)-1; i < j; i, j = i+1, j-1 {
+			hashes[i], hashes[j] = hashes[j], hashes[i]
+		}
+	}
+
+	timestamps := make(map[int64]hexutil.Uint64)
+	for _, hash := range hashes {
+		tx, err := b.GetTransactionByHash(hash)
+		if err != nil {
+			return nil, err
+		}
+		receipt, err := b.GetTransactionReceipt(hash)
+		if err != nil {
+			return nil, err
+		}
+		if tx == nil || receipt == nil || tx.BlockNumber == nil {
+			b.logger.Debug("indexed tx not found", "hash", hash.Hex())
+			continue
+		}
+
+		height := tx.BlockNumber.ToInt().Int64()
+		timestamp, ok := timestamps[height]
+		if !ok {
+			resBlock, err := b.TendermintBlockByNumber(rpctypes.BlockNumber(height))
+			if err != nil {
+				return nil, err
+			}
+			if resBlock == nil || resBlock.Block == nil {
+				return nil, fmt.Errorf("block %d not found", height)
+			}
+			timestamp = hexutil.Uint64(resBlock.Block.Time.Unix())
+			timestamps[height] = timestamp
+		}
+		receipt["timestamp"] = timestamp
+
+		res.Txs = append(res.Txs, tx)
+		res.Receipts = append(res.Receipts, receipt)
+	}
+	return res, nil
+}
+
+// GetContractCreator returns the creation transaction and the creator of the contract, nil if the address isn't
+// a contract deployed by a contract creation transaction. It requires the address index of the custom tx indexer.
+func (b *Backend) GetContractCreator(address common.Address) (*rpctypes.ContractCreator, error) {
+	if b.indexer == nil {
+		return nil, errors.New("the custom tx indexer is not enabled")
+	}
+
+	hash, err := b.indexer.GetContractCreation(address)
+	if err != nil || hash == nil {
+		return nil, err
+	}
+	tx, err := b.GetTransactionByHash(*hash)
+	if err != nil || tx == nil {
+		return nil, err
+	}
+	return &rpctypes.ContractCreator{Hash: *hash, Creator: tx.From}, nil
+}
+
+// GetBlockDetails returns the block without its transactions, with the transaction count and the total fees
+// paid by the transactions.
+func (b *Backend) GetBlockDetails(blockNum rpctypes.BlockNumber) (*rpctypes.BlockDetails, error) {
+	block, err := b.GetBlockByNumber(blockNum, true)
+	if err != nil || block == nil {
+		return nil, err
+	}
+
+	txs, _ := block["transactions"].([]interface{})
+	height := rpctypes.BlockNumber(block["number"].(hexutil.Uint64))
+	receipts, err := b.GetBlockReceipts(rpctypes.BlockNumberOrHash{BlockNumber: &height})
+	if err != nil {
+		return nil, err
+	}
+	gasUsed := make(map[common.Hash]hexutil.Uint64, len(receipts))
+	for _, receipt := range receipts {
+		gasUsed[receipt["transactionHash"].(common.Hash)] = receipt["gasUsed"].(hexutil.Uint64)
+	}
+
+	totalFees := new(big.Int)
+	for _, tx := range txs {
+		rpcTx, ok := tx.(*rpctypes.RPCTransaction)
+		if !ok || rpcTx.GasPrice == nil {
+			continue
+		}
+		fee := new(big.Int).SetUint64(uint64(gasUsed[rpcTx.Hash]))
+		totalFees.Add(totalFees, fee.Mul(fee, rpcTx.GasPrice.ToInt()))
+	}
+
+	delete(block, "transactions")
+	block["transactionCount"] = len(txs)
+	block["logsBloom"] = nil
+
+	return &rpctypes.BlockDetails{
+		Block: block,
+		Issuance: rpctypes.BlockIssuance{
+			BlockReward: new(hexutil.Big),
+			UncleReward: new(hexutil.Big),
+			Issuance:    new(hexutil.Big),
+		},
+		TotalFees: (*hexutil.Big)(totalFees),
+	}, nil
+}
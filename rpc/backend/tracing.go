@@ -268,3 +268,194 @@ func (b *Backend) TraceCall(
 
 	return decodedResult, nil
 }
+
+// callTracerConfig returns the config of the native call tracer used to build the flat traces
+func callTracerConfig() *rpctypes.TraceConfig {
+	return &rpctypes.TraceConfig{TraceConfig: evmtypes.TraceConfig{Tracer: rpctypes.CallTracer}}
+}
+
+// decodeCallFrame decodes the result of the call tracer
+func decodeCallFrame(result interface{}) (*rpctypes.CallFrame, error) {
+	bz, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+	var frame rpctypes.CallFrame
+	if err := json.Unmarshal(bz, &frame); err != nil {
+		return nil, err
+	}
+	return &frame, nil
+}
+
+// traceCallFrame returns the call frames of the transaction traced by the call tracer
+func (b *Backend) traceCallFrame(hash common.Hash) (*rpctypes.CallFrame, error) {
+	result, err := b.TraceTransaction(hash, callTracerConfig())
+	if err != nil {
+		return nil, err
+	}
+	return decodeCallFrame(result)
+}
+
+// setTraceLocation sets the block and transaction fields of the flat traces of a transaction
+func setTraceLocation(traces []*rpctypes.FlatTrace, blockHash common.Hash, blockNumber uint64, txHash common.Hash, txIndex uint64) {
+	for _, trace := range traces {
+		trace.BlockHash = &blockHash
+		trace.BlockNumber = &blockNumber
+		trace.TransactionHash = &txHash
+		trace.TransactionPosition = &txIndex
+	}
+}
+
+// FlatTraceTransaction returns the call traces of the transaction in the OpenEthereum flat format.
+func (b *Backend) FlatTraceTransaction(hash common.Hash) ([]*rpctypes.FlatTrace, error) {
+	tx, err := b.GetTransactionByHash(hash)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil || tx.BlockHash == nil {
+		return nil, fmt.Errorf("transaction %s not found", hash)
+	}
+
+	frame, err := b.traceCallFrame(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	traces := rpctypes.FlattenCallFrame(frame)
+	setTraceLocation(traces, *tx.BlockHash, tx.BlockNumber.ToInt().Uint64(), hash, uint64(*tx.TransactionIndex))
+	return traces, nil
+}
+
+// FlatTraceBlock returns the call traces of all the transactions of the block in the OpenEthereum
+// flat format, block rewards are not traced.
+func (b *Backend) FlatTraceBlock(blockNum rpctypes.BlockNumber) ([]*rpctypes.FlatTrace, error) {
+	resBlock, err := b.TendermintBlockByNumber(blockNum)
+	if err != nil {
+		return nil, err
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, fmt.Errorf("block %d not found", blockNum)
+	}
+	if resBlock.Block.Height == 0 {
+		return nil, errors.New("genesis is not traceable")
+	}
+
+	blockRes, err := b.TendermintBlockResultByNumber(&resBlock.Block.Height)
+	if err != nil {
+		return nil, err
+	}
+	msgs := b.EthMsgsFromTendermintBlock(resBlock, blockRes)
+	traces := []*rpctypes.FlatTrace{}
+	if len(msgs) == 0 {
+		return traces, nil
+	}
+
+	results, err := b.TraceBlock(rpctypes.BlockNumber(resBlock.Block.Height), callTracerConfig(), resBlock)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) != len(msgs) {
+		return nil, fmt.Errorf("expected %d transaction traces, got %d", len(msgs), len(results))
+	}
+
+	blockHash := common.BytesToHash(resBlock.Block.Hash())
+	for i, res := range results {
+		if res.Error != "" {
+			return nil, fmt.Errorf("failed to trace transaction %s: %s", msgs[i].Hash, res.Error)
+		}
+		frame, err := decodeCallFrame(res.Result)
+		if err != nil {
+			return nil, err
+		}
+		txTraces := rpctypes.FlattenCallFrame(frame)
+		setTraceLocation(txTraces, blockHash, uint64(resBlock.Block.Height), common.HexToHash(msgs[i].Hash), uint64(i))
+		traces = append(traces, txTraces...)
+	}
+	return traces, nil
+}
+
+// TraceFilter returns the flat call traces of the block range matching the address filters, the range
+// defaults to the latest block and is bounded by the block range cap of `eth_getLogs`.
+func (b *Backend) TraceFilter(args rpctypes.TraceFilterArgs) ([]*rpctypes.FlatTrace, error) {
+	latest, err := b.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+	resolve := func(blockNum *rpctypes.BlockNumber) int64 {
+		switch {
+		case blockNum == nil || *blockNum < 0:
+			return int64(latest)
+		case *blockNum == rpctypes.EthEarliestBlockNumber:
+			return 1
+		default:
+			return blockNum.Int64()
+		}
+	}
+	from, to := resolve(args.FromBlock), resolve(args.ToBlock)
+	if from > to {
+		return nil, fmt.Errorf("invalid block range: from %d is greater than to %d", from, to)
+	}
+	if blockRange := b.RPCBlockRangeCap(); blockRange > 0 && to-from > int64(blockRange) {
+		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockRange)
+	}
+
+	var after, count uint64
+	if args.After != nil {
+		after = *args.After
+	}
+	if args.Count != nil {
+		count = *args.Count
+	}
+
+	traces := []*rpctypes.FlatTrace{}
+	if args.Count != nil && count == 0 {
+		return traces, nil
+	}
+	var skipped uint64
+	for height := from; height <= to; height++ {
+		blockTraces, err := b.FlatTraceBlock(rpctypes.BlockNumber(height))
+		if err != nil {
+			return nil, err
+		}
+		for _, trace := range blockTraces {
+			if !trace.Matches(args.FromAddress, args.ToAddress) {
+				continue
+			}
+			if skipped < after {
+				skipped++
+				continue
+			}
+			traces = append(traces, trace)
+			if args.Count != nil && uint64(len(traces)) >= count {
+				return traces, nil
+			}
+		}
+	}
+	return traces, nil
+}
+
+// ReplayTransaction replays the transaction and returns the requested trace types in the
+// OpenEthereum format, only the "trace" type is supported.
+func (b *Backend) ReplayTransaction(hash common.Hash, traceTypes []string) (*rpctypes.ReplayTransactionResult, error) {
+	withTrace := false
+	for _, traceType := range traceTypes {
+		if traceType != "trace" {
+			return nil, fmt.Errorf("trace type %s is not supported", traceType)
+		}
+		withTrace = true
+	}
+
+	frame, err := b.traceCallFrame(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &rpctypes.ReplayTransactionResult{
+		Output: frame.Output,
+		Trace:  []*rpctypes.FlatTrace{},
+	}
+	if withTrace {
+		res.Trace = rpctypes.FlattenCallFrame(frame)
+	}
+	return res, nil
+}
@@ -17,8 +17,10 @@ package backend
 
 import (
 	"fmt"
+	"math/big"
 
 	errorsmod "cosmossdk.io/errors"
+	abci "github.com/cometbft/cometbft/abci/types"
 	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -148,6 +150,10 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 	hexTx := hash.Hex()
 	b.logger.Debug("eth_getTransactionReceipt", "hash", hexTx)
 
+	if receipt, ok := b.cache.getReceipt(hash); ok {
+		return receipt, nil
+	}
+
 	res, err := b.GetTxByEthHash(hash)
 	if err != nil {
 		b.logger.Debug("tx not found", "hash", hexTx, "error", err.Error())
@@ -165,22 +171,168 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 	}
 	ethMsg := tx.GetMsgs()[res.MsgIndex].(*evmtypes.MsgEthereumTx)
 
-	txData, err := evmtypes.UnpackTxData(ethMsg.Data)
+	blockRes, err := b.TendermintBlockResultByNumber(&res.Height)
+	if err != nil {
+		b.logger.Debug("failed to retrieve block results", "height", res.Height, "error", err.Error())
+		return nil, nil
+	}
+	var precedingGasUsed uint64
+	for _, txResult := range blockRes.TxsResults[0:res.TxIndex] {
+		precedingGasUsed += uint64(txResult.GasUsed)
+	}
+
+	if res.EthTxIndex == -1 {
+		// Fallback to find tx index by iterating all valid eth transactions
+		msgs := b.EthMsgsFromTendermintBlock(resBlock, blockRes)
+		for i := range msgs {
+			if msgs[i].Hash == hexTx {
+				res.EthTxIndex = int32(i)
+				break
+			}
+		}
+	}
+	// return error if still unable to find the eth tx index
+	if res.EthTxIndex == -1 {
+		return nil, errors.New("can't find index of ethereum tx")
+	}
+
+	chainID, err := b.ChainID()
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := b.formatTxReceipt(hash, ethMsg, res, resBlock, blockRes, precedingGasUsed, chainID.ToInt(), b.receiptBaseFee(ethMsg, blockRes))
 	if err != nil {
-		b.logger.Error("failed to unpack tx data", "error", err.Error())
 		return nil, err
 	}
+	b.cache.receipts.Add(hash, copyReceipt(receipt))
+	return receipt, nil
+}
 
-	cumulativeGasUsed := uint64(0)
-	blockRes, err := b.TendermintBlockResultByNumber(&res.Height)
+// GetBlockReceipts returns the receipts of all the transactions of the block, they are built from a single
+// fetch of the block and its results.
+func (b *Backend) GetBlockReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error) {
+	b.logger.Debug("eth_getBlockReceipts", "block number or hash", blockNrOrHash)
+
+	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
 	if err != nil {
-		b.logger.Debug("failed to retrieve block results", "height", res.Height, "error", err.Error())
+		return nil, err
+	}
+	resBlock, err := b.TendermintBlockByNumber(blockNum)
+	if err != nil || resBlock == nil || resBlock.Block == nil {
+		b.logger.Debug("block not found", "number", blockNum)
 		return nil, nil
 	}
-	for _, txResult := range blockRes.TxsResults[0:res.TxIndex] {
-		cumulativeGasUsed += uint64(txResult.GasUsed)
+	blockRes, err := b.TendermintBlockResultByNumber(&resBlock.Block.Height)
+	if err != nil {
+		b.logger.Debug("failed to retrieve block results", "height", resBlock.Block.Height, "error", err.Error())
+		return nil, nil
+	}
+	chainID, err := b.ChainID()
+	if err != nil {
+		return nil, err
+	}
+
+	// the base fee is only needed by the dynamic fee txs, it's fetched once
+	var baseFee *big.Int
+	baseFeeFetched := false
+
+	receipts := []map[string]interface{}{}
+	var precedingGasUsed uint64
+	var ethTxIndex int32
+	for txIndex, txBz := range resBlock.Block.Txs {
+		result := blockRes.TxsResults[txIndex]
+		gasUsed := uint64(result.GasUsed)
+		if !rpctypes.TxSuccessOrExceedsBlockGasLimit(result) {
+			precedingGasUsed += gasUsed
+			continue
+		}
+		tx, err := b.clientCtx.TxConfig.TxDecoder()(txBz)
+		if err != nil {
+			b.logger.Debug("failed to decode transaction in block", "height", resBlock.Block.Height, "error", err.Error())
+			precedingGasUsed += gasUsed
+			continue
+		}
+		parsedTxs, err := rpctypes.ParseTxResult(result, tx)
+		if err != nil {
+			return nil, err
+		}
+
+		var cumulativeGasUsed uint64
+		for msgIndex, msg := range tx.GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				continue
+			}
+			res := &ethermint.TxResult{
+				Height:     resBlock.Block.Height,
+				TxIndex:    uint32(txIndex),
+				MsgIndex:   uint32(msgIndex),
+				EthTxIndex: ethTxIndex,
+			}
+			if result.Code != abci.CodeTypeOK {
+				// exceeds block gas limit scenario, the gas limit is charged by the ante handler,
+				// same as the custom tx indexer.
+				res.GasUsed = ethMsg.GetGas()
+				res.Failed = true
+			} else {
+				parsedTx := parsedTxs.GetTxByMsgIndex(msgIndex)
+				if parsedTx == nil {
+					return nil, fmt.Errorf("msg index %d not found in the events of tx %d of block %d", msgIndex, txIndex, resBlock.Block.Height)
+				}
+				res.GasUsed = parsedTx.GasUsed
+				res.Failed = parsedTx.Failed
+			}
+			cumulativeGasUsed += res.GasUsed
+			res.CumulativeGasUsed = cumulativeGasUsed
+			ethTxIndex++
+
+			if !baseFeeFetched {
+				baseFee = b.receiptBaseFee(ethMsg, blockRes)
+				baseFeeFetched = baseFee != nil
+			}
+			hash := ethMsg.AsTransaction().Hash()
+			receipt, err := b.formatTxReceipt(hash, ethMsg, res, resBlock, blockRes, precedingGasUsed, chainID.ToInt(), baseFee)
+			if err != nil {
+				return nil, err
+			}
+			receipts = append(receipts, receipt)
+		}
+		precedingGasUsed += gasUsed
+	}
+	return receipts, nil
+}
+
+// receiptBaseFee returns the base fee of the block if it's needed by the receipt of the msg
+func (b *Backend) receiptBaseFee(ethMsg *evmtypes.MsgEthereumTx, blockRes *tmrpctypes.ResultBlockResults) *big.Int {
+	if ethMsg.AsTransaction().Type() != ethtypes.DynamicFeeTxType {
+		return nil
+	}
+	baseFee, err := b.BaseFee(blockRes)
+	if err != nil {
+		// tolerate the error for pruned node.
+		b.logger.Error("fetch basefee failed, node is pruned?", "height", blockRes.Height, "error", err)
+	}
+	return baseFee
+}
+
+// formatTxReceipt builds the receipt of the eth msg from the block and its results, precedingGasUsed is the gas
+// used by the cosmos txs of the block before the one containing the msg. The effective gas price of the dynamic fee
+// txs is omitted if the base fee is nil.
+func (b *Backend) formatTxReceipt(
+	hash common.Hash,
+	ethMsg *evmtypes.MsgEthereumTx,
+	res *ethermint.TxResult,
+	resBlock *tmrpctypes.ResultBlock,
+	blockRes *tmrpctypes.ResultBlockResults,
+	precedingGasUsed uint64,
+	chainID *big.Int,
+	baseFee *big.Int,
+) (map[string]interface{}, error) {
+	txData, err := evmtypes.UnpackTxData(ethMsg.Data)
+	if err != nil {
+		b.logger.Error("failed to unpack tx data", "error", err.Error())
+		return nil, err
 	}
-	cumulativeGasUsed += res.CumulativeGasUsed
 
 	var status hexutil.Uint
 	if res.Failed {
@@ -188,12 +340,8 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 	} else {
 		status = hexutil.Uint(ethtypes.ReceiptStatusSuccessful)
 	}
-	chainID, err := b.ChainID()
-	if err != nil {
-		return nil, err
-	}
 
-	from, err := ethMsg.GetSenderLegacy(chainID.ToInt())
+	from, err := ethMsg.GetSenderLegacy(chainID)
 	if err != nil {
 		return nil, err
 	}
@@ -206,28 +354,13 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		uint64(blockRes.Height),
 	)
 	if err != nil {
-		b.logger.Debug("failed to parse logs", "hash", hexTx, "error", err.Error())
-	}
-
-	if res.EthTxIndex == -1 {
-		// Fallback to find tx index by iterating all valid eth transactions
-		msgs := b.EthMsgsFromTendermintBlock(resBlock, blockRes)
-		for i := range msgs {
-			if msgs[i].Hash == hexTx {
-				res.EthTxIndex = int32(i)
-				break
-			}
-		}
-	}
-	// return error if still unable to find the eth tx index
-	if res.EthTxIndex == -1 {
-		return nil, errors.New("can't find index of ethereum tx")
+		b.logger.Debug("failed to parse logs", "hash", hash.Hex(), "error", err.Error())
 	}
 
 	receipt := map[string]interface{}{
 		// Consensus fields: These fields are defined by the Yellow Paper
 		"status":            status,
-		"cumulativeGasUsed": hexutil.Uint64(cumulativeGasUsed),
+		"cumulativeGasUsed": hexutil.Uint64(precedingGasUsed + res.CumulativeGasUsed),
 		"logsBloom":         ethtypes.BytesToBloom(ethtypes.LogsBloom(logs)),
 		"logs":              logs,
 
@@ -258,14 +391,14 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		receipt["contractAddress"] = crypto.CreateAddress(from, txData.GetNonce())
 	}
 
-	if dynamicTx, ok := txData.(*evmtypes.DynamicFeeTx); ok {
-		baseFee, err := b.BaseFee(blockRes)
-		if err != nil {
-			// tolerate the error for pruned node.
-			b.logger.Error("fetch basefee failed, node is pruned?", "height", res.Height, "error", err)
-		} else {
-			receipt["effectiveGasPrice"] = hexutil.Big(*dynamicTx.EffectiveGasPrice(baseFee))
-		}
+	if dynamicTx, ok := txData.(*evmtypes.DynamicFeeTx); ok && baseFee != nil {
+		receipt["effectiveGasPrice"] = hexutil.Big(*dynamicTx.EffectiveGasPrice(baseFee))
+	}
+
+	// intermediate state root after the tx, not available for the txs executed before it was recorded
+	if txResponses, err := evmtypes.DecodeTxResponses(blockRes.TxsResults[res.TxIndex].Data); err == nil &&
+		int(res.MsgIndex) < len(txResponses) && len(txResponses[res.MsgIndex].StateRoot) > 0 {
+		receipt["root"] = hexutil.Bytes(txResponses[res.MsgIndex].StateRoot)
 	}
 
 	return receipt, nil
@@ -312,12 +445,67 @@ func (b *Backend) GetTransactionByBlockNumberAndIndex(blockNum rpctypes.BlockNum
 	return b.GetTransactionByBlockAndIndex(block, idx)
 }
 
+// AddressTxsPageSize is the number of transactions per page of `eth_getTransactionsByAddress`
+const AddressTxsPageSize = 100
+
+// GetTransactionsByAddress returns a page of the transactions sent by, sent to or creating the address in
+// the inclusive block range, ordered by block number and index. Pages start at 0 and contain at most
+// `AddressTxsPageSize` transactions. It requires the address index of the custom tx indexer.
+func (b *Backend) GetTransactionsByAddress(
+	address common.Address,
+	fromBlock, toBlock rpctypes.BlockNumber,
+	page uint64,
+) ([]*rpctypes.RPCTransaction, error) {
+	if b.indexer == nil {
+		return nil, errors.New("the custom tx indexer is not enabled")
+	}
+
+	latest, err := b.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+	from, to := fromBlock.Int64(), toBlock.Int64()
+	if fromBlock < rpctypes.EthEarliestBlockNumber {
+		from = int64(latest)
+	}
+	if toBlock < rpctypes.EthEarliestBlockNumber || to > int64(latest) {
+		to = int64(latest)
+	}
+
+	hashes, err := b.indexer.GetByAddress(address, from, to, int(page)*AddressTxsPageSize, AddressTxsPageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	txs := make([]*rpctypes.RPCTransaction, 0, len(hashes))
+	for _, hash := range hashes {
+		tx, err := b.GetTransactionByHash(hash)
+		if err != nil {
+			return nil, err
+		}
+		if tx == nil {
+			b.logger.Debug("indexed tx not found", "hash", hash.Hex())
+			continue
+		}
+		txs = append(txs, tx)
+	}
+	return txs, nil
+}
+
 // GetTxByEthHash uses `/tx_query` to find transaction by ethereum tx hash
 // TODO: Don't need to convert once hashing is fixed on Tendermint
 // https://github.com/tendermint/tendermint/issues/6539
 func (b *Backend) GetTxByEthHash(hash common.Hash) (*ethermint.TxResult, error) {
+	if txResult, ok := b.cache.getTxResult(hash); ok {
+		return txResult, nil
+	}
 	if b.indexer != nil {
-		return b.indexer.GetByTxHash(hash)
+		txResult, err := b.indexer.GetByTxHash(hash)
+		if err != nil {
+			return nil, err
+		}
+		b.cache.addTxResult(hash, txResult)
+		return txResult, nil
 	}
 
 	// fallback to tendermint tx indexer
@@ -328,6 +516,7 @@ func (b *Backend) GetTxByEthHash(hash common.Hash) (*ethermint.TxResult, error)
 	if err != nil {
 		return nil, errorsmod.Wrapf(err, "GetTxByEthHash %s", hash.Hex())
 	}
+	b.cache.addTxResult(hash, txResult)
 	return txResult, nil
 }
 
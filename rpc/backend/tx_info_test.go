@@ -137,7 +137,7 @@ func (suite *BackendTestSuite) TestGetTransactionsByHashPending() {
 			"fail - Pending transactions returns error",
 			func() {
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
-				RegisterUnconfirmedTxsError(client, nil)
+				RegisterUnconfirmedTxsError(client)
 			},
 			msgEthereumTx,
 			nil,
@@ -147,7 +147,7 @@ func (suite *BackendTestSuite) TestGetTransactionsByHashPending() {
 			"fail - Tx not found return nil",
 			func() {
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
-				RegisterUnconfirmedTxs(client, nil, nil)
+				RegisterUnconfirmedTxs(client, nil)
 			},
 			msgEthereumTx,
 			nil,
@@ -157,7 +157,7 @@ func (suite *BackendTestSuite) TestGetTransactionsByHashPending() {
 			"pass - Tx found and returned",
 			func() {
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
-				RegisterUnconfirmedTxs(client, nil, types.Txs{bz})
+				RegisterUnconfirmedTxs(client, types.Txs{bz})
 			},
 			msgEthereumTx,
 			rpcTransaction,
@@ -599,6 +599,103 @@ func (suite *BackendTestSuite) TestGetTransactionReceipt() {
 	}
 }
 
+func (suite *BackendTestSuite) TestGetBlockReceipts() {
+	msgEthereumTx, _ := suite.buildEthereumTx()
+	txBz := suite.signAndEncodeEthTx(msgEthereumTx)
+	txHash := msgEthereumTx.AsTransaction().Hash()
+	blockNum := rpctypes.BlockNumber(1)
+
+	txResults := []*abci.ResponseDeliverTx{
+		{
+			Code:    0,
+			GasUsed: 21000,
+			Events: []abci.Event{
+				{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "amount", Value: "1000"},
+					{Key: "txGasUsed", Value: "21000"},
+					{Key: "txHash", Value: ""},
+					{Key: "recipient", Value: "0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7"},
+				}},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expLen       int
+		expNil       bool
+	}{
+		{
+			"pass - block not found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			0,
+			true,
+		},
+		{
+			"pass - block without txs",
+			func() {
+				var header metadata.MD
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterParams(queryClient, &header, 1)
+				RegisterParamsWithoutHeader(queryClient, 1)
+				RegisterBlock(client, 1, nil)
+				res, _ := RegisterBlockResults(client, 1)
+				res.TxsResults = nil
+			},
+			0,
+			false,
+		},
+		{
+			"pass - the receipts match eth_getTransactionReceipt",
+			func() {
+				var header metadata.MD
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterParams(queryClient, &header, 1)
+				RegisterParamsWithoutHeader(queryClient, 1)
+				RegisterBlock(client, 1, txBz)
+				res, _ := RegisterBlockResults(client, 1)
+				res.TxsResults = txResults
+			},
+			1,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			tc.registerMock()
+
+			receipts, err := suite.backend.GetBlockReceipts(rpctypes.BlockNumberOrHash{BlockNumber: &blockNum})
+			suite.Require().NoError(err)
+			if tc.expNil {
+				suite.Require().Nil(receipts)
+				return
+			}
+			suite.Require().Len(receipts, tc.expLen)
+			if tc.expLen == 0 {
+				return
+			}
+
+			suite.backend.indexer = indexer.NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), suite.backend.clientCtx)
+			block := &types.Block{Header: types.Header{Height: 1}, Data: types.Data{Txs: []types.Tx{txBz}}}
+			suite.Require().NoError(suite.backend.indexer.IndexBlock(block, txResults))
+			receipt, err := suite.backend.GetTransactionReceipt(txHash)
+			suite.Require().NoError(err)
+			suite.Require().Equal(receipt, receipts[0])
+			suite.Require().Equal(hexutil.Uint64(21000), receipts[0]["gasUsed"])
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestGetGasUsed() {
 	origin := suite.backend.cfg.JSONRPC.FixRevertGasRefundHeight
 	testCases := []struct {
@@ -0,0 +1,165 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package backend
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// TxPoolContent returns the ethereum transactions of the mempool, indexed by sender and nonce.
+// The transactions executable against the current account nonce are pending, the ones behind
+// a nonce gap are queued, transactions with a nonce lower than the account nonce are omitted.
+func (b *Backend) TxPoolContent() (
+	pending, queued map[common.Address]map[uint64]*rpctypes.RPCTransaction, err error,
+) {
+	return b.txPoolContent(nil)
+}
+
+// TxPoolContentFrom returns the pending and queued transactions of the mempool sent by the address.
+func (b *Backend) TxPoolContentFrom(address common.Address) (
+	pending, queued map[uint64]*rpctypes.RPCTransaction, err error,
+) {
+	allPending, allQueued, err := b.txPoolContent(&address)
+	if err != nil {
+		return nil, nil, err
+	}
+	pending, queued = allPending[address], allQueued[address]
+	if pending == nil {
+		pending = make(map[uint64]*rpctypes.RPCTransaction)
+	}
+	if queued == nil {
+		queued = make(map[uint64]*rpctypes.RPCTransaction)
+	}
+	return pending, queued, nil
+}
+
+// TxPoolStatus returns the number of pending and queued transactions of the mempool. Only the
+// Ethereum transactions are counted, as grouped by sender and nonce by txPoolContent.
+func (b *Backend) TxPoolStatus() (pending, queued uint64, err error) {
+	pendingTxs, queuedTxs, err := b.txPoolContent(nil)
+	if err != nil {
+		return 0, 0, err
+	}
+	for _, txs := range pendingTxs {
+		pending += uint64(len(txs))
+	}
+	for _, txs := range queuedTxs {
+		queued += uint64(len(txs))
+	}
+	return pending, queued, nil
+}
+
+// txPoolContent groups the mempool transactions by sender and nonce, if from is not nil
+// only the transactions of that sender are returned.
+func (b *Backend) txPoolContent(from *common.Address) (
+	pending, queued map[common.Address]map[uint64]*rpctypes.RPCTransaction, err error,
+) {
+	txs, err := b.PendingTransactions()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	bySender := make(map[common.Address]map[uint64]*rpctypes.RPCTransaction)
+	for _, tx := range txs {
+		for _, msg := range (*tx).GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				// not valid ethereum tx
+				break
+			}
+
+			sender, err := ethMsg.GetSenderLegacy(b.chainID)
+			if err != nil {
+				b.logger.Debug("failed to recover the sender of a pending tx", "hash", ethMsg.Hash, "error", err.Error())
+				continue
+			}
+			if from != nil && sender != *from {
+				continue
+			}
+
+			rpcTx, err := rpctypes.NewTransactionFromMsg(ethMsg, common.Hash{}, uint64(0), uint64(0), nil, b.chainID)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			nonces, ok := bySender[sender]
+			if !ok {
+				nonces = make(map[uint64]*rpctypes.RPCTransaction)
+				bySender[sender] = nonces
+			}
+			// keep the first transaction seen for a nonce, the mempool is ordered by arrival
+			if _, ok := nonces[uint64(rpcTx.Nonce)]; !ok {
+				nonces[uint64(rpcTx.Nonce)] = rpcTx
+			}
+		}
+	}
+
+	pending = make(map[common.Address]map[uint64]*rpctypes.RPCTransaction)
+	queued = make(map[common.Address]map[uint64]*rpctypes.RPCTransaction)
+	for sender, txs := range bySender {
+		nonce, err := b.committedNonce(sender)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		sortedNonces := make([]uint64, 0, len(txs))
+		for n := range txs {
+			sortedNonces = append(sortedNonces, n)
+		}
+		sort.Slice(sortedNonces, func(i, j int) bool { return sortedNonces[i] < sortedNonces[j] })
+
+		for _, n := range sortedNonces {
+			switch {
+			case n < nonce:
+				// already committed, it'll be evicted on the next recheck
+				continue
+			case n == nonce:
+				if pending[sender] == nil {
+					pending[sender] = make(map[uint64]*rpctypes.RPCTransaction)
+				}
+				pending[sender][n] = txs[n]
+				nonce++
+			default:
+				if queued[sender] == nil {
+					queued[sender] = make(map[uint64]*rpctypes.RPCTransaction)
+				}
+				queued[sender][n] = txs[n]
+			}
+		}
+	}
+
+	return pending, queued, nil
+}
+
+// committedNonce returns the account nonce of the latest committed state, zero if the account doesn't exist.
+func (b *Backend) committedNonce(address common.Address) (uint64, error) {
+	accAddr := sdk.AccAddress(address.Bytes())
+	if err := b.clientCtx.AccountRetriever.EnsureExists(b.clientCtx, accAddr); err != nil {
+		// account doesn't exist yet
+		return 0, nil
+	}
+	_, seq, err := b.clientCtx.AccountRetriever.GetAccountNumberSequence(b.clientCtx, accAddr)
+	if err != nil {
+		return 0, err
+	}
+	return seq, nil
+}
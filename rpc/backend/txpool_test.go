@@ -0,0 +1,198 @@
+package backend
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/ethermint/rpc/backend/mocks"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// buildEthereumTxWithNonce returns the encoded legacy Ethereum transaction with the given nonce
+func (suite *BackendTestSuite) buildEthereumTxWithNonce(nonce uint64) types.Tx {
+	msgEthereumTx := evmtypes.NewTx(
+		suite.backend.chainID,
+		nonce,
+		&common.Address{},
+		big.NewInt(0),
+		100000,
+		big.NewInt(1),
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+	msgEthereumTx.From = suite.signerAddress
+	err := msgEthereumTx.Sign(ethtypes.LatestSignerForChainID(suite.backend.chainID), suite.signer)
+	suite.Require().NoError(err)
+
+	txBuilder := suite.backend.clientCtx.TxConfig.NewTxBuilder()
+	err = txBuilder.SetMsgs(msgEthereumTx)
+	suite.Require().NoError(err)
+
+	bz, err := suite.backend.clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	suite.Require().NoError(err)
+	return bz
+}
+
+// buildCosmosTx returns an encoded transaction carrying a bank send message
+func (suite *BackendTestSuite) buildCosmosTx() types.Tx {
+	msg := banktypes.NewMsgSend(suite.signerAddress, suite.signerAddress, sdk.NewCoins(sdk.NewInt64Coin(evmtypes.DefaultEVMDenom, 1)))
+
+	txBuilder := suite.backend.clientCtx.TxConfig.NewTxBuilder()
+	err := txBuilder.SetMsgs(msg)
+	suite.Require().NoError(err)
+
+	bz, err := suite.backend.clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	suite.Require().NoError(err)
+	return bz
+}
+
+func (suite *BackendTestSuite) TestTxPoolContent() {
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expPending   []uint64
+		expQueued    []uint64
+		expPass      bool
+	}{
+		{
+			"fail - can't fetch unconfirmed txs",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxsError(client)
+			},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"pass - account doesn't exist, nonce gap after the second tx",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxs(client, []types.Tx{
+					suite.buildEthereumTxWithNonce(1),
+					suite.buildEthereumTxWithNonce(0),
+					suite.buildEthereumTxWithNonce(3),
+				})
+			},
+			[]uint64{0, 1},
+			[]uint64{3},
+			true,
+		},
+		{
+			"pass - committed nonces are omitted",
+			func() {
+				suite.backend.clientCtx = suite.backend.clientCtx.WithAccountRetriever(client.TestAccountRetriever{
+					Accounts: map[string]client.TestAccount{
+						suite.signerAddress.String(): {Address: suite.signerAddress, Num: 1, Seq: 1},
+					},
+				})
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxs(client, []types.Tx{
+					suite.buildEthereumTxWithNonce(0),
+					suite.buildEthereumTxWithNonce(1),
+					suite.buildEthereumTxWithNonce(2),
+					suite.buildEthereumTxWithNonce(4),
+				})
+			},
+			[]uint64{1, 2},
+			[]uint64{4},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			pending, queued, err := suite.backend.TxPoolContent()
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			sender := common.BytesToAddress(suite.signerAddress)
+			suite.Require().Len(pending[sender], len(tc.expPending))
+			for _, nonce := range tc.expPending {
+				suite.Require().Equal(nonce, uint64(pending[sender][nonce].Nonce))
+			}
+			suite.Require().Len(queued[sender], len(tc.expQueued))
+			for _, nonce := range tc.expQueued {
+				suite.Require().Equal(nonce, uint64(queued[sender][nonce].Nonce))
+			}
+
+			// the mempool doesn't contain transactions of other senders
+			otherPending, otherQueued, err := suite.backend.TxPoolContentFrom(common.Address{})
+			suite.Require().NoError(err)
+			suite.Require().Empty(otherPending)
+			suite.Require().Empty(otherQueued)
+
+			fromPending, fromQueued, err := suite.backend.TxPoolContentFrom(sender)
+			suite.Require().NoError(err)
+			suite.Require().Equal(pending[sender], fromPending)
+			suite.Require().Equal(queued[sender], fromQueued)
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestTxPoolStatus() {
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expPending   uint64
+		expQueued    uint64
+		expPass      bool
+	}{
+		{
+			"fail - can't fetch unconfirmed txs",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxsError(client)
+			},
+			0,
+			0,
+			false,
+		},
+		{
+			"pass - non-ethereum txs are not counted",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxs(client, []types.Tx{
+					suite.buildEthereumTxWithNonce(0),
+					suite.buildCosmosTx(),
+					suite.buildEthereumTxWithNonce(1),
+					suite.buildEthereumTxWithNonce(3),
+				})
+			},
+			2,
+			1,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			pending, queued, err := suite.backend.TxPoolStatus()
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expPending, pending)
+			suite.Require().Equal(tc.expQueued, queued)
+		})
+	}
+}
@@ -208,6 +208,16 @@ func (b *Backend) processBlock(
 		if err != nil {
 			return err
 		}
+		if params.Params.BaseFeeAlgorithm == feemarkettypes.BaseFeeAlgorithmTimeWeighted {
+			// the time weighted base fee depends on the history, estimated by the fee market module
+			res, err := b.queryClient.FeeMarket.BaseFeeHistory(ctx, &feemarkettypes.QueryBaseFeeHistoryRequest{})
+			if err != nil {
+				return err
+			}
+			if res.NextBaseFee != nil {
+				nextBaseFee = res.NextBaseFee.BigInt()
+			}
+		}
 		targetOneFeeHistory.NextBaseFee = nextBaseFee
 	} else {
 		targetOneFeeHistory.NextBaseFee = new(big.Int)
@@ -0,0 +1,57 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package cosmos
+
+import (
+	"github.com/cometbft/cometbft/libs/log"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/ethermint/rpc/backend"
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+)
+
+// PublicAPI is the cosmos_ prefixed set of APIs, it exposes the state of the chain in a form that can
+// be verified against the app hash of the CometBFT headers, e.g. by light clients and bridges.
+type PublicAPI struct {
+	logger  log.Logger
+	backend backend.CosmosBackend
+}
+
+// NewPublicAPI creates an instance of the Cosmos API.
+func NewPublicAPI(logger log.Logger, backend backend.CosmosBackend) *PublicAPI {
+	return &PublicAPI{
+		logger:  logger.With("module", "cosmos"),
+		backend: backend,
+	}
+}
+
+// GetProof returns the account with the proofs of its balance, nonce, code hash and storage slots against
+// the app hash of the block, see rpctypes.VerifyAccountProof to verify it.
+func (api *PublicAPI) GetProof(
+	address common.Address,
+	storageKeys []string,
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+) (*rpctypes.VerifiableAccountResult, error) {
+	api.logger.Debug("cosmos_getProof", "address", address.Hex(), "keys", storageKeys, "block number or hash", blockNrOrHash)
+	return api.backend.GetVerifiableProof(address, storageKeys, blockNrOrHash)
+}
+
+// VerifyProof checks the proofs returned by cosmos_getProof against the app hash of the node.
+func (api *PublicAPI) VerifyProof(res *rpctypes.VerifiableAccountResult) (bool, error) {
+	api.logger.Debug("cosmos_verifyProof")
+	return api.backend.VerifyProof(res)
+}
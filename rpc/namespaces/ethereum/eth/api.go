@@ -60,9 +60,14 @@ type EthereumAPI interface {
 	GetTransactionByHash(hash common.Hash) (*rpctypes.RPCTransaction, error)
 	GetTransactionCount(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (*hexutil.Uint64, error)
 	GetTransactionReceipt(hash common.Hash) (map[string]interface{}, error)
+	GetBlockReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error)
 	GetTransactionByBlockHashAndIndex(hash common.Hash, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
 	GetTransactionByBlockNumberAndIndex(blockNum rpctypes.BlockNumber, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
-	// eth_getBlockReceipts
+	GetTransactionsByAddress(
+		address common.Address,
+		fromBlock, toBlock rpctypes.BlockNumber,
+		page hexutil.Uint64,
+	) ([]*rpctypes.RPCTransaction, error)
 
 	// Writing Transactions
 	//
@@ -86,14 +91,17 @@ type EthereumAPI interface {
 	//
 	// Allows developers to read data from the blockchain which includes executing
 	// smart contracts. However, no data is published to the Ethereum network.
-	Call(args evmtypes.TransactionArgs, blockNrOrHash rpctypes.BlockNumberOrHash, overrides *json.RawMessage) (hexutil.Bytes, error)
+	Call(args evmtypes.TransactionArgs, blockNrOrHash rpctypes.BlockNumberOrHash, overrides, blockOverrides *json.RawMessage) (hexutil.Bytes, error)
+	CallBundle(args rpctypes.CallBundleArgs) (*rpctypes.CallBundleResult, error)
+	CreateAccessList(args evmtypes.TransactionArgs, blockNrOrHash *rpctypes.BlockNumberOrHash, overrides *json.RawMessage) (*rpctypes.AccessListResult, error)
+	SimulateV1(opts rpctypes.SimulateArgs, blockNrOrHash rpctypes.BlockNumberOrHash) ([]*rpctypes.SimulateBlockResult, error)
 
 	// Chain Information
 	//
 	// Returns information on the Ethereum network and internal settings.
 	ProtocolVersion() hexutil.Uint
 	GasPrice() (*hexutil.Big, error)
-	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error)
+	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber, overrides, blockOverrides *json.RawMessage) (hexutil.Uint64, error)
 	FeeHistory(blockCount math.HexOrDecimal64, lastBlock rpc.BlockNumber, rewardPercentiles []float64) (*rpctypes.FeeHistoryResult, error)
 	MaxPriorityFeePerGas() (*hexutil.Big, error)
 	ChainId() (*hexutil.Big, error)
@@ -198,6 +206,12 @@ func (e *PublicAPI) GetTransactionReceipt(hash common.Hash) (map[string]interfac
 	return e.backend.GetTransactionReceipt(hash)
 }
 
+// GetBlockReceipts returns the receipts of all the transactions of the block.
+func (e *PublicAPI) GetBlockReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error) {
+	e.logger.Debug("eth_getBlockReceipts", "block number or hash", blockNrOrHash)
+	return e.backend.GetBlockReceipts(blockNrOrHash)
+}
+
 // GetBlockTransactionCountByHash returns the number of transactions in the block identified by hash.
 func (e *PublicAPI) GetBlockTransactionCountByHash(hash common.Hash) *hexutil.Uint {
 	e.logger.Debug("eth_getBlockTransactionCountByHash", "hash", hash.Hex())
@@ -222,6 +236,17 @@ func (e *PublicAPI) GetTransactionByBlockNumberAndIndex(blockNum rpctypes.BlockN
 	return e.backend.GetTransactionByBlockNumberAndIndex(blockNum, idx)
 }
 
+// GetTransactionsByAddress returns a page of the transactions sent by, sent to or creating the address
+// in the block range, it requires the address index of the custom tx indexer.
+func (e *PublicAPI) GetTransactionsByAddress(
+	address common.Address,
+	fromBlock, toBlock rpctypes.BlockNumber,
+	page hexutil.Uint64,
+) ([]*rpctypes.RPCTransaction, error) {
+	e.logger.Debug("eth_getTransactionsByAddress", "address", address.Hex(), "from", fromBlock, "to", toBlock, "page", page)
+	return e.backend.GetTransactionsByAddress(address, fromBlock, toBlock, uint64(page))
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 ///                           Write Txs					                            ///
 ///////////////////////////////////////////////////////////////////////////////
@@ -282,7 +307,7 @@ func (e *PublicAPI) GetProof(address common.Address,
 // Call performs a raw contract call.
 func (e *PublicAPI) Call(args evmtypes.TransactionArgs,
 	blockNrOrHash rpctypes.BlockNumberOrHash,
-	overrides *json.RawMessage,
+	overrides, blockOverrides *json.RawMessage,
 ) (hexutil.Bytes, error) {
 	e.logger.Debug("eth_call", "args", args.String(), "block number or hash", blockNrOrHash)
 
@@ -290,7 +315,7 @@ func (e *PublicAPI) Call(args evmtypes.TransactionArgs,
 	if err != nil {
 		return nil, err
 	}
-	data, err := e.backend.DoCall(args, blockNum, overrides)
+	data, err := e.backend.DoCall(args, blockNum, overrides, blockOverrides)
 	if err != nil {
 		return []byte{}, err
 	}
@@ -298,6 +323,43 @@ func (e *PublicAPI) Call(args evmtypes.TransactionArgs,
 	return (hexutil.Bytes)(data.Ret), nil
 }
 
+// CallBundle simulates an ordered bundle of signed or unsigned transactions against the same state,
+// each transaction sees the state changes of the previous ones.
+func (e *PublicAPI) CallBundle(args rpctypes.CallBundleArgs) (*rpctypes.CallBundleResult, error) {
+	e.logger.Debug("eth_callBundle", "txs", len(args.Txs))
+	return e.backend.CallBundle(args)
+}
+
+// CreateAccessList creates an EIP-2930 type AccessList for the given transaction.
+// The access list is created on top of the state of the given block, or the latest one if omitted.
+func (e *PublicAPI) CreateAccessList(
+	args evmtypes.TransactionArgs,
+	blockNrOrHash *rpctypes.BlockNumberOrHash,
+	overrides *json.RawMessage,
+) (*rpctypes.AccessListResult, error) {
+	e.logger.Debug("eth_createAccessList", "args", args.String(), "block number or hash", blockNrOrHash)
+
+	latest := rpctypes.EthLatestBlockNumber
+	bNrOrHash := rpctypes.BlockNumberOrHash{BlockNumber: &latest}
+	if blockNrOrHash != nil {
+		bNrOrHash = *blockNrOrHash
+	}
+	blockNum, err := e.backend.BlockNumberFromTendermint(bNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	return e.backend.CreateAccessList(args, blockNum, overrides)
+}
+
+// SimulateV1 simulates a block of calls against the state of the given block.
+func (e *PublicAPI) SimulateV1(
+	opts rpctypes.SimulateArgs,
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+) ([]*rpctypes.SimulateBlockResult, error) {
+	e.logger.Debug("eth_simulateV1", "block number or hash", blockNrOrHash)
+	return e.backend.SimulateV1(opts, blockNrOrHash)
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 ///                           Event Logs													          ///
 ///////////////////////////////////////////////////////////////////////////////
@@ -320,9 +382,13 @@ func (e *PublicAPI) GasPrice() (*hexutil.Big, error) {
 }
 
 // EstimateGas returns an estimate of gas usage for the given smart contract call.
-func (e *PublicAPI) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error) {
+func (e *PublicAPI) EstimateGas(
+	args evmtypes.TransactionArgs,
+	blockNrOptional *rpctypes.BlockNumber,
+	overrides, blockOverrides *json.RawMessage,
+) (hexutil.Uint64, error) {
 	e.logger.Debug("eth_estimateGas")
-	return e.backend.EstimateGas(args, blockNrOptional)
+	return e.backend.EstimateGas(args, blockNrOptional, overrides, blockOverrides)
 }
 
 func (e *PublicAPI) FeeHistory(blockCount math.HexOrDecimal64,
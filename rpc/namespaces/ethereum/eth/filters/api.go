@@ -34,6 +34,7 @@ import (
 
 	"github.com/evmos/ethermint/rpc/stream"
 	"github.com/evmos/ethermint/rpc/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
 )
 
 // FilterAPI gathers
@@ -56,6 +57,7 @@ type Backend interface {
 	TendermintBlockResultByNumber(height *int64) (*coretypes.ResultBlockResults, error)
 	GetLogs(blockHash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(*int64) ([][]*ethtypes.Log, error)
+	GetLogsFromIndex(fromBlock, toBlock int64, addresses []common.Address, topics [][]common.Hash, limit int) ([]*ethtypes.Log, error)
 	BlockBloom(blockRes *coretypes.ResultBlockResults) (ethtypes.Bloom, error)
 
 	BloomStatus() (uint64, uint64)
@@ -320,9 +322,13 @@ func (api *PublicFilterAPI) GetFilterChanges(id rpc.ID) (interface{}, error) {
 
 	switch f.typ {
 	case filters.PendingTransactionsSubscription:
-		var hashes []common.Hash
-		hashes, f.offset = api.events.PendingTxStream().ReadAllNonBlocking(f.offset)
-		return returnHashes(hashes), nil
+		var txs []*evmtypes.MsgEthereumTx
+		txs, f.offset = api.events.PendingTxStream().ReadAllNonBlocking(f.offset)
+		hashes := make([]common.Hash, len(txs))
+		for i, tx := range txs {
+			hashes[i] = common.HexToHash(tx.Hash)
+		}
+		return hashes, nil
 	case filters.BlocksSubscription:
 		var headers []stream.RPCHeader
 		headers, f.offset = api.events.HeaderStream().ReadAllNonBlocking(f.offset)
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/evmos/ethermint/indexer"
 	"github.com/evmos/ethermint/rpc/backend"
 	"github.com/evmos/ethermint/rpc/types"
 
@@ -165,6 +166,21 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 
 	from := f.criteria.FromBlock.Int64()
 	to := f.criteria.ToBlock.Int64()
+
+	// serve the query from the persistent log index when it covers the range,
+	// otherwise fallback to scan the block results.
+	indexTo := to
+	if indexTo > head {
+		indexTo = head
+	}
+	indexed, err := f.backend.GetLogsFromIndex(from, indexTo, f.criteria.Addresses, f.criteria.Topics, logLimit)
+	switch {
+	case err == nil:
+		return indexed, nil
+	case !errors.Is(err, indexer.ErrLogIndexDisabled) && !errors.Is(err, indexer.ErrLogRangeNotIndexed):
+		return nil, err
+	}
+
 	logs := []*ethtypes.Log{}
 
 	for height := from; height <= to; height++ {
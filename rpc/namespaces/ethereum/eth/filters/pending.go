@@ -0,0 +1,108 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package filters
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// PendingTxFilter defines the options of the newPendingTransactions subscriptions. It is decoded
+// either from the full transactions flag of go-ethereum or from an object which can also filter
+// the transactions by sender and recipient addresses:
+//
+//	{"fullTx": true, "fromAddress": ["0x..."], "toAddress": "0x..."}
+type PendingTxFilter struct {
+	FullTx      bool
+	FromAddress []common.Address
+	ToAddress   []common.Address
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (f *PendingTxFilter) UnmarshalJSON(input []byte) error {
+	var fullTx bool
+	if err := json.Unmarshal(input, &fullTx); err == nil {
+		*f = PendingTxFilter{FullTx: fullTx}
+		return nil
+	}
+
+	var raw struct {
+		FullTx      bool        `json:"fullTx"`
+		FromAddress addressList `json:"fromAddress"`
+		ToAddress   addressList `json:"toAddress"`
+	}
+	if err := json.Unmarshal(input, &raw); err != nil {
+		return errors.New("invalid pending transactions options; must be a boolean or an object")
+	}
+	*f = PendingTxFilter{
+		FullTx:      raw.FullTx,
+		FromAddress: raw.FromAddress,
+		ToAddress:   raw.ToAddress,
+	}
+	return nil
+}
+
+// Match returns true if the transaction is sent from and to one of the filtered addresses, an
+// empty list of addresses matches all transactions.
+func (f *PendingTxFilter) Match(tx *evmtypes.MsgEthereumTx) bool {
+	if f == nil {
+		return true
+	}
+	if len(f.FromAddress) > 0 && !includes(f.FromAddress, tx.GetSender()) {
+		return false
+	}
+	if len(f.ToAddress) > 0 {
+		to := tx.AsTransaction().To()
+		// contract creations have no recipient
+		if to == nil || !includes(f.ToAddress, *to) {
+			return false
+		}
+	}
+	return true
+}
+
+// Result returns the notification sent for the transaction, its hash or the full transaction.
+func (f *PendingTxFilter) Result(tx *evmtypes.MsgEthereumTx) (interface{}, error) {
+	if f == nil || !f.FullTx {
+		return common.HexToHash(tx.Hash), nil
+	}
+	ethTx := tx.AsTransaction()
+	return rpctypes.NewRPCTransaction(ethTx, common.Hash{}, 0, 0, nil, ethTx.ChainId())
+}
+
+// addressList decodes either a single address or a list of addresses.
+type addressList []common.Address
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (l *addressList) UnmarshalJSON(input []byte) error {
+	var address common.Address
+	if err := json.Unmarshal(input, &address); err == nil {
+		*l = addressList{address}
+		return nil
+	}
+
+	var addresses []common.Address
+	if err := json.Unmarshal(input, &addresses); err != nil {
+		return errors.New("invalid addresses; must be address or array of addresses")
+	}
+	*l = addresses
+	return nil
+}
@@ -0,0 +1,99 @@
+package filters
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+	"github.com/evmos/ethermint/tests"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+func signedTx(t *testing.T, to *common.Address) *evmtypes.MsgEthereumTx {
+	chainID := big.NewInt(9000)
+	from, priv := tests.NewAddrKey()
+	msg := evmtypes.NewTx(chainID, 0, to, big.NewInt(1), 21000, big.NewInt(1), nil, nil, nil, nil)
+	msg.From = from.Bytes()
+	require.NoError(t, msg.Sign(ethtypes.LatestSignerForChainID(chainID), tests.NewSigner(priv)))
+	return msg
+}
+
+func TestPendingTxFilterUnmarshal(t *testing.T) {
+	addr := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	testCases := []struct {
+		name     string
+		input    string
+		expected PendingTxFilter
+		expErr   bool
+	}{
+		{"full tx flag", `true`, PendingTxFilter{FullTx: true}, false},
+		{"hashes flag", `false`, PendingTxFilter{}, false},
+		{
+			"single addresses",
+			`{"fullTx":true,"fromAddress":"` + addr.Hex() + `","toAddress":"` + addr.Hex() + `"}`,
+			PendingTxFilter{FullTx: true, FromAddress: []common.Address{addr}, ToAddress: []common.Address{addr}},
+			false,
+		},
+		{
+			"address list",
+			`{"toAddress":["` + addr.Hex() + `"]}`,
+			PendingTxFilter{ToAddress: []common.Address{addr}},
+			false,
+		},
+		{"invalid address", `{"toAddress":1}`, PendingTxFilter{}, true},
+		{"invalid options", `"0x1"`, PendingTxFilter{}, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var f PendingTxFilter
+			err := json.Unmarshal([]byte(tc.input), &f)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, f)
+		})
+	}
+}
+
+func TestPendingTxFilterMatch(t *testing.T) {
+	to := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	tx := signedTx(t, &to)
+	creation := signedTx(t, nil)
+	other := common.HexToAddress("0x1000000000000000000000000000000000000002")
+
+	var nilFilter *PendingTxFilter
+	require.True(t, nilFilter.Match(tx))
+	require.True(t, (&PendingTxFilter{}).Match(creation))
+	require.True(t, (&PendingTxFilter{ToAddress: []common.Address{other, to}}).Match(tx))
+	require.False(t, (&PendingTxFilter{ToAddress: []common.Address{other}}).Match(tx))
+	require.False(t, (&PendingTxFilter{ToAddress: []common.Address{to}}).Match(creation))
+	require.True(t, (&PendingTxFilter{FromAddress: []common.Address{tx.GetSender()}}).Match(tx))
+	require.False(t, (&PendingTxFilter{FromAddress: []common.Address{other}, ToAddress: []common.Address{to}}).Match(tx))
+}
+
+func TestPendingTxFilterResult(t *testing.T) {
+	to := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	tx := signedTx(t, &to)
+
+	var nilFilter *PendingTxFilter
+	res, err := nilFilter.Result(tx)
+	require.NoError(t, err)
+	require.Equal(t, common.HexToHash(tx.Hash), res)
+
+	res, err = (&PendingTxFilter{FullTx: true}).Result(tx)
+	require.NoError(t, err)
+	rpcTx, ok := res.(*rpctypes.RPCTransaction)
+	require.True(t, ok)
+	require.Equal(t, common.HexToHash(tx.Hash), rpcTx.Hash)
+	require.Equal(t, tx.GetSender(), rpcTx.From)
+	require.Equal(t, &to, rpcTx.To)
+	require.Nil(t, rpcTx.BlockHash)
+}
@@ -0,0 +1,113 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package filters
+
+import (
+	"context"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/evmos/ethermint/rpc/stream"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// NewHeads sends a notification each time a new block is appended to the chain.
+// The subscriptions are available to the transports supporting notifications, like IPC, the
+// websocket server handles its own subscriptions.
+func (api *PublicFilterAPI) NewHeads(ctx context.Context) (*rpc.Subscription, error) {
+	return subscribe(ctx, api.events.HeaderStream(), func(notifier *rpc.Notifier, id rpc.ID, headers []stream.RPCHeader) error {
+		for _, header := range headers {
+			if err := notifier.Notify(id, header.EthHeader); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Logs sends a notification for each new log matching the given criteria.
+func (api *PublicFilterAPI) Logs(ctx context.Context, crit filters.FilterCriteria) (*rpc.Subscription, error) {
+	return subscribe(ctx, api.events.LogStream(), func(notifier *rpc.Notifier, id rpc.ID, txLogs []*ethtypes.Log) error {
+		for _, log := range FilterLogs(txLogs, crit.FromBlock, crit.ToBlock, crit.Addresses, crit.Topics) {
+			if err := notifier.Notify(id, log); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// NewPendingTransactions sends a notification with the hash of each transaction entering the mempool,
+// or with the full transaction if requested by the options, which can also filter the transactions
+// by sender and recipient.
+func (api *PublicFilterAPI) NewPendingTransactions(ctx context.Context, opts *PendingTxFilter) (*rpc.Subscription, error) {
+	return subscribe(ctx, api.events.PendingTxStream(), func(notifier *rpc.Notifier, id rpc.ID, txs []*evmtypes.MsgEthereumTx) error {
+		for _, tx := range txs {
+			if !opts.Match(tx) {
+				continue
+			}
+			res, err := opts.Result(tx)
+			if err != nil {
+				api.logger.Debug("failed to convert pending transaction", "hash", tx.Hash, "error", err.Error())
+				continue
+			}
+			if err := notifier.Notify(id, res); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// subscribe creates a subscription forwarding the new items of the stream to the client, until it
+// unsubscribes or the connection is closed.
+func subscribe[V any](
+	ctx context.Context,
+	s *stream.Stream[V],
+	notify func(notifier *rpc.Notifier, id rpc.ID, items []V) error,
+) (*rpc.Subscription, error) {
+	return runSubscription(ctx, func(subCtx context.Context, notifier *rpc.Notifier, id rpc.ID) error {
+		return s.Subscribe(subCtx, func(items []V, _ int) error {
+			return notify(notifier, id, items)
+		})
+	})
+}
+
+// runSubscription creates a subscription and runs fn in the background with a context canceled
+// when the client unsubscribes or the connection is closed.
+func runSubscription(
+	ctx context.Context,
+	fn func(subCtx context.Context, notifier *rpc.Notifier, id rpc.ID) error,
+) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+
+	sub := notifier.CreateSubscription()
+	subCtx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-sub.Err()
+		cancel()
+	}()
+	go func() {
+		defer cancel()
+		_ = fn(subCtx, notifier, sub.ID)
+	}()
+	return sub, nil
+}
@@ -0,0 +1,94 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package filters
+
+import (
+	"context"
+
+	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/evmos/ethermint/rpc/stream"
+)
+
+// SyncStatus is the progress of the node catching up with the chain.
+type SyncStatus struct {
+	StartingBlock hexutil.Uint64 `json:"startingBlock"`
+	CurrentBlock  hexutil.Uint64 `json:"currentBlock"`
+	HighestBlock  hexutil.Uint64 `json:"highestBlock"`
+}
+
+// SyncingResult is the notification of the syncing subscriptions, in the format used by go-ethereum.
+type SyncingResult struct {
+	Syncing bool       `json:"syncing"`
+	Status  SyncStatus `json:"status"`
+}
+
+// WatchSyncing checks the status of the node each time a new block is received and passes the
+// result to notify the first time and then each time the node starts or stops catching up.
+// It only stops when the context is canceled or notify fails.
+//
+// The starting block is the height of the node when it began catching up, or when the watch began
+// if it wasn't. The height of the peers is unknown, as CometBFT doesn't expose it, so the highest
+// block is the latest block of the node.
+func WatchSyncing(
+	ctx context.Context,
+	client tmrpcclient.StatusClient,
+	headers *stream.Stream[stream.RPCHeader],
+	notify func(*SyncingResult) error,
+) error {
+	var (
+		last     *SyncingResult
+		starting hexutil.Uint64
+	)
+	return headers.Subscribe(ctx, func(_ []stream.RPCHeader, _ int) error {
+		status, err := client.Status(ctx)
+		if err != nil {
+			// checked again on the next block
+			return nil
+		}
+
+		height := hexutil.Uint64(status.SyncInfo.LatestBlockHeight)
+		syncing := status.SyncInfo.CatchingUp
+		if last == nil || (syncing && !last.Syncing) {
+			starting = height
+		}
+		if last != nil && last.Syncing == syncing {
+			return nil
+		}
+
+		last = &SyncingResult{
+			Syncing: syncing,
+			Status: SyncStatus{
+				StartingBlock: starting,
+				CurrentBlock:  height,
+				HighestBlock:  height,
+			},
+		}
+		return notify(last)
+	})
+}
+
+// Syncing sends a notification with the sync status of the node on the next block, and then each
+// time the node starts or stops catching up with the chain.
+func (api *PublicFilterAPI) Syncing(ctx context.Context) (*rpc.Subscription, error) {
+	return runSubscription(ctx, func(subCtx context.Context, notifier *rpc.Notifier, id rpc.ID) error {
+		return WatchSyncing(subCtx, api.clientCtx.Client, api.events.HeaderStream(), func(res *SyncingResult) error {
+			return notifier.Notify(id, res)
+		})
+	})
+}
@@ -0,0 +1,66 @@
+package filters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/rpc/stream"
+)
+
+type statusClient struct {
+	catchingUp chan bool
+	height     int64
+}
+
+func (c *statusClient) Status(context.Context) (*coretypes.ResultStatus, error) {
+	c.height++
+	return &coretypes.ResultStatus{
+		SyncInfo: coretypes.SyncInfo{
+			CatchingUp:          <-c.catchingUp,
+			EarliestBlockHeight: 1,
+			LatestBlockHeight:   c.height + 10,
+		},
+	}, nil
+}
+
+func TestWatchSyncing(t *testing.T) {
+	client := &statusClient{catchingUp: make(chan bool, 4)}
+	headers := stream.NewStream[stream.RPCHeader](1, 16)
+	results := make(chan *SyncingResult, 4)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		_ = WatchSyncing(ctx, client, headers, func(res *SyncingResult) error {
+			results <- res
+			return nil
+		})
+	}()
+
+	// give the subscription time to start reading the stream
+	time.Sleep(50 * time.Millisecond)
+	for _, catchingUp := range []bool{false, true, true, false} {
+		client.catchingUp <- catchingUp
+		headers.Add(stream.RPCHeader{})
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	first := <-results
+	require.False(t, first.Syncing)
+	require.Equal(t, SyncStatus{StartingBlock: 11, CurrentBlock: 11, HighestBlock: 11}, first.Status)
+
+	// the starting block is the height at which the catch up began
+	second := <-results
+	require.True(t, second.Syncing)
+	require.Equal(t, SyncStatus{StartingBlock: 12, CurrentBlock: 12, HighestBlock: 12}, second.Status)
+
+	third := <-results
+	require.False(t, third.Syncing)
+	require.Equal(t, SyncStatus{StartingBlock: 12, CurrentBlock: 14, HighestBlock: 14}, third.Status)
+
+	require.Empty(t, results)
+}
@@ -102,15 +102,6 @@ func bloomFilter(bloom ethtypes.Bloom, addresses []common.Address, topics [][]co
 	return true
 }
 
-// returnHashes is a helper that will return an empty hash array case the given hash array is nil,
-// otherwise the given hashes array is returned.
-func returnHashes(hashes []common.Hash) []common.Hash {
-	if hashes == nil {
-		return []common.Hash{}
-	}
-	return hashes
-}
-
 // returnLogs is a helper that will return an empty log array in case the given logs array is nil,
 // otherwise the given logs array is returned.
 func returnLogs(logs []*ethtypes.Log) []*ethtypes.Log {
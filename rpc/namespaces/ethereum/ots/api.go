@@ -0,0 +1,93 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package ots
+
+import (
+	"github.com/cometbft/cometbft/libs/log"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/evmos/ethermint/rpc/backend"
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+)
+
+// API is the ots_ prefixed set of APIs used by the Otterscan block explorer, the address search and the
+// contract creator lookup require the address index of the custom tx indexer.
+type API struct {
+	logger  log.Logger
+	backend backend.EVMBackend
+}
+
+// NewAPI creates an instance of the Otterscan API.
+func NewAPI(logger log.Logger, backend backend.EVMBackend) *API {
+	return &API{
+		logger:  logger.With("module", "ots"),
+		backend: backend,
+	}
+}
+
+// GetApiLevel returns the level of the Otterscan API implemented by the node.
+func (api *API) GetApiLevel() uint64 { //nolint: golint, stylecheck, revive
+	api.logger.Debug("ots_getApiLevel")
+	return rpctypes.OtsAPILevel
+}
+
+// GetInternalOperations returns the value transfers, contract creations and self destructs of the transaction.
+func (api *API) GetInternalOperations(hash common.Hash) ([]*rpctypes.InternalOperation, error) {
+	api.logger.Debug("ots_getInternalOperations", "hash", hash)
+	return api.backend.GetInternalOperations(hash)
+}
+
+// GetTransactionError returns the revert data of the transaction.
+func (api *API) GetTransactionError(hash common.Hash) (hexutil.Bytes, error) {
+	api.logger.Debug("ots_getTransactionError", "hash", hash)
+	return api.backend.GetTransactionError(hash)
+}
+
+// TraceTransaction returns the call frames of the transaction.
+func (api *API) TraceTransaction(hash common.Hash) ([]*rpctypes.OtsTraceEntry, error) {
+	api.logger.Debug("ots_traceTransaction", "hash", hash)
+	return api.backend.OtsTraceTransaction(hash)
+}
+
+// SearchTransactionsBefore returns a page of the transactions of the address before the block.
+func (api *API) SearchTransactionsBefore(
+	address common.Address, blockNum, pageSize uint64,
+) (*rpctypes.TransactionsWithReceipts, error) {
+	api.logger.Debug("ots_searchTransactionsBefore", "address", address, "block", blockNum, "page size", pageSize)
+	return api.backend.SearchTransactions(address, blockNum, pageSize, true)
+}
+
+// SearchTransactionsAfter returns a page of the transactions of the address after the block.
+func (api *API) SearchTransactionsAfter(
+	address common.Address, blockNum, pageSize uint64,
+) (*rpctypes.TransactionsWithReceipts, error) {
+	api.logger.Debug("ots_searchTransactionsAfter", "address", address, "block", blockNum, "page size", pageSize)
+	return api.backend.SearchTransactions(address, blockNum, pageSize, false)
+}
+
+// GetContractCreator returns the creation transaction and the creator of the contract.
+func (api *API) GetContractCreator(address common.Address) (*rpctypes.ContractCreator, error) {
+	api.logger.Debug("ots_getContractCreator", "address", address)
+	return api.backend.GetContractCreator(address)
+}
+
+// GetBlockDetails returns the block without its transactions, with the transaction count and the total fees.
+func (api *API) GetBlockDetails(blockNum rpctypes.BlockNumber) (*rpctypes.BlockDetails, error) {
+	api.logger.Debug("ots_getBlockDetails", "number", blockNum)
+	return api.backend.GetBlockDetails(blockNum)
+}
@@ -0,0 +1,63 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package trace
+
+import (
+	"github.com/cometbft/cometbft/libs/log"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/ethermint/rpc/backend"
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+)
+
+// API is the trace_ prefixed set of APIs, it returns the call traces in the flat format of OpenEthereum.
+type API struct {
+	logger  log.Logger
+	backend backend.EVMBackend
+}
+
+// NewAPI creates an instance of the trace API.
+func NewAPI(logger log.Logger, backend backend.EVMBackend) *API {
+	return &API{
+		logger:  logger.With("module", "trace"),
+		backend: backend,
+	}
+}
+
+// Block returns the call traces of all the transactions of the block.
+func (api *API) Block(blockNum rpctypes.BlockNumber) ([]*rpctypes.FlatTrace, error) {
+	api.logger.Debug("trace_block", "number", blockNum)
+	return api.backend.FlatTraceBlock(blockNum)
+}
+
+// Transaction returns the call traces of the transaction.
+func (api *API) Transaction(hash common.Hash) ([]*rpctypes.FlatTrace, error) {
+	api.logger.Debug("trace_transaction", "hash", hash)
+	return api.backend.FlatTraceTransaction(hash)
+}
+
+// Filter returns the call traces of a block range filtered by the from and to addresses.
+func (api *API) Filter(args rpctypes.TraceFilterArgs) ([]*rpctypes.FlatTrace, error) {
+	api.logger.Debug("trace_filter", "from", args.FromBlock, "to", args.ToBlock)
+	return api.backend.TraceFilter(args)
+}
+
+// ReplayTransaction replays the transaction and returns the requested trace types.
+func (api *API) ReplayTransaction(hash common.Hash, traceTypes []string) (*rpctypes.ReplayTransactionResult, error) {
+	api.logger.Debug("trace_replayTransaction", "hash", hash, "types", traceTypes)
+	return api.backend.ReplayTransaction(hash, traceTypes)
+}
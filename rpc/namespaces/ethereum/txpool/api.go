@@ -16,51 +16,127 @@
 package txpool
 
 import (
+	"fmt"
+	"strconv"
+
 	"github.com/cometbft/cometbft/libs/log"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
+	"github.com/evmos/ethermint/rpc/backend"
 	"github.com/evmos/ethermint/rpc/types"
 )
 
 // PublicAPI offers and API for the transaction pool. It only operates on data that is non-confidential.
-// NOTE: For more info about the current status of this endpoints see https://github.com/evmos/ethermint/issues/124
+// The content is read from the node mempool, the transactions are pending if they are executable against
+// the current account nonce, and queued if there's a nonce gap before them.
 type PublicAPI struct {
-	logger log.Logger
+	logger  log.Logger
+	backend backend.EVMBackend
 }
 
 // NewPublicAPI creates a new tx pool service that gives information about the transaction pool.
-func NewPublicAPI(logger log.Logger) *PublicAPI {
+func NewPublicAPI(logger log.Logger, backend backend.EVMBackend) *PublicAPI {
 	return &PublicAPI{
-		logger: logger.With("module", "txpool"),
+		logger:  logger.With("module", "txpool"),
+		backend: backend,
 	}
 }
 
 // Content returns the transactions contained within the transaction pool
 func (api *PublicAPI) Content() (map[string]map[string]map[string]*types.RPCTransaction, error) {
 	api.logger.Debug("txpool_content")
+	pending, queued, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
 	content := map[string]map[string]map[string]*types.RPCTransaction{
-		"pending": make(map[string]map[string]*types.RPCTransaction),
-		"queued":  make(map[string]map[string]*types.RPCTransaction),
+		"pending": make(map[string]map[string]*types.RPCTransaction, len(pending)),
+		"queued":  make(map[string]map[string]*types.RPCTransaction, len(queued)),
+	}
+	for sender, txs := range pending {
+		content["pending"][sender.Hex()] = formatTxs(txs)
+	}
+	for sender, txs := range queued {
+		content["queued"][sender.Hex()] = formatTxs(txs)
 	}
 	return content, nil
 }
 
+// ContentFrom returns the transactions contained within the transaction pool sent by the address
+func (api *PublicAPI) ContentFrom(address common.Address) (map[string]map[string]*types.RPCTransaction, error) {
+	api.logger.Debug("txpool_contentFrom", "address", address.Hex())
+	pending, queued, err := api.backend.TxPoolContentFrom(address)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]map[string]*types.RPCTransaction{
+		"pending": formatTxs(pending),
+		"queued":  formatTxs(queued),
+	}, nil
+}
+
 // Inspect returns the content of the transaction pool and flattens it into an
+// easily inspectable list.
 func (api *PublicAPI) Inspect() (map[string]map[string]map[string]string, error) {
 	api.logger.Debug("txpool_inspect")
+	pending, queued, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
 	content := map[string]map[string]map[string]string{
-		"pending": make(map[string]map[string]string),
-		"queued":  make(map[string]map[string]string),
+		"pending": make(map[string]map[string]string, len(pending)),
+		"queued":  make(map[string]map[string]string, len(queued)),
+	}
+	for sender, txs := range pending {
+		content["pending"][sender.Hex()] = inspectTxs(txs)
+	}
+	for sender, txs := range queued {
+		content["queued"][sender.Hex()] = inspectTxs(txs)
 	}
 	return content, nil
 }
 
 // Status returns the number of pending and queued transaction in the pool.
-func (api *PublicAPI) Status() map[string]hexutil.Uint {
+func (api *PublicAPI) Status() (map[string]hexutil.Uint, error) {
 	api.logger.Debug("txpool_status")
+	pending, queued, err := api.backend.TxPoolStatus()
+	if err != nil {
+		return nil, err
+	}
 	return map[string]hexutil.Uint{
-		"pending": hexutil.Uint(0),
-		"queued":  hexutil.Uint(0),
+		"pending": hexutil.Uint(pending),
+		"queued":  hexutil.Uint(queued),
+	}, nil
+}
+
+// formatTxs indexes the transactions by the decimal representation of the nonce
+func formatTxs(txs map[uint64]*types.RPCTransaction) map[string]*types.RPCTransaction {
+	result := make(map[string]*types.RPCTransaction, len(txs))
+	for nonce, tx := range txs {
+		result[strconv.FormatUint(nonce, 10)] = tx
+	}
+	return result
+}
+
+// inspectTxs summarizes the transactions in the same format as go-ethereum
+func inspectTxs(txs map[uint64]*types.RPCTransaction) map[string]string {
+	result := make(map[string]string, len(txs))
+	for nonce, tx := range txs {
+		gasPrice := tx.GasPrice
+		if tx.GasFeeCap != nil {
+			gasPrice = tx.GasFeeCap
+		}
+		if tx.To != nil {
+			result[strconv.FormatUint(nonce, 10)] = fmt.Sprintf(
+				"%s: %v wei + %v gas × %v wei", tx.To.Hex(), tx.Value.ToInt(), uint64(tx.Gas), gasPrice.ToInt(),
+			)
+		} else {
+			result[strconv.FormatUint(nonce, 10)] = fmt.Sprintf(
+				"contract creation: %v wei + %v gas × %v wei", tx.Value.ToInt(), uint64(tx.Gas), gasPrice.ToInt(),
+			)
+		}
 	}
+	return result
 }
@@ -0,0 +1,316 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package ratelimit
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/lru"
+
+	"github.com/evmos/ethermint/server/config"
+)
+
+const (
+	// APIKeyHeader is the header carrying the API key of the client.
+	APIKeyHeader = "X-API-Key"
+
+	// forwardedHeader marks the requests forwarded by the websocket server, they are already
+	// rate limited with the identity of the websocket client.
+	forwardedHeader = "X-Ethermint-Forwarded"
+
+	// maxClients bounds the number of token buckets kept in memory, the least recently used
+	// ones are dropped first.
+	maxClients = 100_000
+
+	// maxRequestContentLength is the request size limit of the go-ethereum HTTP server.
+	maxRequestContentLength = 1024 * 1024 * 5
+)
+
+// Error is a JSON-RPC error returned when a request is rejected by the limiter.
+type Error struct {
+	Code    int
+	Message string
+	status  int
+}
+
+var (
+	// ErrRateLimited is returned when the client has no tokens left for the request.
+	ErrRateLimited = &Error{Code: -32005, Message: "rate limit exceeded", status: http.StatusTooManyRequests}
+	// ErrResponseTooLarge is returned instead of a response exceeding the max response size.
+	ErrResponseTooLarge = &Error{Code: -32003, Message: "response too large", status: http.StatusOK}
+)
+
+func (e *Error) Error() string { return e.Message }
+
+// ErrorCode implements the go-ethereum rpc.Error interface.
+func (e *Error) ErrorCode() int { return e.Code }
+
+// write sends the error as a JSON-RPC response.
+func (e *Error) write(w http.ResponseWriter) {
+	w.Header().Del("Content-Length")
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(e.status)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      nil,
+		"error":   map[string]interface{}{"code": e.Code, "message": e.Message},
+	})
+}
+
+// Limiter enforces the per client rate limits, the batch size and the response size limits of the
+// JSON-RPC HTTP and WebSocket servers.
+type Limiter struct {
+	limit           config.RateLimit
+	methodLimits    map[string]config.RateLimit
+	apiKeys         map[string]bool
+	maxBatchSize    int
+	maxResponseSize int
+	// token authenticates the requests forwarded by the websocket server
+	token string
+
+	mu      sync.Mutex
+	buckets lru.BasicLRU[string, *bucket]
+	now     func() time.Time
+}
+
+// New creates a Limiter from the JSON-RPC configuration.
+func New(cfg config.JSONRPCConfig) (*Limiter, error) {
+	methodLimits, err := config.ParseMethodRateLimits(cfg.MethodRateLimits)
+	if err != nil {
+		return nil, err
+	}
+	apiKeys := make(map[string]bool)
+	for _, key := range config.SplitList(cfg.RateLimitAPIKeys) {
+		apiKeys[key] = true
+	}
+	token := make([]byte, 16)
+	if _, err := rand.Read(token); err != nil {
+		return nil, err
+	}
+	return &Limiter{
+		limit:           config.RateLimit{Rate: cfg.RateLimit, Burst: cfg.RateLimitBurst},
+		methodLimits:    methodLimits,
+		apiKeys:         apiKeys,
+		maxBatchSize:    cfg.MaxBatchSize,
+		maxResponseSize: cfg.MaxResponseSize,
+		token:           hex.EncodeToString(token),
+		buckets:         lru.NewBasicLRU[string, *bucket](maxClients),
+		now:             time.Now,
+	}, nil
+}
+
+// checksRequests returns true if the requests need to be parsed to apply the limits.
+func (l *Limiter) checksRequests() bool {
+	return l.limit.Rate > 0 || len(l.methodLimits) > 0 || l.maxBatchSize > 0
+}
+
+// ClientKey identifies the client of the request by its API key when it's one of the configured keys,
+// otherwise by its IP.
+func (l *Limiter) ClientKey(r *http.Request) string {
+	if key := r.Header.Get(APIKeyHeader); key != "" && l.apiKeys[key] {
+		return "key:" + key
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	return "ip:" + host
+}
+
+// Check applies the limits to the JSON-RPC request or batch sent by the client, each request of a
+// batch consumes a token from the client budget and from the budget of its method, if any.
+func (l *Limiter) Check(client string, body []byte) error {
+	if !l.checksRequests() {
+		return nil
+	}
+	methods, batch := parseMethods(body)
+	if batch && l.maxBatchSize > 0 && len(methods) > l.maxBatchSize {
+		return &Error{
+			Code:    -32600,
+			Message: fmt.Sprintf("batch too large, max %d requests", l.maxBatchSize),
+			status:  http.StatusOK,
+		}
+	}
+
+	now := l.now()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, method := range methods {
+		if l.limit.Rate > 0 && !l.bucket(client, l.limit).take(now) {
+			return ErrRateLimited
+		}
+		if pattern, limit, ok := l.methodLimit(method); ok && !l.bucket(client+"|"+pattern, limit).take(now) {
+			return ErrRateLimited
+		}
+	}
+	return nil
+}
+
+// methodLimit returns the limit of the method, an exact match has precedence over the longest
+// matching wildcard.
+func (l *Limiter) methodLimit(method string) (string, config.RateLimit, bool) {
+	if limit, ok := l.methodLimits[method]; ok {
+		return method, limit, true
+	}
+	var (
+		match string
+		limit config.RateLimit
+	)
+	for pattern, patternLimit := range l.methodLimits {
+		if !strings.HasSuffix(pattern, "*") {
+			continue
+		}
+		if strings.HasPrefix(method, strings.TrimSuffix(pattern, "*")) && len(pattern) > len(match) {
+			match, limit = pattern, patternLimit
+		}
+	}
+	return match, limit, match != ""
+}
+
+// bucket returns the token bucket of the key, creating a full one if needed.
+// It must be called with the mutex held.
+func (l *Limiter) bucket(key string, limit config.RateLimit) *bucket {
+	b, ok := l.buckets.Get(key)
+	if !ok {
+		b = &bucket{limit: limit, tokens: limit.Capacity(), last: l.now()}
+		l.buckets.Add(key, b)
+	}
+	return b
+}
+
+// MarkForwarded marks a request forwarded to the HTTP server by the websocket server, the HTTP server
+// doesn't consume the tokens of the request again.
+func (l *Limiter) MarkForwarded(req *http.Request) {
+	req.Header.Set(forwardedHeader, l.token)
+}
+
+// Middleware applies the limits to the requests served by the handler.
+func (l *Limiter) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if l.checksRequests() && r.Header.Get(forwardedHeader) != l.token {
+			body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestContentLength+1))
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			if err := l.Check(l.ClientKey(r), body); err != nil {
+				if rpcErr, ok := err.(*Error); ok {
+					rpcErr.write(w)
+				} else {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+				}
+				return
+			}
+		}
+
+		if l.maxResponseSize == 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		// buffer the response, so it can be replaced by an error when it's too large
+		rw := &limitedResponseWriter{ResponseWriter: w, limit: l.maxResponseSize}
+		next.ServeHTTP(rw, r)
+		if rw.exceeded {
+			ErrResponseTooLarge.write(w)
+			return
+		}
+		if rw.status != 0 {
+			w.WriteHeader(rw.status)
+		}
+		_, _ = w.Write(rw.buf.Bytes())
+	})
+}
+
+// parseMethods returns the methods of the request or batch, the methods of invalid requests are
+// left empty so they still consume the client tokens.
+func parseMethods(body []byte) ([]string, bool) {
+	type request struct {
+		Method string `json:"method"`
+	}
+
+	body = bytes.TrimLeft(body, " \t\r\n")
+	if len(body) > 0 && body[0] == '[' {
+		var reqs []request
+		if err := json.Unmarshal(body, &reqs); err != nil {
+			return []string{""}, true
+		}
+		methods := make([]string, len(reqs))
+		for i, req := range reqs {
+			methods[i] = req.Method
+		}
+		return methods, true
+	}
+
+	var req request
+	_ = json.Unmarshal(body, &req)
+	return []string{req.Method}, false
+}
+
+// bucket is a token bucket refilled continuously at the rate of its limit.
+type bucket struct {
+	limit  config.RateLimit
+	tokens float64
+	last   time.Time
+}
+
+// take consumes a token if there is one left.
+func (b *bucket) take(now time.Time) bool {
+	b.tokens = math.Min(b.limit.Capacity(), b.tokens+now.Sub(b.last).Seconds()*b.limit.Rate)
+	b.last = now
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// limitedResponseWriter buffers the response and discards it once it exceeds the limit.
+type limitedResponseWriter struct {
+	http.ResponseWriter
+	buf      bytes.Buffer
+	status   int
+	limit    int
+	exceeded bool
+}
+
+func (w *limitedResponseWriter) WriteHeader(status int) {
+	w.status = status
+}
+
+func (w *limitedResponseWriter) Write(b []byte) (int, error) {
+	if !w.exceeded && w.buf.Len()+len(b) > w.limit {
+		w.exceeded = true
+		w.buf.Reset()
+	}
+	if w.exceeded {
+		return len(b), nil
+	}
+	return w.buf.Write(b)
+}
@@ -0,0 +1,148 @@
+package ratelimit
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/server/config"
+)
+
+func newTestLimiter(t *testing.T, cfg config.JSONRPCConfig) (*Limiter, *time.Time) {
+	l, err := New(cfg)
+	require.NoError(t, err)
+	now := time.Unix(1700000000, 0)
+	l.now = func() time.Time { return now }
+	return l, &now
+}
+
+func request(method string) []byte {
+	return []byte(`{"jsonrpc":"2.0","id":1,"method":"` + method + `","params":[]}`)
+}
+
+func TestCheck(t *testing.T) {
+	t.Run("client rate limit", func(t *testing.T) {
+		l, now := newTestLimiter(t, config.JSONRPCConfig{RateLimit: 1, RateLimitBurst: 2})
+		require.NoError(t, l.Check("ip:1", request("eth_blockNumber")))
+		require.NoError(t, l.Check("ip:1", request("eth_blockNumber")))
+		require.Equal(t, ErrRateLimited, l.Check("ip:1", request("eth_blockNumber")))
+		// other clients have their own budget
+		require.NoError(t, l.Check("ip:2", request("eth_blockNumber")))
+
+		*now = now.Add(time.Second)
+		require.NoError(t, l.Check("ip:1", request("eth_blockNumber")))
+		require.Equal(t, ErrRateLimited, l.Check("ip:1", request("eth_blockNumber")))
+	})
+
+	t.Run("method rate limits", func(t *testing.T) {
+		l, _ := newTestLimiter(t, config.JSONRPCConfig{
+			MethodRateLimits: []string{"eth_getLogs=1", "debug_*=1,debug_traceCall=2"},
+		})
+		require.NoError(t, l.Check("ip:1", request("eth_getLogs")))
+		require.Equal(t, ErrRateLimited, l.Check("ip:1", request("eth_getLogs")))
+		require.NoError(t, l.Check("ip:1", request("eth_blockNumber")))
+		require.NoError(t, l.Check("ip:1", request("eth_blockNumber")))
+
+		// the wildcard budget is shared by the methods it matches
+		require.NoError(t, l.Check("ip:1", request("debug_traceTransaction")))
+		require.Equal(t, ErrRateLimited, l.Check("ip:1", request("debug_traceBlockByNumber")))
+		// an exact match has its own budget
+		require.NoError(t, l.Check("ip:1", request("debug_traceCall")))
+		require.NoError(t, l.Check("ip:1", request("debug_traceCall")))
+		require.Equal(t, ErrRateLimited, l.Check("ip:1", request("debug_traceCall")))
+	})
+
+	t.Run("batch", func(t *testing.T) {
+		l, _ := newTestLimiter(t, config.JSONRPCConfig{RateLimit: 1, RateLimitBurst: 3, MaxBatchSize: 3})
+		batch := func(n int) []byte {
+			reqs := make([]string, n)
+			for i := range reqs {
+				reqs[i] = string(request("eth_chainId"))
+			}
+			return []byte("[" + strings.Join(reqs, ",") + "]")
+		}
+
+		err := l.Check("ip:1", batch(4))
+		require.Error(t, err)
+		require.Equal(t, -32600, err.(*Error).ErrorCode())
+
+		// each request of the batch consumes a token
+		require.NoError(t, l.Check("ip:1", batch(3)))
+		require.Equal(t, ErrRateLimited, l.Check("ip:1", request("eth_chainId")))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		l, _ := newTestLimiter(t, config.JSONRPCConfig{})
+		for i := 0; i < 100; i++ {
+			require.NoError(t, l.Check("ip:1", request("eth_getLogs")))
+		}
+	})
+}
+
+func TestClientKey(t *testing.T) {
+	l, _ := newTestLimiter(t, config.JSONRPCConfig{RateLimitAPIKeys: []string{"secret"}})
+
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	req.RemoteAddr = "10.0.0.1:1234"
+	require.Equal(t, "ip:10.0.0.1", l.ClientKey(req))
+
+	// unknown keys are limited by IP
+	req.Header.Set(APIKeyHeader, "unknown")
+	require.Equal(t, "ip:10.0.0.1", l.ClientKey(req))
+
+	req.Header.Set(APIKeyHeader, "secret")
+	require.Equal(t, "key:secret", l.ClientKey(req))
+}
+
+func TestMiddleware(t *testing.T) {
+	result := `{"jsonrpc":"2.0","id":1,"result":"` + strings.Repeat("f", 100) + `"}`
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(result))
+	})
+
+	serve := func(l *Limiter, forwarded bool) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(string(request("eth_call"))))
+		if forwarded {
+			l.MarkForwarded(req)
+		}
+		rec := httptest.NewRecorder()
+		l.Middleware(handler).ServeHTTP(rec, req)
+		return rec
+	}
+	errorCode := func(rec *httptest.ResponseRecorder) int {
+		var res struct {
+			Error struct {
+				Code int `json:"code"`
+			} `json:"error"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		return res.Error.Code
+	}
+
+	l, _ := newTestLimiter(t, config.JSONRPCConfig{MethodRateLimits: []string{"eth_call=1"}})
+	rec := serve(l, false)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, result, rec.Body.String())
+
+	rec = serve(l, false)
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	require.Equal(t, ErrRateLimited.Code, errorCode(rec))
+
+	// the requests forwarded by the websocket server are already limited
+	rec = serve(l, true)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	l, _ = newTestLimiter(t, config.JSONRPCConfig{MaxResponseSize: len(result)})
+	rec = serve(l, false)
+	require.Equal(t, result, rec.Body.String())
+
+	l, _ = newTestLimiter(t, config.JSONRPCConfig{MaxResponseSize: len(result) - 1})
+	rec = serve(l, false)
+	require.Equal(t, ErrResponseTooLarge.Code, errorCode(rec))
+}
@@ -52,7 +52,7 @@ type RPCStream struct {
 
 	headerStream    *Stream[RPCHeader]
 	txStream        *Stream[common.Hash]
-	pendingTxStream *Stream[common.Hash]
+	pendingTxStream *Stream[*evmtypes.MsgEthereumTx]
 	logStream       *Stream[*ethtypes.Log]
 
 	wg sync.WaitGroup
@@ -70,7 +70,7 @@ func NewRPCStreams(
 
 		headerStream:    NewStream[RPCHeader](headerStreamSegmentSize, headerStreamCapacity),
 		txStream:        NewStream[common.Hash](txStreamSegmentSize, txStreamCapacity),
-		pendingTxStream: NewStream[common.Hash](txStreamSegmentSize, txStreamCapacity),
+		pendingTxStream: NewStream[*evmtypes.MsgEthereumTx](txStreamSegmentSize, txStreamCapacity),
 		logStream:       NewStream[*ethtypes.Log](logStreamSegmentSize, logStreamCapacity),
 	}
 
@@ -106,7 +106,7 @@ func (s *RPCStream) HeaderStream() *Stream[RPCHeader] {
 	return s.headerStream
 }
 
-func (s *RPCStream) PendingTxStream() *Stream[common.Hash] {
+func (s *RPCStream) PendingTxStream() *Stream[*evmtypes.MsgEthereumTx] {
 	return s.pendingTxStream
 }
 
@@ -115,8 +115,8 @@ func (s *RPCStream) LogStream() *Stream[*ethtypes.Log] {
 }
 
 // ListenPendingTx is a callback passed to application to listen for pending transactions in CheckTx.
-func (s *RPCStream) ListenPendingTx(hash common.Hash) {
-	s.pendingTxStream.Add(hash)
+func (s *RPCStream) ListenPendingTx(tx *evmtypes.MsgEthereumTx) {
+	s.pendingTxStream.Add(tx)
 }
 
 func (s *RPCStream) start(
@@ -0,0 +1,150 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// BundleTx is a transaction of a bundle, either a signed raw transaction or the arguments of an
+// unsigned call.
+type BundleTx struct {
+	Raw  hexutil.Bytes
+	Args *evmtypes.TransactionArgs
+}
+
+// UnmarshalJSON decodes a hex string as a signed raw transaction and an object as call arguments.
+func (tx *BundleTx) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		return json.Unmarshal(data, &tx.Raw)
+	}
+	tx.Args = new(evmtypes.TransactionArgs)
+	return json.Unmarshal(data, tx.Args)
+}
+
+// ToArgs returns the call arguments of the transaction, along with the hash of the signed transactions.
+func (tx BundleTx) ToArgs(chainID *big.Int) (evmtypes.TransactionArgs, *common.Hash, error) {
+	if tx.Args != nil {
+		return *tx.Args, nil, nil
+	}
+
+	ethTx := new(ethtypes.Transaction)
+	if err := ethTx.UnmarshalBinary(tx.Raw); err != nil {
+		return evmtypes.TransactionArgs{}, nil, err
+	}
+	from, err := ethtypes.LatestSignerForChainID(chainID).Sender(ethTx)
+	if err != nil {
+		return evmtypes.TransactionArgs{}, nil, err
+	}
+
+	var (
+		gas        = hexutil.Uint64(ethTx.Gas())
+		nonce      = hexutil.Uint64(ethTx.Nonce())
+		input      = hexutil.Bytes(ethTx.Data())
+		accessList = ethTx.AccessList()
+		hash       = ethTx.Hash()
+	)
+	args := evmtypes.TransactionArgs{
+		From:       &from,
+		To:         ethTx.To(),
+		Gas:        &gas,
+		Value:      (*hexutil.Big)(ethTx.Value()),
+		Nonce:      &nonce,
+		Input:      &input,
+		AccessList: &accessList,
+		ChainID:    (*hexutil.Big)(ethTx.ChainId()),
+	}
+	if ethTx.Type() == ethtypes.DynamicFeeTxType {
+		args.MaxFeePerGas = (*hexutil.Big)(ethTx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(ethTx.GasTipCap())
+	} else {
+		args.GasPrice = (*hexutil.Big)(ethTx.GasPrice())
+	}
+	return args, &hash, nil
+}
+
+// CallBundleArgs represents the arguments of eth_callBundle, the state block defaults to the latest block.
+type CallBundleArgs struct {
+	Txs              []BundleTx         `json:"txs"`
+	StateBlockNumber *BlockNumberOrHash `json:"stateBlockNumber"`
+	StateOverrides   *json.RawMessage   `json:"stateOverrides"`
+}
+
+// CallBundleTxResult is the result of a transaction of a bundle, the transaction hash is only set for
+// signed transactions.
+type CallBundleTxResult struct {
+	TxHash      *common.Hash    `json:"txHash,omitempty"`
+	FromAddress common.Address  `json:"fromAddress"`
+	ToAddress   *common.Address `json:"toAddress"`
+	Value       *hexutil.Big    `json:"value"`
+	GasUsed     hexutil.Uint64  `json:"gasUsed"`
+	ReturnData  hexutil.Bytes   `json:"returnData"`
+	Logs        []*ethtypes.Log `json:"logs"`
+	Error       string          `json:"error,omitempty"`
+	Revert      string          `json:"revert,omitempty"`
+}
+
+// CallBundleResult is the result of eth_callBundle
+type CallBundleResult struct {
+	Results          []CallBundleTxResult `json:"results"`
+	StateBlockNumber hexutil.Uint64       `json:"stateBlockNumber"`
+	TotalGasUsed     hexutil.Uint64       `json:"totalGasUsed"`
+}
+
+// SimulateArgs represents the options of eth_simulateV1, the calls of a block are applied in order on
+// top of the state of the requested block.
+type SimulateArgs struct {
+	BlockStateCalls []SimulateBlock `json:"blockStateCalls"`
+}
+
+// SimulateBlock is a block of calls of eth_simulateV1
+type SimulateBlock struct {
+	StateOverrides *json.RawMessage           `json:"stateOverrides"`
+	Calls          []evmtypes.TransactionArgs `json:"calls"`
+}
+
+// SimulateCallError is the error of a failed call of eth_simulateV1
+type SimulateCallError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data,omitempty"`
+}
+
+// SimulateCallResult is the result of a call of eth_simulateV1
+type SimulateCallResult struct {
+	ReturnData hexutil.Bytes      `json:"returnData"`
+	Logs       []*ethtypes.Log    `json:"logs"`
+	GasUsed    hexutil.Uint64     `json:"gasUsed"`
+	Status     hexutil.Uint64     `json:"status"`
+	Error      *SimulateCallError `json:"error,omitempty"`
+}
+
+// SimulateBlockResult is the result of a block of calls of eth_simulateV1, the header fields are the ones
+// of the block the calls are simulated on.
+type SimulateBlockResult struct {
+	Number    hexutil.Uint64       `json:"number"`
+	Hash      common.Hash          `json:"hash"`
+	Timestamp hexutil.Uint64       `json:"timestamp"`
+	GasUsed   hexutil.Uint64       `json:"gasUsed"`
+	Calls     []SimulateCallResult `json:"calls"`
+}
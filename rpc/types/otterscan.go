@@ -0,0 +1,150 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// OtsAPILevel is the level of the Otterscan API implemented by the ots namespace
+const OtsAPILevel = 8
+
+// Types of the internal operations returned by ots_getInternalOperations
+const (
+	OpTransfer     = 0
+	OpSelfDestruct = 1
+	OpCreate       = 2
+	OpCreate2      = 3
+)
+
+// InternalOperation is an operation of a contract which transfers value, creates or destroys a contract
+type InternalOperation struct {
+	Type  int            `json:"type"`
+	From  common.Address `json:"from"`
+	To    common.Address `json:"to"`
+	Value *hexutil.Big   `json:"value"`
+}
+
+// OtsTraceEntry is a call frame returned by ots_traceTransaction
+type OtsTraceEntry struct {
+	Type   string         `json:"type"`
+	Depth  int            `json:"depth"`
+	From   common.Address `json:"from"`
+	To     common.Address `json:"to"`
+	Value  *hexutil.Big   `json:"value"`
+	Input  hexutil.Bytes  `json:"input"`
+	Output hexutil.Bytes  `json:"output"`
+}
+
+// TransactionsWithReceipts is a page of the transactions of an address, ordered from the newest to the oldest
+type TransactionsWithReceipts struct {
+	Txs       []*RPCTransaction        `json:"txs"`
+	Receipts  []map[string]interface{} `json:"receipts"`
+	FirstPage bool                     `json:"firstPage"`
+	LastPage  bool                     `json:"lastPage"`
+}
+
+// ContractCreator is the result of ots_getContractCreator
+type ContractCreator struct {
+	Hash    common.Hash    `json:"hash"`
+	Creator common.Address `json:"creator"`
+}
+
+// BlockIssuance is the issuance of a block, always zero as the rewards aren't minted by the EVM
+type BlockIssuance struct {
+	BlockReward *hexutil.Big `json:"blockReward"`
+	UncleReward *hexutil.Big `json:"uncleReward"`
+	Issuance    *hexutil.Big `json:"issuance"`
+}
+
+// BlockDetails is the result of ots_getBlockDetails
+type BlockDetails struct {
+	Block     map[string]interface{} `json:"block"`
+	Issuance  BlockIssuance          `json:"issuance"`
+	TotalFees *hexutil.Big           `json:"totalFees"`
+}
+
+// InternalOperations returns the value transfers, contract creations and self destructs of the nested
+// call frames, the top level call is the transaction itself so it's not an internal operation.
+func InternalOperations(frame *CallFrame) []*InternalOperation {
+	ops := []*InternalOperation{}
+	for i := range frame.Calls {
+		ops = appendInternalOperations(ops, &frame.Calls[i])
+	}
+	return ops
+}
+
+func appendInternalOperations(ops []*InternalOperation, frame *CallFrame) []*InternalOperation {
+	if frame.Error != "" {
+		// the state changes of the failed frames are reverted
+		return ops
+	}
+
+	op := &InternalOperation{From: frame.From, Value: frame.Value}
+	if frame.To != nil {
+		op.To = *frame.To
+	}
+	if op.Value == nil {
+		op.Value = new(hexutil.Big)
+	}
+	switch vm.StringToOp(frame.Type) {
+	case vm.CALL:
+		if op.Value.ToInt().Sign() > 0 {
+			op.Type = OpTransfer
+			ops = append(ops, op)
+		}
+	case vm.SELFDESTRUCT:
+		op.Type = OpSelfDestruct
+		ops = append(ops, op)
+	case vm.CREATE:
+		op.Type = OpCreate
+		ops = append(ops, op)
+	case vm.CREATE2:
+		op.Type = OpCreate2
+		ops = append(ops, op)
+	}
+
+	for i := range frame.Calls {
+		ops = appendInternalOperations(ops, &frame.Calls[i])
+	}
+	return ops
+}
+
+// OtsTraceEntries flattens the call frames depth first into the entries of ots_traceTransaction
+func OtsTraceEntries(frame *CallFrame) []*OtsTraceEntry {
+	return appendOtsTraceEntries(nil, frame, 0)
+}
+
+func appendOtsTraceEntries(entries []*OtsTraceEntry, frame *CallFrame, depth int) []*OtsTraceEntry {
+	entry := &OtsTraceEntry{
+		Type:   frame.Type,
+		Depth:  depth,
+		From:   frame.From,
+		Value:  frame.Value,
+		Input:  frame.Input,
+		Output: frame.Output,
+	}
+	if frame.To != nil {
+		entry.To = *frame.To
+	}
+	entries = append(entries, entry)
+	for i := range frame.Calls {
+		entries = appendOtsTraceEntries(entries, &frame.Calls[i], depth+1)
+	}
+	return entries
+}
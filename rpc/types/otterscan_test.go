@@ -0,0 +1,47 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInternalOperations(t *testing.T) {
+	eoa := common.HexToAddress("0x1")
+	contract := common.HexToAddress("0x2")
+	created := common.HexToAddress("0x3")
+	value := (*hexutil.Big)(big.NewInt(100))
+
+	frame := &CallFrame{
+		Type: "CALL", From: eoa, To: &contract, Value: value,
+		Calls: []CallFrame{
+			{Type: "CALL", From: contract, To: &eoa, Value: value},
+			{Type: "CALL", From: contract, To: &eoa, Value: new(hexutil.Big)},
+			{Type: "STATICCALL", From: contract, To: &eoa},
+			{
+				Type: "CREATE2", From: contract, To: &created, Value: new(hexutil.Big),
+				Calls: []CallFrame{{Type: "SELFDESTRUCT", From: created, To: &eoa, Value: value}},
+			},
+			{Type: "CALL", From: contract, To: &eoa, Value: value, Error: "execution reverted"},
+		},
+	}
+
+	ops := InternalOperations(frame)
+	require.Equal(t, []*InternalOperation{
+		{Type: OpTransfer, From: contract, To: eoa, Value: value},
+		{Type: OpCreate2, From: contract, To: created, Value: new(hexutil.Big)},
+		{Type: OpSelfDestruct, From: created, To: eoa, Value: value},
+	}, ops)
+
+	entries := OtsTraceEntries(frame)
+	require.Len(t, entries, 7)
+	require.Equal(t, 0, entries[0].Depth)
+	require.Equal(t, "CREATE2", entries[4].Type)
+	require.Equal(t, 1, entries[4].Depth)
+	require.Equal(t, "SELFDESTRUCT", entries[5].Type)
+	require.Equal(t, 2, entries[5].Depth)
+	require.Equal(t, created, entries[5].From)
+}
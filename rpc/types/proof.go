@@ -0,0 +1,166 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	tmcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	ethermint "github.com/evmos/ethermint/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// ProofOp is a hex encoded merkle proof operation, see tendermint crypto.ProofOp
+type ProofOp struct {
+	Type string        `json:"type"`
+	Key  hexutil.Bytes `json:"key"`
+	Data hexutil.Bytes `json:"data"`
+}
+
+// StoreProof proves the value of a key in a module store against the app hash, it's made of the proof of
+// the key in the IAVL tree of the store followed by the proof of the store root in the multistore.
+// An empty value is proven by an absence proof.
+type StoreProof struct {
+	Value hexutil.Bytes `json:"value"`
+	Ops   []ProofOp     `json:"ops"`
+}
+
+// NewStoreProof creates a StoreProof from the result of an ABCI store query
+func NewStoreProof(value []byte, proof *tmcrypto.ProofOps) StoreProof {
+	storeProof := StoreProof{Value: value, Ops: []ProofOp{}}
+	if proof == nil {
+		return storeProof
+	}
+	for _, op := range proof.Ops {
+		storeProof.Ops = append(storeProof.Ops, ProofOp{Type: op.Type, Key: op.Key, Data: op.Data})
+	}
+	return storeProof
+}
+
+// Verify checks the proof of the given key in the given store against the app hash
+func (p StoreProof) Verify(appHash []byte, storeKey string, key []byte) error {
+	ops := &tmcrypto.ProofOps{Ops: make([]tmcrypto.ProofOp, len(p.Ops))}
+	for i, op := range p.Ops {
+		ops.Ops[i] = tmcrypto.ProofOp{Type: op.Type, Key: op.Key, Data: op.Data}
+	}
+
+	keyPath := merkle.KeyPath{}.
+		AppendKey([]byte(storeKey), merkle.KeyEncodingURL).
+		AppendKey(key, merkle.KeyEncodingHex).
+		String()
+
+	prt := rootmulti.DefaultProofRuntime()
+	if len(p.Value) == 0 {
+		return prt.VerifyAbsence(ops, appHash, keyPath)
+	}
+	return prt.VerifyValue(ops, appHash, keyPath, p.Value)
+}
+
+// VerifiableStorageResult is a storage slot of an account with its proof
+type VerifiableStorageResult struct {
+	Key   common.Hash `json:"key"`
+	Value common.Hash `json:"value"`
+	Proof StoreProof  `json:"proof"`
+}
+
+// VerifiableAccountResult is an EVM account with the proofs of its balance, nonce, code hash and storage
+// slots. The proofs are made against the app hash committing to the state at Height, which is part of
+// the header of the next block.
+type VerifiableAccountResult struct {
+	Address      common.Address            `json:"address"`
+	Height       hexutil.Uint64            `json:"height"`
+	AppHash      hexutil.Bytes             `json:"appHash"`
+	Balance      *hexutil.Big              `json:"balance"`
+	Denom        string                    `json:"denom"`
+	CodeHash     common.Hash               `json:"codeHash"`
+	Nonce        hexutil.Uint64            `json:"nonce"`
+	AccountProof StoreProof                `json:"accountProof"`
+	BalanceProof StoreProof                `json:"balanceProof"`
+	StorageProof []VerifiableStorageResult `json:"storageProof"`
+}
+
+// VerifyAccountProof checks the balance, nonce, code hash and storage slots of the account against the
+// given trusted app hash and evm denom, the app hash returned along the proofs is ignored. The codec is
+// used to decode the account from the auth store.
+func VerifyAccountProof(cdc codec.Codec, appHash []byte, denom string, res *VerifiableAccountResult) error {
+	if res == nil {
+		return errors.New("empty account result")
+	}
+	// the balance of any other denom could be proven, absent balances included
+	if res.Denom != denom {
+		return fmt.Errorf("denom mismatch: expected %s, got %s", denom, res.Denom)
+	}
+
+	// nonce and code hash are part of the auth account
+	addr := sdk.AccAddress(res.Address.Bytes())
+	if err := res.AccountProof.Verify(appHash, authtypes.StoreKey, authtypes.AddressStoreKey(addr)); err != nil {
+		return fmt.Errorf("invalid account proof: %w", err)
+	}
+	nonce, codeHash := uint64(0), common.BytesToHash(evmtypes.EmptyCodeHash)
+	if len(res.AccountProof.Value) > 0 {
+		var acc authtypes.AccountI
+		if err := cdc.UnmarshalInterface(res.AccountProof.Value, &acc); err != nil {
+			return fmt.Errorf("invalid account: %w", err)
+		}
+		nonce = acc.GetSequence()
+		if ethAcc, ok := acc.(ethermint.EthAccountI); ok {
+			codeHash = ethAcc.GetCodeHash()
+		}
+	}
+	if nonce != uint64(res.Nonce) {
+		return fmt.Errorf("nonce mismatch: proven %d, got %d", nonce, res.Nonce)
+	}
+	if codeHash != res.CodeHash {
+		return fmt.Errorf("code hash mismatch: proven %s, got %s", codeHash, res.CodeHash)
+	}
+
+	// the balance is stored by the bank module, zero balances are not persisted
+	balanceKey := append(banktypes.CreateAccountBalancesPrefix(addr), []byte(denom)...)
+	if err := res.BalanceProof.Verify(appHash, banktypes.StoreKey, balanceKey); err != nil {
+		return fmt.Errorf("invalid balance proof: %w", err)
+	}
+	balance := sdkmath.ZeroInt()
+	if len(res.BalanceProof.Value) > 0 {
+		if err := balance.Unmarshal(res.BalanceProof.Value); err != nil {
+			return fmt.Errorf("invalid balance: %w", err)
+		}
+	}
+	if res.Balance == nil || balance.BigInt().Cmp(res.Balance.ToInt()) != 0 {
+		return fmt.Errorf("balance mismatch: proven %s, got %s", balance, res.Balance)
+	}
+
+	// the storage slots are stored by the evm module, empty slots are not persisted
+	for _, slot := range res.StorageProof {
+		if err := slot.Proof.Verify(appHash, evmtypes.StoreKey, evmtypes.StateKey(res.Address, slot.Key.Bytes())); err != nil {
+			return fmt.Errorf("invalid storage proof of slot %s: %w", slot.Key, err)
+		}
+		if value := common.BytesToHash(slot.Proof.Value); value != slot.Value {
+			return fmt.Errorf("storage mismatch of slot %s: proven %s, got %s", slot.Key, value, slot.Value)
+		}
+	}
+	return nil
+}
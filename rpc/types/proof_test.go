@@ -0,0 +1,183 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	ethermint "github.com/evmos/ethermint/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+func TestVerifyAccountProof(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	authtypes.RegisterInterfaces(registry)
+	ethermint.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	address := common.BytesToAddress([]byte("account"))
+	accAddr := sdk.AccAddress(address.Bytes())
+	codeHash := common.BytesToHash([]byte("code hash"))
+	slot, emptySlot := common.BytesToHash([]byte("slot")), common.BytesToHash([]byte("empty slot"))
+	value := common.BytesToHash([]byte("value"))
+
+	// commit the account, its balance and a storage slot to a multistore
+	store := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger())
+	storeKeys := map[string]*storetypes.KVStoreKey{}
+	for _, name := range []string{authtypes.StoreKey, banktypes.StoreKey, evmtypes.StoreKey} {
+		storeKeys[name] = storetypes.NewKVStoreKey(name)
+		store.MountStoreWithDB(storeKeys[name], storetypes.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, store.LoadLatestVersion())
+
+	acc := &ethermint.EthAccount{
+		BaseAccount: authtypes.NewBaseAccount(accAddr, nil, 1, 7),
+		CodeHash:    codeHash.Hex(),
+	}
+	accountBz, err := cdc.MarshalInterface(authtypes.AccountI(acc))
+	require.NoError(t, err)
+	store.GetKVStore(storeKeys[authtypes.StoreKey]).Set(authtypes.AddressStoreKey(accAddr), accountBz)
+
+	balanceBz, err := sdkmath.NewInt(100).Marshal()
+	require.NoError(t, err)
+	balanceKey := append(banktypes.CreateAccountBalancesPrefix(accAddr), []byte("aphoton")...)
+	store.GetKVStore(storeKeys[banktypes.StoreKey]).Set(balanceKey, balanceBz)
+
+	store.GetKVStore(storeKeys[evmtypes.StoreKey]).Set(evmtypes.StateKey(address, slot.Bytes()), value.Bytes())
+	appHash := store.Commit().Hash
+
+	prove := func(storeKey string, key []byte) StoreProof {
+		res := store.Query(abci.RequestQuery{Path: "/" + storeKey + "/key", Data: key, Prove: true})
+		require.Equal(t, uint32(0), res.Code, res.Log)
+		return NewStoreProof(res.Value, res.ProofOps)
+	}
+
+	var res *VerifiableAccountResult
+	testCases := []struct {
+		name     string
+		malleate func()
+		appHash  []byte
+		expPass  bool
+	}{
+		{
+			"pass",
+			func() {},
+			appHash,
+			true,
+		},
+		{
+			"fail - wrong app hash",
+			func() {},
+			common.BytesToHash([]byte("app hash")).Bytes(),
+			false,
+		},
+		{
+			"fail - wrong nonce",
+			func() { res.Nonce = 8 },
+			appHash,
+			false,
+		},
+		{
+			"fail - wrong code hash",
+			func() { res.CodeHash = common.BytesToHash(evmtypes.EmptyCodeHash) },
+			appHash,
+			false,
+		},
+		{
+			"fail - wrong balance",
+			func() { res.Balance = (*hexutil.Big)(big.NewInt(101)) },
+			appHash,
+			false,
+		},
+		{
+			"fail - tampered balance proof value",
+			func() {
+				bz, err := sdkmath.NewInt(101).Marshal()
+				require.NoError(t, err)
+				res.Balance = (*hexutil.Big)(big.NewInt(101))
+				res.BalanceProof.Value = bz
+			},
+			appHash,
+			false,
+		},
+		{
+			"fail - absence proof of another denom",
+			func() {
+				otherKey := append(banktypes.CreateAccountBalancesPrefix(accAddr), []byte("other")...)
+				res.Denom = "other"
+				res.Balance = (*hexutil.Big)(big.NewInt(0))
+				res.BalanceProof = prove(banktypes.StoreKey, otherKey)
+			},
+			appHash,
+			false,
+		},
+		{
+			"fail - wrong storage value",
+			func() { res.StorageProof[0].Value = common.Hash{} },
+			appHash,
+			false,
+		},
+		{
+			"fail - absence proof of an existing slot",
+			func() {
+				res.StorageProof[0].Value = common.Hash{}
+				res.StorageProof[0].Proof.Value = nil
+			},
+			appHash,
+			false,
+		},
+		{
+			"fail - proof of another slot",
+			func() { res.StorageProof[1].Key = slot },
+			appHash,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res = &VerifiableAccountResult{
+				Address:      address,
+				Balance:      (*hexutil.Big)(big.NewInt(100)),
+				Denom:        "aphoton",
+				CodeHash:     codeHash,
+				Nonce:        7,
+				AccountProof: prove(authtypes.StoreKey, authtypes.AddressStoreKey(accAddr)),
+				BalanceProof: prove(banktypes.StoreKey, balanceKey),
+				StorageProof: []VerifiableStorageResult{
+					{
+						Key:   slot,
+						Value: value,
+						Proof: prove(evmtypes.StoreKey, evmtypes.StateKey(address, slot.Bytes())),
+					},
+					{
+						Key:   emptySlot,
+						Proof: prove(evmtypes.StoreKey, evmtypes.StateKey(address, emptySlot.Bytes())),
+					},
+				},
+			}
+			tc.malleate()
+
+			err := VerifyAccountProof(cdc, tc.appHash, "aphoton", res)
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
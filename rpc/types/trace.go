@@ -0,0 +1,229 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// CallTracer is the name of the native geth tracer used to build the flat traces
+const CallTracer = "callTracer"
+
+// CallFrame is a call frame returned by the native callTracer
+type CallFrame struct {
+	Type    string          `json:"type"`
+	From    common.Address  `json:"from"`
+	To      *common.Address `json:"to,omitempty"`
+	Value   *hexutil.Big    `json:"value,omitempty"`
+	Gas     hexutil.Uint64  `json:"gas"`
+	GasUsed hexutil.Uint64  `json:"gasUsed"`
+	Input   hexutil.Bytes   `json:"input"`
+	Output  hexutil.Bytes   `json:"output,omitempty"`
+	Error   string          `json:"error,omitempty"`
+	Calls   []CallFrame     `json:"calls,omitempty"`
+}
+
+// TraceCallAction is the action of a flat trace of type call
+type TraceCallAction struct {
+	CallType string         `json:"callType"`
+	From     common.Address `json:"from"`
+	To       common.Address `json:"to"`
+	Gas      hexutil.Uint64 `json:"gas"`
+	Input    hexutil.Bytes  `json:"input"`
+	Value    *hexutil.Big   `json:"value"`
+}
+
+// TraceCreateAction is the action of a flat trace of type create
+type TraceCreateAction struct {
+	From  common.Address `json:"from"`
+	Gas   hexutil.Uint64 `json:"gas"`
+	Init  hexutil.Bytes  `json:"init"`
+	Value *hexutil.Big   `json:"value"`
+}
+
+// TraceSuicideAction is the action of a flat trace of type suicide
+type TraceSuicideAction struct {
+	Address       common.Address `json:"address"`
+	RefundAddress common.Address `json:"refundAddress"`
+	Balance       *hexutil.Big   `json:"balance"`
+}
+
+// TraceCallResult is the result of a successful flat trace of type call
+type TraceCallResult struct {
+	GasUsed hexutil.Uint64 `json:"gasUsed"`
+	Output  hexutil.Bytes  `json:"output"`
+}
+
+// TraceCreateResult is the result of a successful flat trace of type create
+type TraceCreateResult struct {
+	Address common.Address `json:"address"`
+	Code    hexutil.Bytes  `json:"code"`
+	GasUsed hexutil.Uint64 `json:"gasUsed"`
+}
+
+// FlatTrace is a call trace in the OpenEthereum (parity) format, the block and transaction
+// fields are left empty by trace_replayTransaction.
+type FlatTrace struct {
+	Action              interface{}     `json:"action"`
+	BlockHash           *common.Hash    `json:"blockHash,omitempty"`
+	BlockNumber         *uint64         `json:"blockNumber,omitempty"`
+	Error               string          `json:"error,omitempty"`
+	Result              interface{}     `json:"result"`
+	Subtraces           int             `json:"subtraces"`
+	TraceAddress        []int           `json:"traceAddress"`
+	TransactionHash     *common.Hash    `json:"transactionHash,omitempty"`
+	TransactionPosition *uint64         `json:"transactionPosition,omitempty"`
+	Type                string          `json:"type"`
+	from, to            *common.Address // addresses matched by trace_filter
+}
+
+// TraceFilterArgs are the arguments of trace_filter, the address filters match any of the given
+// addresses and an empty list matches all of them.
+type TraceFilterArgs struct {
+	FromBlock   *BlockNumber     `json:"fromBlock"`
+	ToBlock     *BlockNumber     `json:"toBlock"`
+	FromAddress []common.Address `json:"fromAddress"`
+	ToAddress   []common.Address `json:"toAddress"`
+	After       *uint64          `json:"after"`
+	Count       *uint64          `json:"count"`
+}
+
+// ReplayTransactionResult is the result of trace_replayTransaction
+type ReplayTransactionResult struct {
+	Output    hexutil.Bytes `json:"output"`
+	StateDiff interface{}   `json:"stateDiff"`
+	Trace     []*FlatTrace  `json:"trace"`
+	VMTrace   interface{}   `json:"vmTrace"`
+}
+
+// FlattenCallFrame converts the nested call frames returned by the callTracer into flat traces,
+// ordered depth first like OpenEthereum does.
+func FlattenCallFrame(frame *CallFrame) []*FlatTrace {
+	return flattenCallFrame(frame, []int{}, nil)
+}
+
+func flattenCallFrame(frame *CallFrame, traceAddress []int, traces []*FlatTrace) []*FlatTrace {
+	trace := &FlatTrace{
+		Subtraces:    len(frame.Calls),
+		TraceAddress: traceAddress,
+		Error:        traceError(frame.Error),
+	}
+
+	value := frame.Value
+	if value == nil {
+		value = new(hexutil.Big)
+	}
+	from := frame.From
+	trace.from = &from
+	trace.to = frame.To
+
+	switch op := vm.StringToOp(frame.Type); op {
+	case vm.CREATE, vm.CREATE2:
+		trace.Type = "create"
+		trace.Action = &TraceCreateAction{
+			From:  frame.From,
+			Gas:   frame.Gas,
+			Init:  frame.Input,
+			Value: value,
+		}
+		if trace.Error == "" && frame.To != nil {
+			trace.Result = &TraceCreateResult{
+				Address: *frame.To,
+				Code:    frame.Output,
+				GasUsed: frame.GasUsed,
+			}
+		}
+	case vm.SELFDESTRUCT:
+		trace.Type = "suicide"
+		action := &TraceSuicideAction{
+			Address: frame.From,
+			Balance: value,
+		}
+		if frame.To != nil {
+			action.RefundAddress = *frame.To
+		}
+		trace.Action = action
+	default:
+		trace.Type = "call"
+		action := &TraceCallAction{
+			CallType: strings.ToLower(frame.Type),
+			From:     frame.From,
+			Gas:      frame.Gas,
+			Input:    frame.Input,
+			Value:    value,
+		}
+		if frame.To != nil {
+			action.To = *frame.To
+		}
+		trace.Action = action
+		if trace.Error == "" {
+			trace.Result = &TraceCallResult{
+				GasUsed: frame.GasUsed,
+				Output:  frame.Output,
+			}
+		}
+	}
+
+	traces = append(traces, trace)
+	for i := range frame.Calls {
+		childAddress := make([]int, len(traceAddress), len(traceAddress)+1)
+		copy(childAddress, traceAddress)
+		traces = flattenCallFrame(&frame.Calls[i], append(childAddress, i), traces)
+	}
+	return traces
+}
+
+// traceError converts the geth errors into the ones returned by OpenEthereum
+func traceError(err string) string {
+	switch err {
+	case "":
+		return ""
+	case vm.ErrExecutionReverted.Error():
+		return "Reverted"
+	case vm.ErrOutOfGas.Error():
+		return "Out of gas"
+	case vm.ErrInvalidJump.Error():
+		return "Bad jump destination"
+	case vm.ErrWriteProtection.Error():
+		return "Mutable Call In Static Context"
+	default:
+		return err
+	}
+}
+
+// Matches returns true if the trace matches the address filters of trace_filter
+func (t *FlatTrace) Matches(fromAddresses, toAddresses []common.Address) bool {
+	return matchAddress(t.from, fromAddresses) && matchAddress(t.to, toAddresses)
+}
+
+func matchAddress(address *common.Address, filter []common.Address) bool {
+	if len(filter) == 0 {
+		return true
+	}
+	if address == nil {
+		return false
+	}
+	for _, a := range filter {
+		if a == *address {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenCallFrame(t *testing.T) {
+	eoa := common.HexToAddress("0x1")
+	contract := common.HexToAddress("0x2")
+	created := common.HexToAddress("0x3")
+	beneficiary := common.HexToAddress("0x4")
+
+	// the json output of the native callTracer
+	result := `{
+	  "type": "CALL", "from": "` + eoa.Hex() + `", "to": "` + contract.Hex() + `", "value": "0x1",
+	  "gas": "0x10000", "gasUsed": "0x5000", "input": "0x01", "output": "0x02",
+	  "calls": [
+	    {"type": "CREATE2", "from": "` + contract.Hex() + `", "to": "` + created.Hex() + `", "value": "0x0",
+	     "gas": "0x8000", "gasUsed": "0x1000", "input": "0x6000", "output": "0x00",
+	     "calls": [{"type": "SELFDESTRUCT", "from": "` + created.Hex() + `", "to": "` + beneficiary.Hex() + `", "value": "0x0", "gas": "0x0", "gasUsed": "0x0", "input": "0x"}]},
+	    {"type": "STATICCALL", "from": "` + contract.Hex() + `", "to": "` + created.Hex() + `",
+	     "gas": "0x100", "gasUsed": "0x100", "input": "0x", "error": "execution reverted"}
+	  ]
+	}`
+	var frame CallFrame
+	require.NoError(t, json.Unmarshal([]byte(result), &frame))
+
+	traces := FlattenCallFrame(&frame)
+	require.Len(t, traces, 4)
+
+	require.Equal(t, "call", traces[0].Type)
+	require.Equal(t, []int{}, traces[0].TraceAddress)
+	require.Equal(t, 2, traces[0].Subtraces)
+	require.Equal(t, "call", traces[0].Action.(*TraceCallAction).CallType)
+	require.Equal(t, uint64(0x5000), uint64(traces[0].Result.(*TraceCallResult).GasUsed))
+
+	require.Equal(t, "create", traces[1].Type)
+	require.Equal(t, []int{0}, traces[1].TraceAddress)
+	require.Equal(t, 1, traces[1].Subtraces)
+	require.Equal(t, created, traces[1].Result.(*TraceCreateResult).Address)
+
+	require.Equal(t, "suicide", traces[2].Type)
+	require.Equal(t, []int{0, 0}, traces[2].TraceAddress)
+	require.Equal(t, beneficiary, traces[2].Action.(*TraceSuicideAction).RefundAddress)
+	require.Nil(t, traces[2].Result)
+
+	require.Equal(t, "call", traces[3].Type)
+	require.Equal(t, []int{1}, traces[3].TraceAddress)
+	require.Equal(t, "staticcall", traces[3].Action.(*TraceCallAction).CallType)
+	require.Equal(t, "Reverted", traces[3].Error)
+	require.Nil(t, traces[3].Result)
+
+	bz, err := json.Marshal(traces[3])
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+	  "action": {"callType": "staticcall", "from": "`+contract.Hex()+`", "to": "`+created.Hex()+`", "gas": "0x100", "input": "0x", "value": "0x0"},
+	  "error": "Reverted", "result": null, "subtraces": 0, "traceAddress": [1], "type": "call"
+	}`, string(bz))
+
+	testCases := []struct {
+		name       string
+		from, to   []common.Address
+		expMatches int
+	}{
+		{"no filter", nil, nil, 4},
+		{"from address", []common.Address{contract}, nil, 2},
+		{"to address", nil, []common.Address{created}, 2},
+		{"from and to address", []common.Address{eoa}, []common.Address{contract}, 1},
+		{"no match", []common.Address{beneficiary}, nil, 0},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			matches := 0
+			for _, trace := range traces {
+				if trace.Matches(tc.from, tc.to) {
+					matches++
+				}
+			}
+			require.Equal(t, tc.expMatches, matches)
+		})
+	}
+}
@@ -188,3 +188,12 @@ type TraceConfig struct {
 	StateOverrides json.RawMessage `json:"stateOverrides"`
 	BlockOverrides json.RawMessage `json:"blockOverrides"`
 }
+
+// AccessListResult returns an optional accesslist
+// It's the result of the `eth_createAccessList` RPC call.
+// It contains an error if the transaction itself failed.
+type AccessListResult struct {
+	Accesslist *ethtypes.AccessList `json:"accessList"`
+	Error      string               `json:"error,omitempty"`
+	GasUsed    hexutil.Uint64       `json:"gasUsed"`
+}
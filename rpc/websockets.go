@@ -40,8 +40,10 @@ import (
 	"github.com/cometbft/cometbft/libs/log"
 
 	rpcfilters "github.com/evmos/ethermint/rpc/namespaces/ethereum/eth/filters"
+	"github.com/evmos/ethermint/rpc/ratelimit"
 	"github.com/evmos/ethermint/rpc/stream"
 	"github.com/evmos/ethermint/server/config"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
 )
 
 type WebsocketsServer interface {
@@ -83,9 +85,16 @@ type websocketsServer struct {
 	keyFile  string
 	api      *pubSubAPI
 	logger   log.Logger
+	limiter  *ratelimit.Limiter
 }
 
-func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, stream *stream.RPCStream, cfg *config.Config) WebsocketsServer {
+func NewWebsocketsServer(
+	clientCtx client.Context,
+	logger log.Logger,
+	stream *stream.RPCStream,
+	cfg *config.Config,
+	limiter *ratelimit.Limiter,
+) WebsocketsServer {
 	logger = logger.With("api", "websocket-server")
 	_, port, _ := net.SplitHostPort(cfg.JSONRPC.Address)
 
@@ -96,6 +105,7 @@ func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, stream *st
 		keyFile:  cfg.TLS.KeyPath,
 		api:      newPubSubAPI(clientCtx, logger, stream),
 		logger:   logger,
+		limiter:  limiter,
 	}
 }
 
@@ -134,16 +144,21 @@ func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 
 	s.readLoop(&wsConn{
-		mux:  new(sync.Mutex),
-		conn: conn,
+		mux:    new(sync.Mutex),
+		conn:   conn,
+		client: s.limiter.ClientKey(r),
 	})
 }
 
 func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
+	s.sendErrResponseWithCode(wsConn, -32600, msg)
+}
+
+func (s *websocketsServer) sendErrResponseWithCode(wsConn *wsConn, code int64, msg string) {
 	res := &ErrorResponseJSON{
 		Jsonrpc: "2.0",
 		Error: &ErrorMessageJSON{
-			Code:    big.NewInt(-32600),
+			Code:    big.NewInt(code),
 			Message: msg,
 		},
 		ID: nil,
@@ -155,6 +170,8 @@ func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
 type wsConn struct {
 	conn *websocket.Conn
 	mux  *sync.Mutex
+	// client identifies the connection in the rate limiter
+	client string
 }
 
 func (w *wsConn) WriteJSON(v interface{}) error {
@@ -195,6 +212,16 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 			return
 		}
 
+		// the requests forwarded to the HTTP server are limited here, with the identity of the client
+		if err := s.limiter.Check(wsConn.client, mb); err != nil {
+			if rpcErr, ok := err.(*ratelimit.Error); ok {
+				s.sendErrResponseWithCode(wsConn, int64(rpcErr.Code), rpcErr.Message)
+			} else {
+				s.sendErrResponse(wsConn, err.Error())
+			}
+			continue
+		}
+
 		if isBatch(mb) {
 			if err := s.tcpGetAndSendResponse(wsConn, mb); err != nil {
 				s.sendErrResponse(wsConn, err.Error())
@@ -322,6 +349,7 @@ func (s *websocketsServer) tcpGetAndSendResponse(wsConn *wsConn, mb []byte) erro
 	}
 
 	req.Header.Set("Content-Type", "application/json")
+	s.limiter.MarkForwarded(req)
 	client := &http.Client{}
 	resp, err := client.Do(req)
 	if err != nil {
@@ -377,7 +405,10 @@ func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []interface
 		}
 		return api.subscribeLogs(wsConn, subID, nil)
 	case "newPendingTransactions":
-		return api.subscribePendingTransactions(wsConn, subID)
+		if len(params) > 1 {
+			return api.subscribePendingTransactions(wsConn, subID, params[1])
+		}
+		return api.subscribePendingTransactions(wsConn, subID, nil)
 	case "syncing":
 		return api.subscribeSyncing(wsConn, subID)
 	default:
@@ -559,22 +590,43 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 	return cancel, nil
 }
 
-func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID) (context.CancelFunc, error) {
+func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID, extra interface{}) (context.CancelFunc, error) {
+	var opts *rpcfilters.PendingTxFilter
+	if extra != nil {
+		bz, err := json.Marshal(extra)
+		if err != nil {
+			return nil, errors.New("invalid pending transactions options")
+		}
+		opts = &rpcfilters.PendingTxFilter{}
+		if err := json.Unmarshal(bz, opts); err != nil {
+			return nil, err
+		}
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 	//nolint: errcheck
-	go api.events.PendingTxStream().Subscribe(ctx, func(items []common.Hash, _ int) error {
-		for _, hash := range items {
+	go api.events.PendingTxStream().Subscribe(ctx, func(items []*evmtypes.MsgEthereumTx, _ int) error {
+		for _, tx := range items {
+			if !opts.Match(tx) {
+				continue
+			}
+			result, err := opts.Result(tx)
+			if err != nil {
+				api.logger.Debug("failed to convert pending transaction", "hash", tx.Hash, "error", err.Error())
+				continue
+			}
+
 			// write to ws conn
 			res := &SubscriptionNotification{
 				Jsonrpc: "2.0",
 				Method:  "eth_subscription",
 				Params: &SubscriptionResult{
 					Subscription: subID,
-					Result:       hash,
+					Result:       result,
 				},
 			}
 
-			err := wsConn.WriteJSON(res)
+			err = wsConn.WriteJSON(res)
 			if err != nil {
 				api.logger.Debug("error writing header, will drop peer", "error", err.Error())
 
@@ -592,8 +644,34 @@ func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID)
 	return cancel, nil
 }
 
-func (api *pubSubAPI) subscribeSyncing(_ *wsConn, _ rpc.ID) (context.CancelFunc, error) {
-	return nil, errors.New("syncing subscription is not implemented")
+func (api *pubSubAPI) subscribeSyncing(wsConn *wsConn, subID rpc.ID) (context.CancelFunc, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	//nolint: errcheck
+	go rpcfilters.WatchSyncing(ctx, api.clientCtx.Client, api.events.HeaderStream(), func(status *rpcfilters.SyncingResult) error {
+		// write to ws conn
+		res := &SubscriptionNotification{
+			Jsonrpc: "2.0",
+			Method:  "eth_subscription",
+			Params: &SubscriptionResult{
+				Subscription: subID,
+				Result:       status,
+			},
+		}
+
+		if err := wsConn.WriteJSON(res); err != nil {
+			api.logger.Error("error writing syncing status, will drop peer", "error", err.Error())
+
+			try(func() {
+				if err != websocket.ErrCloseSent {
+					_ = wsConn.Close()
+				}
+			}, api.logger, "closing websocket peer sub")
+			return err
+		}
+		return nil
+	})
+
+	return cancel, nil
 }
 
 // copy from github.com/ethereum/go-ethereum/rpc/json.go
@@ -8,3 +8,8 @@ solc --combined-json bin,abi --allow-paths . ./tests/solidity/suites/staking/con
 solc --combined-json bin,abi --allow-paths . ./tests/solidity/suites/basic/contracts/TestMessageCall.sol \
     | jq ".contracts.\"./tests/solidity/suites/basic/contracts/TestMessageCall.sol:TestMessageCall\"" \
     > x/evm/types/TestMessageCall.json
+
+# the erc20 wrapper requires solc >= v0.8.10, targeting the london evm
+solc --combined-json bin,abi --optimize --evm-version london --metadata-hash none --allow-paths . ./x/erc20/types/contracts/ERC20Wrapper.sol \
+    | jq ".contracts.\"./x/erc20/types/contracts/ERC20Wrapper.sol:ERC20Wrapper\"" \
+    > x/erc20/types/contracts/ERC20Wrapper.json
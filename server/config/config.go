@@ -51,6 +51,27 @@ const (
 
 	DefaultMaxTxGasWanted = 0
 
+	// DefaultAppMempool enables the app-side mempool by default
+	DefaultAppMempool = true
+
+	// DefaultMempoolMaxTxs is the default maximum number of transactions in the app-side mempool
+	DefaultMempoolMaxTxs = 5000
+
+	// DefaultMempoolMaxTxsPerAccount is the default maximum number of pending transactions of an account
+	DefaultMempoolMaxTxsPerAccount = 64
+
+	// DefaultMempoolMaxQueuedTxs is the default maximum number of transactions queued behind a nonce gap
+	DefaultMempoolMaxQueuedTxs = 1024
+
+	// DefaultMempoolMaxNonceGap is the default maximum nonce gap of a queued transaction
+	DefaultMempoolMaxNonceGap uint64 = 16
+
+	// DefaultMempoolQueuedLifetime is the default maximum time a transaction stays queued
+	DefaultMempoolQueuedLifetime = 3 * time.Hour
+
+	// DefaultMempoolPriceBump is the default minimum fee increase, in percent, to replace a pending transaction
+	DefaultMempoolPriceBump uint64 = 10
+
 	DefaultGasCap uint64 = 25000000
 
 	DefaultFilterCap int32 = 200
@@ -78,6 +99,12 @@ const (
 
 	// DefaultReturnDataLimit is maximum number of bytes returned from eth_call or similar invocations
 	DefaultReturnDataLimit = 100000
+
+	// DefaultBlockCacheSize is the number of finalized blocks cached by the JSON-RPC backend
+	DefaultBlockCacheSize = 256
+
+	// DefaultReceiptCacheSize is the number of transaction lookups and receipts cached by the JSON-RPC backend
+	DefaultReceiptCacheSize = 4096
 )
 
 var evmTracers = []string{"json", "markdown", "struct", "access_list"}
@@ -99,6 +126,22 @@ type EVMConfig struct {
 	Tracer string `mapstructure:"tracer"`
 	// MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 	MaxTxGasWanted uint64 `mapstructure:"max-tx-gas-wanted"`
+	// AppMempool enables the app-side mempool ordering the eth txs by nonce, queueing the txs with a
+	// nonce gap and replacing the pending txs by the ones paying more.
+	AppMempool bool `mapstructure:"app-mempool"`
+	// MempoolMaxTxs defines the maximum number of txs in the app-side mempool.
+	MempoolMaxTxs int `mapstructure:"mempool-max-txs"`
+	// MempoolMaxTxsPerAccount defines the maximum number of pending eth txs of an account.
+	MempoolMaxTxsPerAccount int `mapstructure:"mempool-max-txs-per-account"`
+	// MempoolMaxQueuedTxs defines the maximum number of eth txs queued behind a nonce gap.
+	MempoolMaxQueuedTxs int `mapstructure:"mempool-max-queued-txs"`
+	// MempoolMaxNonceGap defines the maximum gap between the nonce of a queued eth tx and the next
+	// executable nonce of the account.
+	MempoolMaxNonceGap uint64 `mapstructure:"mempool-max-nonce-gap"`
+	// MempoolQueuedLifetime defines the maximum time an eth tx stays queued behind a nonce gap.
+	MempoolQueuedLifetime time.Duration `mapstructure:"mempool-queued-lifetime"`
+	// MempoolPriceBump defines the minimum fee increase, in percent, to replace a pending eth tx.
+	MempoolPriceBump uint64 `mapstructure:"mempool-price-bump"`
 }
 
 // JSONRPCConfig defines configuration for the EVM RPC server.
@@ -139,12 +182,80 @@ type JSONRPCConfig struct {
 	EnableIndexer bool `mapstructure:"enable-indexer"`
 	// AllowIndexerGap defines if allow block gap for the custom indexer service.
 	AllowIndexerGap bool `mapstructure:"allow-indexer-gap"`
+	// EnableAddressIndex defines if the custom indexer should index the txs by address.
+	EnableAddressIndex bool `mapstructure:"enable-address-index"`
+	// EnableLogIndex defines if the custom indexer should persist the logs, indexed by address and topics.
+	EnableLogIndex bool `mapstructure:"enable-log-index"`
 	// MetricsAddress defines the metrics server to listen on
 	MetricsAddress string `mapstructure:"metrics-address"`
 	// FixRevertGasRefundHeight defines the upgrade height for fix of revert gas refund logic when transaction reverted
 	FixRevertGasRefundHeight int64 `mapstructure:"fix-revert-gas-refund-height"`
 	// ReturnDataLimit defines maximum number of bytes returned from `eth_call` or similar invocations
 	ReturnDataLimit int64 `mapstructure:"return-data-limit"`
+	// BlockCacheSize defines the number of finalized blocks, block results and parsed ethereum messages
+	// cached in memory by the backend, 0 disables the cache.
+	BlockCacheSize int `mapstructure:"block-cache-size"`
+	// ReceiptCacheSize defines the number of transaction lookups and receipts cached in memory by the
+	// backend, 0 disables the cache.
+	ReceiptCacheSize int `mapstructure:"receipt-cache-size"`
+	// RateLimit defines the number of requests per second allowed for each client, identified by its IP
+	// or by its API key, 0 disables the limit.
+	RateLimit float64 `mapstructure:"rate-limit"`
+	// RateLimitBurst defines the number of requests a client can send at once on top of the rate limit.
+	RateLimitBurst int `mapstructure:"rate-limit-burst"`
+	// MethodRateLimits defines separate per client budgets for some methods, as "<method>=<rate>[:<burst>]".
+	MethodRateLimits []string `mapstructure:"method-rate-limits"`
+	// RateLimitAPIKeys defines the API keys, sent in the X-API-Key header, that are rate limited on their
+	// own instead of by client IP.
+	RateLimitAPIKeys []string `mapstructure:"rate-limit-api-keys"`
+	// MaxBatchSize defines the maximum number of requests in a batch, 0 means unlimited.
+	MaxBatchSize int `mapstructure:"max-batch-size"`
+	// MaxResponseSize defines the maximum size in bytes of a response, 0 means unlimited.
+	MaxResponseSize int `mapstructure:"max-response-size"`
+	// Listeners defines additional HTTP servers, each one serving its own set of namespaces.
+	Listeners []JSONRPCListenerConfig `mapstructure:"listeners"`
+	// IPCPath defines the unix domain socket serving the JSON-RPC namespaces and subscriptions, relative
+	// paths are resolved from the node home directory, empty disables the IPC server.
+	IPCPath string `mapstructure:"ipc-path"`
+}
+
+// JSONRPCListenerConfig defines an additional JSON-RPC HTTP server, it allows to serve the sensitive
+// namespaces on a separate address, protected by authentication.
+type JSONRPCListenerConfig struct {
+	// Address defines the HTTP server to listen on
+	Address string `mapstructure:"address"`
+	// API defines the list of JSON-RPC namespaces served by the listener
+	API []string `mapstructure:"api"`
+	// CORSOrigins defines the origins allowed to make cross-origin requests, all the origins are allowed if empty
+	CORSOrigins []string `mapstructure:"cors-origins"`
+	// JWTSecret defines the path of the file holding the hex encoded HS256 secret used to authenticate
+	// the requests with a bearer token
+	JWTSecret string `mapstructure:"jwt-secret"`
+	// APIKeys defines the static API keys allowed to use the listener, sent in the X-API-Key header
+	APIKeys []string `mapstructure:"api-keys"`
+	// EnableTLS defines if the listener serves HTTPS with the certificate of the TLS configuration
+	EnableTLS bool `mapstructure:"enable-tls"`
+}
+
+// Validate returns an error if the listener configuration fields are invalid.
+func (c JSONRPCListenerConfig) Validate() error {
+	if c.Address == "" {
+		return errors.New("listener address cannot be empty")
+	}
+
+	if len(SplitList(c.API)) == 0 {
+		return fmt.Errorf("listener %s doesn't define any API namespace", c.Address)
+	}
+
+	seenAPIs := make(map[string]bool)
+	for _, api := range SplitList(c.API) {
+		if seenAPIs[api] {
+			return fmt.Errorf("repeated API namespace '%s' in listener %s", api, c.Address)
+		}
+		seenAPIs[api] = true
+	}
+
+	return nil
 }
 
 // TLSConfig defines the certificate and matching private key for the server.
@@ -203,8 +314,15 @@ func DefaultConfig() *Config {
 // DefaultEVMConfig returns the default EVM configuration
 func DefaultEVMConfig() *EVMConfig {
 	return &EVMConfig{
-		Tracer:         DefaultEVMTracer,
-		MaxTxGasWanted: DefaultMaxTxGasWanted,
+		Tracer:                  DefaultEVMTracer,
+		MaxTxGasWanted:          DefaultMaxTxGasWanted,
+		AppMempool:              DefaultAppMempool,
+		MempoolMaxTxs:           DefaultMempoolMaxTxs,
+		MempoolMaxTxsPerAccount: DefaultMempoolMaxTxsPerAccount,
+		MempoolMaxQueuedTxs:     DefaultMempoolMaxQueuedTxs,
+		MempoolMaxNonceGap:      DefaultMempoolMaxNonceGap,
+		MempoolQueuedLifetime:   DefaultMempoolQueuedLifetime,
+		MempoolPriceBump:        DefaultMempoolPriceBump,
 	}
 }
 
@@ -214,6 +332,24 @@ func (c EVMConfig) Validate() error {
 		return fmt.Errorf("invalid tracer type %s, available types: %v", c.Tracer, evmTracers)
 	}
 
+	if c.AppMempool {
+		if c.MempoolMaxTxs <= 0 {
+			return errors.New("mempool max txs must be positive")
+		}
+
+		if c.MempoolMaxTxsPerAccount <= 0 {
+			return errors.New("mempool max txs per account must be positive")
+		}
+
+		if c.MempoolMaxQueuedTxs < 0 {
+			return errors.New("mempool max queued txs cannot be negative")
+		}
+
+		if c.MempoolQueuedLifetime < 0 {
+			return errors.New("mempool queued lifetime cannot be negative")
+		}
+	}
+
 	return nil
 }
 
@@ -224,7 +360,7 @@ func GetDefaultAPINamespaces() []string {
 
 // GetAPINamespaces returns the all the available JSON-RPC API namespaces.
 func GetAPINamespaces() []string {
-	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner"}
+	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner", "trace", "ots"}
 }
 
 // DefaultJSONRPCConfig returns an EVM config with the JSON-RPC API enabled by default
@@ -247,9 +383,13 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		MaxOpenConnections:       DefaultMaxOpenConnections,
 		EnableIndexer:            false,
 		AllowIndexerGap:          true,
+		EnableAddressIndex:       false,
+		EnableLogIndex:           false,
 		MetricsAddress:           DefaultJSONRPCMetricsAddress,
 		FixRevertGasRefundHeight: DefaultFixRevertGasRefundHeight,
 		ReturnDataLimit:          DefaultReturnDataLimit,
+		BlockCacheSize:           DefaultBlockCacheSize,
+		ReceiptCacheSize:         DefaultReceiptCacheSize,
 	}
 }
 
@@ -291,6 +431,34 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC HTTP idle timeout duration cannot be negative")
 	}
 
+	if c.BlockCacheSize < 0 {
+		return errors.New("JSON-RPC block cache size cannot be negative")
+	}
+
+	if c.ReceiptCacheSize < 0 {
+		return errors.New("JSON-RPC receipt cache size cannot be negative")
+	}
+
+	if c.RateLimit < 0 {
+		return errors.New("JSON-RPC rate limit cannot be negative")
+	}
+
+	if c.RateLimitBurst < 0 {
+		return errors.New("JSON-RPC rate limit burst cannot be negative")
+	}
+
+	if _, err := ParseMethodRateLimits(c.MethodRateLimits); err != nil {
+		return fmt.Errorf("JSON-RPC %w", err)
+	}
+
+	if c.MaxBatchSize < 0 {
+		return errors.New("JSON-RPC max batch size cannot be negative")
+	}
+
+	if c.MaxResponseSize < 0 {
+		return errors.New("JSON-RPC max response size cannot be negative")
+	}
+
 	// check for duplicates
 	seenAPIs := make(map[string]bool)
 	for _, api := range c.API {
@@ -301,6 +469,17 @@ func (c JSONRPCConfig) Validate() error {
 		seenAPIs[api] = true
 	}
 
+	seenAddresses := map[string]bool{c.Address: true, c.WsAddress: true}
+	for _, listener := range c.Listeners {
+		if err := listener.Validate(); err != nil {
+			return err
+		}
+		if seenAddresses[listener.Address] {
+			return fmt.Errorf("listener address %s is already in use", listener.Address)
+		}
+		seenAddresses[listener.Address] = true
+	}
+
 	return nil
 }
 
@@ -336,11 +515,23 @@ func GetConfig(v *viper.Viper) (Config, error) {
 		return Config{}, err
 	}
 
+	var listeners []JSONRPCListenerConfig
+	if err := v.UnmarshalKey("json-rpc.listeners", &listeners); err != nil {
+		return Config{}, fmt.Errorf("failed to parse json-rpc listeners: %w", err)
+	}
+
 	return Config{
 		Config: cfg,
 		EVM: EVMConfig{
-			Tracer:         v.GetString("evm.tracer"),
-			MaxTxGasWanted: v.GetUint64("evm.max-tx-gas-wanted"),
+			Tracer:                  v.GetString("evm.tracer"),
+			MaxTxGasWanted:          v.GetUint64("evm.max-tx-gas-wanted"),
+			AppMempool:              v.GetBool("evm.app-mempool"),
+			MempoolMaxTxs:           v.GetInt("evm.mempool-max-txs"),
+			MempoolMaxTxsPerAccount: v.GetInt("evm.mempool-max-txs-per-account"),
+			MempoolMaxQueuedTxs:     v.GetInt("evm.mempool-max-queued-txs"),
+			MempoolMaxNonceGap:      v.GetUint64("evm.mempool-max-nonce-gap"),
+			MempoolQueuedLifetime:   v.GetDuration("evm.mempool-queued-lifetime"),
+			MempoolPriceBump:        v.GetUint64("evm.mempool-price-bump"),
 		},
 		JSONRPC: JSONRPCConfig{
 			Enable:                   v.GetBool("json-rpc.enable"),
@@ -359,9 +550,21 @@ func GetConfig(v *viper.Viper) (Config, error) {
 			MaxOpenConnections:       v.GetInt("json-rpc.max-open-connections"),
 			EnableIndexer:            v.GetBool("json-rpc.enable-indexer"),
 			AllowIndexerGap:          v.GetBool("json-rpc.allow-indexer-gap"),
+			EnableAddressIndex:       v.GetBool("json-rpc.enable-address-index"),
+			EnableLogIndex:           v.GetBool("json-rpc.enable-log-index"),
 			MetricsAddress:           v.GetString("json-rpc.metrics-address"),
 			FixRevertGasRefundHeight: v.GetInt64("json-rpc.fix-revert-gas-refund-height"),
 			ReturnDataLimit:          v.GetInt64("json-rpc.return-data-limit"),
+			BlockCacheSize:           v.GetInt("json-rpc.block-cache-size"),
+			ReceiptCacheSize:         v.GetInt("json-rpc.receipt-cache-size"),
+			RateLimit:                v.GetFloat64("json-rpc.rate-limit"),
+			RateLimitBurst:           v.GetInt("json-rpc.rate-limit-burst"),
+			MethodRateLimits:         v.GetStringSlice("json-rpc.method-rate-limits"),
+			RateLimitAPIKeys:         v.GetStringSlice("json-rpc.rate-limit-api-keys"),
+			MaxBatchSize:             v.GetInt("json-rpc.max-batch-size"),
+			MaxResponseSize:          v.GetInt("json-rpc.max-response-size"),
+			Listeners:                listeners,
+			IPCPath:                  v.GetString("json-rpc.ipc-path"),
 		},
 		TLS: TLSConfig{
 			CertificatePath: v.GetString("tls.certificate-path"),
@@ -393,5 +596,14 @@ func (c Config) ValidateBasic() error {
 		return errorsmod.Wrapf(errortypes.ErrAppConfig, "invalid tls config value: %s", err.Error())
 	}
 
+	for _, listener := range c.JSONRPC.Listeners {
+		if listener.EnableTLS && (c.TLS.CertificatePath == "" || c.TLS.KeyPath == "") {
+			return errorsmod.Wrapf(
+				errortypes.ErrAppConfig,
+				"json-rpc listener %s requires the tls certificate and key paths", listener.Address,
+			)
+		}
+	}
+
 	return c.Config.ValidateBasic()
 }
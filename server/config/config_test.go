@@ -12,3 +12,75 @@ func TestDefaultConfig(t *testing.T) {
 	require.Equal(t, cfg.JSONRPC.Address, DefaultJSONRPCAddress)
 	require.Equal(t, cfg.JSONRPC.WsAddress, DefaultJSONRPCWsAddress)
 }
+
+func TestParseMethodRateLimits(t *testing.T) {
+	testCases := []struct {
+		name    string
+		entries []string
+		exp     map[string]RateLimit
+		expPass bool
+	}{
+		{"empty", []string{""}, map[string]RateLimit{}, true},
+		{
+			"comma separated entries",
+			[]string{"eth_getLogs=5:10,debug_*=0.5"},
+			map[string]RateLimit{"eth_getLogs": {Rate: 5, Burst: 10}, "debug_*": {Rate: 0.5}},
+			true,
+		},
+		{"missing rate", []string{"eth_call"}, nil, false},
+		{"invalid rate", []string{"eth_call=0"}, nil, false},
+		{"invalid burst", []string{"eth_call=1:x"}, nil, false},
+		{"wildcard not as suffix", []string{"eth_*Logs=1"}, nil, false},
+		{"repeated method", []string{"eth_call=1", "eth_call=2"}, nil, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			limits, err := ParseMethodRateLimits(tc.entries)
+			if !tc.expPass {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, limits)
+		})
+	}
+
+	cfg := DefaultJSONRPCConfig()
+	cfg.MethodRateLimits = []string{"eth_call"}
+	require.Error(t, cfg.Validate())
+	require.Equal(t, float64(1), RateLimit{Rate: 0.5}.Capacity())
+}
+
+func TestValidateListeners(t *testing.T) {
+	testCases := []struct {
+		name      string
+		listeners []JSONRPCListenerConfig
+		expPass   bool
+	}{
+		{"no listener", nil, true},
+		{"valid listener", []JSONRPCListenerConfig{{Address: "127.0.0.1:8555", API: []string{"debug,personal"}}}, true},
+		{"missing address", []JSONRPCListenerConfig{{API: []string{"debug"}}}, false},
+		{"missing api", []JSONRPCListenerConfig{{Address: "127.0.0.1:8555"}}, false},
+		{"repeated api", []JSONRPCListenerConfig{{Address: "127.0.0.1:8555", API: []string{"debug,debug"}}}, false},
+		{"address of the main server", []JSONRPCListenerConfig{{Address: DefaultJSONRPCAddress, API: []string{"debug"}}}, false},
+		{
+			"repeated address",
+			[]JSONRPCListenerConfig{
+				{Address: "127.0.0.1:8555", API: []string{"debug"}},
+				{Address: "127.0.0.1:8555", API: []string{"personal"}},
+			},
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := DefaultJSONRPCConfig()
+			cfg.Listeners = tc.listeners
+			if tc.expPass {
+				require.NoError(t, cfg.Validate())
+			} else {
+				require.Error(t, cfg.Validate())
+			}
+		})
+	}
+}
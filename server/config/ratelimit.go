@@ -0,0 +1,85 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package config
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// RateLimit defines a token bucket refilled with Rate tokens per second and holding at most Burst tokens.
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+// Capacity returns the size of the bucket, it defaults to one second of tokens when the burst is not set.
+func (l RateLimit) Capacity() float64 {
+	if l.Burst > 0 {
+		return float64(l.Burst)
+	}
+	return math.Max(1, math.Ceil(l.Rate))
+}
+
+// SplitList returns the non empty elements of a list option, splitting the comma separated values
+// that are read as a single element from the toml file.
+func SplitList(values []string) []string {
+	var res []string
+	for _, value := range values {
+		for _, elem := range strings.Split(value, ",") {
+			if elem = strings.TrimSpace(elem); elem != "" {
+				res = append(res, elem)
+			}
+		}
+	}
+	return res
+}
+
+// ParseMethodRateLimits parses the "<method>=<rate>[:<burst>]" entries of the method-rate-limits option,
+// a method ending with "*" matches all the methods starting with the given prefix, eg. "debug_*".
+func ParseMethodRateLimits(entries []string) (map[string]RateLimit, error) {
+	limits := make(map[string]RateLimit, len(entries))
+	for _, entry := range SplitList(entries) {
+		method, value, ok := strings.Cut(entry, "=")
+		if !ok || method == "" {
+			return nil, fmt.Errorf("invalid method rate limit '%s', expected <method>=<rate>[:<burst>]", entry)
+		}
+		if strings.Contains(strings.TrimSuffix(method, "*"), "*") {
+			return nil, fmt.Errorf("invalid method rate limit '%s', wildcard is only allowed as suffix", entry)
+		}
+		if _, ok := limits[method]; ok {
+			return nil, fmt.Errorf("repeated method rate limit '%s'", method)
+		}
+
+		rate, burst, hasBurst := strings.Cut(value, ":")
+		var (
+			limit RateLimit
+			err   error
+		)
+		if limit.Rate, err = strconv.ParseFloat(rate, 64); err != nil || limit.Rate <= 0 {
+			return nil, fmt.Errorf("invalid rate in method rate limit '%s'", entry)
+		}
+		if hasBurst {
+			if limit.Burst, err = strconv.Atoi(burst); err != nil || limit.Burst <= 0 {
+				return nil, fmt.Errorf("invalid burst in method rate limit '%s'", entry)
+			}
+		}
+		limits[method] = limit
+	}
+	return limits, nil
+}
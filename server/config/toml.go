@@ -31,6 +31,31 @@ tracer = "{{ .EVM.Tracer }}"
 # MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 max-tx-gas-wanted = {{ .EVM.MaxTxGasWanted }}
 
+# AppMempool enables the app-side mempool ordering the eth txs by nonce, queueing the txs with a
+# nonce gap and replacing the pending txs by the ones paying more.
+app-mempool = {{ .EVM.AppMempool }}
+
+# MempoolMaxTxs defines the maximum number of txs in the app-side mempool.
+mempool-max-txs = {{ .EVM.MempoolMaxTxs }}
+
+# MempoolMaxTxsPerAccount defines the maximum number of pending eth txs of an account, the tx with
+# the highest nonce is evicted for a tx filling a nonce gap.
+mempool-max-txs-per-account = {{ .EVM.MempoolMaxTxsPerAccount }}
+
+# MempoolMaxQueuedTxs defines the maximum number of eth txs queued behind a nonce gap, the queued txs
+# are evicted first when the mempool is full.
+mempool-max-queued-txs = {{ .EVM.MempoolMaxQueuedTxs }}
+
+# MempoolMaxNonceGap defines the maximum gap between the nonce of a queued eth tx and the next
+# executable nonce of the account.
+mempool-max-nonce-gap = {{ .EVM.MempoolMaxNonceGap }}
+
+# MempoolQueuedLifetime defines the maximum time an eth tx stays queued behind a nonce gap (0=unlimited).
+mempool-queued-lifetime = "{{ .EVM.MempoolQueuedLifetime }}"
+
+# MempoolPriceBump defines the minimum fee increase, in percent, to replace a pending eth tx.
+mempool-price-bump = {{ .EVM.MempoolPriceBump }}
+
 ###############################################################################
 ###                           JSON RPC Configuration                        ###
 ###############################################################################
@@ -91,6 +116,15 @@ enable-indexer = {{ .JSONRPC.EnableIndexer }}
 # AllowIndexerGap allow block gap for the custom transaction indexer for the EVM (ethereum transactions).
 allow-indexer-gap = {{ .JSONRPC.AllowIndexerGap }}
 
+# EnableAddressIndex enables the index of the EVM transactions by sender, recipient and created contract,
+# it's required by 'eth_getTransactionsByAddress' and the 'ots' namespace. The range of blocks covered by the address
+# index is tracked, use 'index-eth-tx' with '--enable-address-index' to backfill the blocks indexed before enabling it.
+enable-address-index = {{ .JSONRPC.EnableAddressIndex }}
+
+# EnableLogIndex enables the persistent index of the EVM logs by address and topics, 'eth_getLogs' queries
+# are answered from it when the block range is covered, use 'index-eth-tx' to backfill the historical blocks.
+enable-log-index = {{ .JSONRPC.EnableLogIndex }}
+
 # MetricsAddress defines the EVM Metrics server address to bind to. Pass --metrics in CLI to enable
 # Prometheus metrics path: /debug/metrics/prometheus
 metrics-address = "{{ .JSONRPC.MetricsAddress }}"
@@ -101,6 +135,64 @@ fix-revert-gas-refund-height = {{ .JSONRPC.FixRevertGasRefundHeight }}
 # Maximum number of bytes returned from eth_call or similar invocations.
 return-data-limit = {{ .JSONRPC.ReturnDataLimit }}
 
+# BlockCacheSize is the number of finalized blocks, block results and parsed ethereum messages
+# cached in memory by the JSON-RPC backend, 0 disables the cache.
+block-cache-size = {{ .JSONRPC.BlockCacheSize }}
+
+# ReceiptCacheSize is the number of transaction lookups and receipts cached in memory
+# by the JSON-RPC backend, 0 disables the cache.
+receipt-cache-size = {{ .JSONRPC.ReceiptCacheSize }}
+
+# RateLimit is the number of requests per second allowed for each client, identified by its IP
+# or by its API key, it applies to the HTTP and the WebSocket servers. 0 disables the limit.
+rate-limit = {{ .JSONRPC.RateLimit }}
+
+# RateLimitBurst is the number of requests a client can send at once on top of the rate limit,
+# it defaults to one second of requests.
+rate-limit-burst = {{ .JSONRPC.RateLimitBurst }}
+
+# MethodRateLimits defines separate per client budgets for some methods, a method ending with '*'
+# matches all the methods with that prefix.
+# Example: "eth_getLogs=5:10,eth_call=20:40,debug_*=1"
+method-rate-limits = "{{range $index, $elmt := .JSONRPC.MethodRateLimits}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# RateLimitAPIKeys defines the API keys, sent in the X-API-Key header, that are rate limited on their own
+# instead of by client IP.
+rate-limit-api-keys = "{{range $index, $elmt := .JSONRPC.RateLimitAPIKeys}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# MaxBatchSize is the maximum number of requests in a batch (0=unlimited).
+max-batch-size = {{ .JSONRPC.MaxBatchSize }}
+
+# MaxResponseSize is the maximum size in bytes of a response (0=unlimited).
+max-response-size = {{ .JSONRPC.MaxResponseSize }}
+
+# IPCPath defines the unix domain socket serving the JSON-RPC namespaces and subscriptions, it's only
+# accessible by the node user. Relative paths are resolved from the node home directory. Empty disables it.
+# Example: "data/ethermint.ipc"
+ipc-path = "{{ .JSONRPC.IPCPath }}"
+
+# Listeners define additional HTTP servers, each one serving its own set of namespaces, with its own
+# CORS origins and optionally protected by a JWT secret (HS256 bearer token) and/or static API keys
+# sent in the X-API-Key header. The TLS configuration is used when enable-tls is set.
+# Example:
+# [[json-rpc.listeners]]
+# address = "127.0.0.1:8555"
+# api = "debug,personal,admin"
+# cors-origins = "https://admin.example.com"
+# jwt-secret = "/path/to/jwt.hex"
+# api-keys = ""
+# enable-tls = true
+{{- range .JSONRPC.Listeners }}
+
+[[json-rpc.listeners]]
+address = "{{ .Address }}"
+api = "{{range $index, $elmt := .API}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+cors-origins = "{{range $index, $elmt := .CORSOrigins}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+jwt-secret = "{{ .JWTSecret }}"
+api-keys = "{{range $index, $elmt := .APIKeys}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+enable-tls = {{ .EnableTLS }}
+{{- end }}
+
 ###############################################################################
 ###                             TLS Configuration                           ###
 ###############################################################################
@@ -66,18 +66,35 @@ const (
 	JSONRPCMaxOpenConnections  = "json-rpc.max-open-connections"
 	JSONRPCEnableIndexer       = "json-rpc.enable-indexer"
 	JSONRPCAllowIndexerGap     = "json-rpc.allow-indexer-gap"
+	JSONRPCEnableAddressIndex  = "json-rpc.enable-address-index"
+	JSONRPCEnableLogIndex      = "json-rpc.enable-log-index"
 	// JSONRPCEnableMetrics enables EVM RPC metrics server.
 	// Set to `metrics` which is hardcoded flag from go-ethereum.
 	// https://github.com/ethereum/go-ethereum/blob/master/metrics/metrics.go#L35-L55
 	JSONRPCEnableMetrics            = "metrics"
 	JSONRPCFixRevertGasRefundHeight = "json-rpc.fix-revert-gas-refund-height"
 	JSONRPCReturnDataLimit          = "json-rpc.return-data-limit"
+	JSONRPCBlockCacheSize           = "json-rpc.block-cache-size"
+	JSONRPCReceiptCacheSize         = "json-rpc.receipt-cache-size"
+	JSONRPCRateLimit                = "json-rpc.rate-limit"
+	JSONRPCRateLimitBurst           = "json-rpc.rate-limit-burst"
+	JSONRPCMethodRateLimits         = "json-rpc.method-rate-limits"
+	JSONRPCMaxBatchSize             = "json-rpc.max-batch-size"
+	JSONRPCMaxResponseSize          = "json-rpc.max-response-size"
+	JSONRPCIPCPath                  = "json-rpc.ipc-path"
 )
 
 // EVM flags
 const (
-	EVMTracer         = "evm.tracer"
-	EVMMaxTxGasWanted = "evm.max-tx-gas-wanted"
+	EVMTracer                  = "evm.tracer"
+	EVMMaxTxGasWanted          = "evm.max-tx-gas-wanted"
+	EVMAppMempool              = "evm.app-mempool"
+	EVMMempoolMaxTxs           = "evm.mempool-max-txs"
+	EVMMempoolMaxTxsPerAccount = "evm.mempool-max-txs-per-account"
+	EVMMempoolMaxQueuedTxs     = "evm.mempool-max-queued-txs"
+	EVMMempoolMaxNonceGap      = "evm.mempool-max-nonce-gap"
+	EVMMempoolQueuedLifetime   = "evm.mempool-queued-lifetime"
+	EVMMempoolPriceBump        = "evm.mempool-price-bump"
 )
 
 // TLS flags
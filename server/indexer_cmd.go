@@ -26,6 +26,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/evmos/ethermint/indexer"
+	srvflags "github.com/evmos/ethermint/server/flags"
 )
 
 func NewIndexTxCmd() *cobra.Command {
@@ -60,7 +61,53 @@ func NewIndexTxCmd() *cobra.Command {
 				logger.Error("failed to open evm indexer DB", "error", err.Error())
 				return err
 			}
-			idxer := indexer.NewKVIndexer(idxDB, logger.With("module", "evmindex"), clientCtx)
+			addressIndex := serverCtx.Viper.GetBool(srvflags.JSONRPCEnableAddressIndex)
+			logIndex := serverCtx.Viper.GetBool(srvflags.JSONRPCEnableLogIndex)
+			idxer := indexer.NewKVIndexer(
+				idxDB, logger.With("module", "evmindex"), clientCtx,
+				indexer.WithAddressIndex(addressIndex),
+				indexer.WithLogIndex(logIndex),
+			)
+
+			// the address and log indexes cover every block, so they track the progress when enabled,
+			// which allows to backfill the blocks indexed before enabling them. The least advanced
+			// index in the direction is resumed.
+			var indexRanges []func() (int64, int64, error)
+			if addressIndex {
+				indexRanges = append(indexRanges, idxer.AddressIndexRange)
+			}
+			if logIndex {
+				indexRanges = append(indexRanges, idxer.LogIndexRange)
+			}
+			firstIndexedBlock, lastIndexedBlock := idxer.FirstIndexedBlock, idxer.LastIndexedBlock
+			if len(indexRanges) > 0 {
+				firstIndexedBlock = func() (int64, error) {
+					var first int64
+					for _, indexRange := range indexRanges {
+						start, _, err := indexRange()
+						if err != nil || start == -1 {
+							return start, err
+						}
+						if start > first {
+							first = start
+						}
+					}
+					return first, nil
+				}
+				lastIndexedBlock = func() (int64, error) {
+					last := int64(-1)
+					for _, indexRange := range indexRanges {
+						_, end, err := indexRange()
+						if err != nil || end == -1 {
+							return end, err
+						}
+						if last == -1 || end < last {
+							last = end
+						}
+					}
+					return last, nil
+				}
+			}
 
 			// open local tendermint db, because the local rpc won't be available.
 			tmdb, err := tmnode.DefaultDBProvider(&tmnode.DBContext{ID: "blockstore", Config: cfg})
@@ -95,7 +142,7 @@ func NewIndexTxCmd() *cobra.Command {
 
 			switch args[0] {
 			case "backward":
-				first, err := idxer.FirstIndexedBlock()
+				first, err := firstIndexedBlock()
 				if err != nil {
 					return err
 				}
@@ -109,7 +156,7 @@ func NewIndexTxCmd() *cobra.Command {
 					}
 				}
 			case "forward":
-				latest, err := idxer.LastIndexedBlock()
+				latest, err := lastIndexedBlock()
 				if err != nil {
 					return err
 				}
@@ -129,5 +176,7 @@ func NewIndexTxCmd() *cobra.Command {
 			return nil
 		},
 	}
+	cmd.Flags().Bool(srvflags.JSONRPCEnableAddressIndex, false, "Index the txs by address too, should match the node config")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableLogIndex, false, "Index the logs too, should match the node config")
 	return cmd
 }
@@ -16,8 +16,13 @@
 package server
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net"
 	"net/http"
+	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/gorilla/mux"
@@ -31,6 +36,9 @@ import (
 	ethrpc "github.com/ethereum/go-ethereum/rpc"
 	"github.com/evmos/ethermint/app/ante"
 	"github.com/evmos/ethermint/rpc"
+	"github.com/evmos/ethermint/rpc/auth"
+	"github.com/evmos/ethermint/rpc/backend"
+	"github.com/evmos/ethermint/rpc/ratelimit"
 	"github.com/evmos/ethermint/rpc/stream"
 	"github.com/evmos/ethermint/server/config"
 	ethermint "github.com/evmos/ethermint/types"
@@ -84,26 +92,21 @@ func StartJSONRPC(ctx *server.Context,
 		return nil
 	}))
 
-	rpcServer := ethrpc.NewServer()
-
-	allowUnprotectedTxs := config.JSONRPC.AllowUnprotectedTxs
-	rpcAPIArr := config.JSONRPC.API
+	// the backends of all the namespaces and listeners share the cache of the finalized blocks
+	cache := backend.NewCache(config.JSONRPC)
 
-	apis := rpc.GetRPCAPIs(ctx, clientCtx, rpcStream, allowUnprotectedTxs, indexer, rpcAPIArr)
+	rpcServer, err := newRPCServer(ctx, clientCtx, rpcStream, config, indexer, cache, config.JSONRPC.API)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	for _, api := range apis {
-		if err := rpcServer.RegisterName(api.Namespace, api.Service); err != nil {
-			ctx.Logger.Error(
-				"failed to register service in JSON RPC namespace",
-				"namespace", api.Namespace,
-				"service", api.Service,
-			)
-			return nil, nil, err
-		}
+	limiter, err := ratelimit.New(config.JSONRPC)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	r := mux.NewRouter()
-	r.HandleFunc("/", rpcServer.ServeHTTP).Methods("POST")
+	r.Handle("/", limiter.Middleware(rpcServer)).Methods("POST")
 
 	handlerWithCors := cors.Default()
 	if config.API.EnableUnsafeCORS {
@@ -125,6 +128,35 @@ func StartJSONRPC(ctx *server.Context,
 		return nil, nil, err
 	}
 
+	if config.JSONRPC.IPCPath != "" {
+		ipcListener, err := startIPC(ctx, config.JSONRPC.IPCPath, rpcServer)
+		if err != nil {
+			_ = ln.Close()
+			return nil, nil, err
+		}
+		// closing the listener removes the socket file
+		httpSrv.RegisterOnShutdown(func() {
+			if err := ipcListener.Close(); err != nil {
+				ctx.Logger.Error("failed to close JSON-RPC IPC endpoint", "error", err.Error())
+			}
+		})
+	}
+
+	// the additional listeners are shut down with the main server
+	for _, listenerCfg := range config.JSONRPC.Listeners {
+		listenerSrv, err := startJSONRPCListener(ctx, clientCtx, config, listenerCfg, rpcStream, indexer, cache, limiter)
+		if err != nil {
+			_ = httpSrv.Shutdown(context.Background())
+			_ = ln.Close()
+			return nil, nil, err
+		}
+		httpSrv.RegisterOnShutdown(func() {
+			if err := listenerSrv.Shutdown(context.Background()); err != nil {
+				ctx.Logger.Error("failed to shutdown JSON-RPC listener", "address", listenerSrv.Addr, "error", err.Error())
+			}
+		})
+	}
+
 	errCh := make(chan error)
 	go func() {
 		ctx.Logger.Info("Starting JSON-RPC server", "address", config.JSONRPC.Address)
@@ -148,7 +180,150 @@ func StartJSONRPC(ctx *server.Context,
 
 	ctx.Logger.Info("Starting JSON WebSocket server", "address", config.JSONRPC.WsAddress)
 
-	wsSrv := rpc.NewWebsocketsServer(clientCtx, ctx.Logger, rpcStream, config)
+	wsSrv := rpc.NewWebsocketsServer(clientCtx, ctx.Logger, rpcStream, config, limiter)
 	wsSrv.Start()
 	return httpSrv, httpSrvDone, nil
 }
+
+// newRPCServer creates a JSON-RPC server serving the given namespaces.
+func newRPCServer(
+	ctx *server.Context,
+	clientCtx client.Context,
+	rpcStream *stream.RPCStream,
+	cfg *config.Config,
+	indexer ethermint.EVMTxIndexer,
+	cache *backend.Cache,
+	namespaces []string,
+) (*ethrpc.Server, error) {
+	rpcServer := ethrpc.NewServer()
+	apis := rpc.GetRPCAPIs(ctx, clientCtx, rpcStream, cfg.JSONRPC.AllowUnprotectedTxs, indexer, cache, namespaces)
+
+	for _, api := range apis {
+		if err := rpcServer.RegisterName(api.Namespace, api.Service); err != nil {
+			ctx.Logger.Error(
+				"failed to register service in JSON RPC namespace",
+				"namespace", api.Namespace,
+				"service", api.Service,
+			)
+			return nil, err
+		}
+	}
+	return rpcServer, nil
+}
+
+// listenerCORS returns the CORS handler of a JSON-RPC listener. All the origins are allowed if none is
+// configured, and the authentication headers are always allowed so that the browser clients can
+// authenticate.
+func listenerCORS(origins []string, enableUnsafeCORS bool) *cors.Cors {
+	if len(origins) == 0 {
+		if enableUnsafeCORS {
+			return cors.AllowAll()
+		}
+		origins = []string{"*"}
+	}
+
+	return cors.New(cors.Options{
+		AllowedOrigins: origins,
+		AllowedMethods: []string{http.MethodPost},
+		AllowedHeaders: []string{"Origin", "Accept", "Content-Type", "X-Requested-With", "Authorization", ratelimit.APIKeyHeader},
+	})
+}
+
+// startJSONRPCListener starts an additional HTTP server serving the namespaces of the listener,
+// with its own CORS origins, authentication and TLS settings.
+func startJSONRPCListener(
+	ctx *server.Context,
+	clientCtx client.Context,
+	cfg *config.Config,
+	listenerCfg config.JSONRPCListenerConfig,
+	rpcStream *stream.RPCStream,
+	indexer ethermint.EVMTxIndexer,
+	cache *backend.Cache,
+	limiter *ratelimit.Limiter,
+) (*http.Server, error) {
+	authenticator, err := auth.New(listenerCfg.JWTSecret, config.SplitList(listenerCfg.APIKeys))
+	if err != nil {
+		return nil, err
+	}
+	if !authenticator.Enabled() {
+		ctx.Logger.Info("JSON-RPC listener is not authenticated", "address", listenerCfg.Address)
+	}
+
+	rpcServer, err := newRPCServer(ctx, clientCtx, rpcStream, cfg, indexer, cache, config.SplitList(listenerCfg.API))
+	if err != nil {
+		return nil, err
+	}
+
+	r := mux.NewRouter()
+	r.Handle("/", limiter.Middleware(authenticator.Middleware(rpcServer))).Methods("POST")
+
+	handlerWithCors := listenerCORS(config.SplitList(listenerCfg.CORSOrigins), cfg.API.EnableUnsafeCORS)
+
+	srv := &http.Server{
+		Addr:              listenerCfg.Address,
+		Handler:           handlerWithCors.Handler(r),
+		ReadHeaderTimeout: cfg.JSONRPC.HTTPTimeout,
+		ReadTimeout:       cfg.JSONRPC.HTTPTimeout,
+		WriteTimeout:      cfg.JSONRPC.HTTPTimeout,
+		IdleTimeout:       cfg.JSONRPC.HTTPIdleTimeout,
+	}
+
+	ln, err := Listen(srv.Addr, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		ctx.Logger.Info(
+			"Starting JSON-RPC listener",
+			"address", listenerCfg.Address, "api", listenerCfg.API, "tls", listenerCfg.EnableTLS,
+		)
+		var err error
+		if listenerCfg.EnableTLS {
+			err = srv.ServeTLS(ln, cfg.TLS.CertificatePath, cfg.TLS.KeyPath)
+		} else {
+			err = srv.Serve(ln)
+		}
+		if err != nil && err != http.ErrServerClosed {
+			ctx.Logger.Error("failed to start JSON-RPC listener", "address", listenerCfg.Address, "error", err.Error())
+		}
+	}()
+	return srv, nil
+}
+
+// startIPC serves the JSON-RPC server on a unix domain socket, which is only accessible by the node user.
+func startIPC(ctx *server.Context, endpoint string, rpcServer *ethrpc.Server) (net.Listener, error) {
+	if !filepath.IsAbs(endpoint) {
+		endpoint = filepath.Join(ctx.Config.RootDir, endpoint)
+	}
+	if err := os.MkdirAll(filepath.Dir(endpoint), 0o750); err != nil {
+		return nil, err
+	}
+
+	// remove the socket left by an unclean shutdown, but never a regular file
+	if fi, err := os.Lstat(endpoint); err == nil {
+		if fi.Mode()&os.ModeSocket == 0 {
+			return nil, fmt.Errorf("ipc path %s already exists and is not a socket", endpoint)
+		}
+		if err := os.Remove(endpoint); err != nil {
+			return nil, err
+		}
+	}
+
+	ln, err := net.Listen("unix", endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on ipc path %s: %w", endpoint, err)
+	}
+	if err := os.Chmod(endpoint, 0o600); err != nil {
+		_ = ln.Close()
+		return nil, err
+	}
+
+	go func() {
+		ctx.Logger.Info("Starting JSON-RPC IPC endpoint", "path", endpoint)
+		if err := rpcServer.ServeListener(ln); err != nil && !errors.Is(err, net.ErrClosed) {
+			ctx.Logger.Error("JSON-RPC IPC endpoint failed", "error", err.Error())
+		}
+	}()
+	return ln, nil
+}
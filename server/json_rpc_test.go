@@ -0,0 +1,81 @@
+package server
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStartIPC(t *testing.T) {
+	ctx := server.NewDefaultContext()
+	ctx.Config.RootDir = t.TempDir()
+	endpoint := filepath.Join(ctx.Config.RootDir, "data", "ethermint.ipc")
+
+	// a stale socket is replaced
+	require.NoError(t, os.MkdirAll(filepath.Dir(endpoint), 0o750))
+	stale, err := net.Listen("unix", endpoint)
+	require.NoError(t, err)
+	stale.(*net.UnixListener).SetUnlinkOnClose(false)
+	require.NoError(t, stale.Close())
+
+	ln, err := startIPC(ctx, filepath.Join("data", "ethermint.ipc"), ethrpc.NewServer())
+	require.NoError(t, err)
+
+	fi, err := os.Stat(endpoint)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0o600), fi.Mode().Perm())
+
+	client, err := ethrpc.DialIPC(context.Background(), endpoint)
+	require.NoError(t, err)
+	var modules map[string]string
+	require.NoError(t, client.Call(&modules, "rpc_modules"))
+	require.Contains(t, modules, "rpc")
+	client.Close()
+
+	// the socket is removed on shutdown
+	require.NoError(t, ln.Close())
+	_, err = os.Stat(endpoint)
+	require.True(t, os.IsNotExist(err))
+
+	// a regular file is never removed
+	require.NoError(t, os.WriteFile(endpoint, []byte("data"), 0o600))
+	_, err = startIPC(ctx, endpoint, ethrpc.NewServer())
+	require.Error(t, err)
+}
+
+func TestListenerCORS(t *testing.T) {
+	testCases := []struct {
+		name      string
+		origins   []string
+		origin    string
+		expOrigin string
+	}{
+		{"all origins", nil, "https://wallet.example.com", "*"},
+		{"allowed origin", []string{"https://admin.example.com"}, "https://admin.example.com", "https://admin.example.com"},
+		{"other origin", []string{"https://admin.example.com"}, "https://wallet.example.com", ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := listenerCORS(tc.origins, false).Handler(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+
+			// the preflight of an authenticated request
+			req := httptest.NewRequest(http.MethodOptions, "/", nil)
+			req.Header.Set("Origin", tc.origin)
+			req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+			req.Header.Set("Access-Control-Request-Headers", "authorization,content-type,x-api-key")
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			require.Equal(t, tc.expOrigin, rec.Header().Get("Access-Control-Allow-Origin"))
+		})
+	}
+}
@@ -217,11 +217,28 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Int32(srvflags.JSONRPCBlockRangeCap, config.DefaultBlockRangeCap, "Sets the max block range allowed for `eth_getLogs` query")
 	cmd.Flags().Int(srvflags.JSONRPCMaxOpenConnections, config.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener") //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableAddressIndex, false, "Enable the index of txs by address in the custom tx indexer")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableLogIndex, false, "Enable the persistent log index in the custom tx indexer for eth_getLogs")
 	cmd.Flags().Bool(srvflags.JSONRPCAllowIndexerGap, true, "Allow block gap for the custom tx indexer for json-rpc")
+	cmd.Flags().Int(srvflags.JSONRPCBlockCacheSize, config.DefaultBlockCacheSize, "Sets the number of finalized blocks cached in memory by the json-rpc backend (0=disabled)")
+	cmd.Flags().Int(srvflags.JSONRPCReceiptCacheSize, config.DefaultReceiptCacheSize, "Sets the number of tx lookups and receipts cached in memory by the json-rpc backend (0=disabled)")
+	cmd.Flags().Float64(srvflags.JSONRPCRateLimit, 0, "Sets the number of requests per second allowed for each json-rpc client (0=unlimited)")
+	cmd.Flags().Int(srvflags.JSONRPCRateLimitBurst, 0, "Sets the number of requests a json-rpc client can send at once on top of the rate limit")
+	cmd.Flags().StringSlice(srvflags.JSONRPCMethodRateLimits, nil, "Sets separate per client budgets for json-rpc methods, eg. eth_getLogs=5:10,debug_*=1")
+	cmd.Flags().Int(srvflags.JSONRPCMaxBatchSize, 0, "Sets the maximum number of requests in a json-rpc batch (0=unlimited)")
+	cmd.Flags().Int(srvflags.JSONRPCMaxResponseSize, 0, "Sets the maximum size in bytes of a json-rpc response (0=unlimited)")
+	cmd.Flags().String(srvflags.JSONRPCIPCPath, "", "Sets the unix domain socket serving the json-rpc apis, relative to the home directory (empty=disabled)")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
 
 	cmd.Flags().String(srvflags.EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
 	cmd.Flags().Uint64(srvflags.EVMMaxTxGasWanted, config.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")                                 //nolint:lll
+	cmd.Flags().Bool(srvflags.EVMAppMempool, config.DefaultAppMempool, "enable the app-side mempool ordering the eth txs by nonce and replacing the pending txs by the ones paying more")
+	cmd.Flags().Int(srvflags.EVMMempoolMaxTxs, config.DefaultMempoolMaxTxs, "the maximum number of txs in the app-side mempool")
+	cmd.Flags().Int(srvflags.EVMMempoolMaxTxsPerAccount, config.DefaultMempoolMaxTxsPerAccount, "the maximum number of pending eth txs of an account in the app-side mempool")
+	cmd.Flags().Int(srvflags.EVMMempoolMaxQueuedTxs, config.DefaultMempoolMaxQueuedTxs, "the maximum number of eth txs queued behind a nonce gap in the app-side mempool")
+	cmd.Flags().Uint64(srvflags.EVMMempoolMaxNonceGap, config.DefaultMempoolMaxNonceGap, "the maximum nonce gap of a queued eth tx in the app-side mempool")
+	cmd.Flags().Duration(srvflags.EVMMempoolQueuedLifetime, config.DefaultMempoolQueuedLifetime, "the maximum time an eth tx stays queued in the app-side mempool (0=unlimited)")
+	cmd.Flags().Uint64(srvflags.EVMMempoolPriceBump, config.DefaultMempoolPriceBump, "the minimum fee increase, in percent, to replace a pending eth tx")
 
 	cmd.Flags().String(srvflags.TLSCertPath, "", "the cert.pem file path for the server TLS configuration")
 	cmd.Flags().String(srvflags.TLSKeyPath, "", "the key.pem file path for the server TLS configuration")
@@ -429,7 +446,11 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 		}
 
 		idxLogger := logger.With("indexer", "evm")
-		idxer = indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
+		idxer = indexer.NewKVIndexer(
+			idxDB, idxLogger, clientCtx,
+			indexer.WithAddressIndex(config.JSONRPC.EnableAddressIndex),
+			indexer.WithLogIndex(config.JSONRPC.EnableLogIndex),
+		)
 		indexerService := NewEVMIndexerService(idxer, clientCtx.Client.(rpcclient.Client), config.JSONRPC.AllowIndexerGap)
 		indexerService.SetLogger(idxLogger)
 
@@ -19,6 +19,7 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmtypes "github.com/cometbft/cometbft/types"
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
 // EVMTxIndexer defines the interface of custom eth tx indexer.
@@ -31,4 +32,14 @@ type EVMTxIndexer interface {
 	GetByTxHash(common.Hash) (*TxResult, error)
 	// GetByBlockAndIndex returns nil if tx not found.
 	GetByBlockAndIndex(int64, int32) (*TxResult, error)
+	// GetByAddress returns the hashes of the txs related to the address in the block range,
+	// with offset and limit for pagination.
+	GetByAddress(address common.Address, fromBlock, toBlock int64, offset, limit int) ([]common.Hash, error)
+	// SearchByAddress returns the hashes of the txs related to the address before or after the block,
+	// paginated by whole blocks.
+	SearchByAddress(address common.Address, blockNumber int64, reverse bool, pageSize int) ([]common.Hash, bool, error)
+	// GetContractCreation returns the hash of the tx which created the contract, nil if not found.
+	GetContractCreation(address common.Address) (*common.Hash, error)
+	// GetLogs returns the logs matching the filter criteria in the block range, at most limit results.
+	GetLogs(fromBlock, toBlock int64, addresses []common.Address, topics [][]common.Hash, limit int) ([]*ethtypes.Log, error)
 }
@@ -0,0 +1,123 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package cli
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	ethermint "github.com/evmos/ethermint/types"
+	"github.com/evmos/ethermint/x/erc20/types"
+)
+
+// GetTxCmd returns the transaction commands for the erc20 module
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "erc20 transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewConvertCoinCmd(),
+		NewConvertERC20Cmd(),
+	)
+	return cmd
+}
+
+// NewConvertCoinCmd returns a CLI command converting coins into ERC20 tokens
+func NewConvertCoinCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert-coin COIN [RECEIVER_HEX]",
+		Short: "Convert coins into the ERC20 tokens of their token pair, the receiver defaults to the sender",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			sender := clientCtx.GetFromAddress()
+			receiver := common.BytesToAddress(sender)
+			if len(args) == 2 {
+				if err := ethermint.ValidateAddress(args[1]); err != nil {
+					return err
+				}
+				receiver = common.HexToAddress(args[1])
+			}
+
+			msg := types.NewMsgConvertCoin(coin, receiver, sender)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewConvertERC20Cmd returns a CLI command converting ERC20 tokens into coins
+func NewConvertERC20Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert-erc20 CONTRACT_ADDRESS AMOUNT [RECEIVER_BECH32]",
+		Short: "Convert ERC20 tokens into the coins of their token pair, the receiver defaults to the sender",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if err := ethermint.ValidateNonZeroAddress(args[0]); err != nil {
+				return err
+			}
+
+			amount, ok := sdkmath.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid amount %s", args[1])
+			}
+
+			sender := clientCtx.GetFromAddress()
+			receiver := sender
+			if len(args) == 3 {
+				receiver, err = sdk.AccAddressFromBech32(args[2])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgConvertERC20(amount, receiver, common.HexToAddress(args[0]), common.BytesToAddress(sender))
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
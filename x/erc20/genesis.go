@@ -0,0 +1,57 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package erc20
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/ethermint/x/erc20/keeper"
+	"github.com/evmos/ethermint/x/erc20/types"
+)
+
+// InitGenesis initializes genesis state based on exported genesis
+func InitGenesis(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	accountKeeper types.AccountKeeper,
+	data types.GenesisState,
+) []abci.ValidatorUpdate {
+	// ensure the module account is set before the EVM creates an account at its address
+	if acc := accountKeeper.GetModuleAccount(ctx, types.ModuleName); acc == nil {
+		panic("the erc20 module account has not been set")
+	}
+
+	err := k.SetParams(ctx, data.Params)
+	if err != nil {
+		panic(errorsmod.Wrap(err, "could not set parameters at genesis"))
+	}
+
+	for _, pair := range data.TokenPairs {
+		k.SetTokenPair(ctx, pair)
+	}
+
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis exports genesis state of the erc20 module
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		Params:     k.GetParams(ctx),
+		TokenPairs: k.GetTokenPairs(ctx),
+	}
+}
@@ -0,0 +1,178 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/ethermint/x/erc20/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// callGasLimit is the gas limit of the calls to the ERC20 contracts, bounding the execution of the
+// external contracts. The limit is capped by the gas remaining on the cosmos gas meter.
+const callGasLimit = 3_000_000
+
+// CallEVM packs the arguments of the contract method and calls the contract.
+func (k Keeper) CallEVM(
+	ctx sdk.Context,
+	contractABI abi.ABI,
+	from, contract common.Address,
+	commit bool,
+	method string,
+	args ...interface{},
+) (*evmtypes.MsgEthereumTxResponse, error) {
+	data, err := contractABI.Pack(method, args...)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrERC20Call, "failed to pack %s: %s", method, err)
+	}
+
+	res, err := k.CallEVMWithData(ctx, from, &contract, data, commit)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "contract %s, method %s", contract, method)
+	}
+	return res, nil
+}
+
+// CallEVMWithData executes the message with the module's gas limit, deploying a contract when
+// the recipient is nil, and consumes the gas used on the gas meter of the context. It fails if the
+// execution fails.
+func (k Keeper) CallEVMWithData(
+	ctx sdk.Context,
+	from common.Address,
+	contract *common.Address,
+	data []byte,
+	commit bool,
+) (*evmtypes.MsgEthereumTxResponse, error) {
+	gasLimit := uint64(callGasLimit)
+	if remaining := ctx.GasMeter().GasRemaining(); gasLimit > remaining {
+		gasLimit = remaining
+	}
+
+	msg := core.Message{
+		From:              from,
+		To:                contract,
+		Nonce:             k.evmKeeper.GetNonce(ctx, from),
+		Value:             big.NewInt(0),
+		GasLimit:          gasLimit,
+		GasPrice:          big.NewInt(0),
+		GasFeeCap:         big.NewInt(0),
+		GasTipCap:         big.NewInt(0),
+		Data:              data,
+		SkipAccountChecks: true,
+	}
+
+	res, err := k.evmKeeper.ApplyMessage(ctx, msg, nil, commit)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.GasMeter().ConsumeGas(res.GasUsed, "erc20 evm call")
+
+	if res.Failed() {
+		vmErr := res.VmError
+		if vmErr == vm.ErrExecutionReverted.Error() {
+			vmErr = evmtypes.NewExecErrorWithReason(res.Ret).Error()
+		}
+		return nil, errorsmod.Wrap(types.ErrERC20Call, vmErr)
+	}
+
+	return res, nil
+}
+
+// BalanceOf returns the token balance of the account, the ERC20 contract being called as
+// the module.
+func (k Keeper) BalanceOf(ctx sdk.Context, contract, account common.Address) (*big.Int, error) {
+	contractABI := types.ERC20WrapperContract.ABI
+	res, err := k.CallEVM(ctx, contractABI, types.ModuleAddress, contract, false, "balanceOf", account)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := contractABI.Unpack("balanceOf", res.Ret)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrERC20Call, "failed to unpack balanceOf: %s", err)
+	}
+	balance, ok := out[0].(*big.Int)
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrERC20Call, "invalid balanceOf result: %v", out[0])
+	}
+	return balance, nil
+}
+
+// QueryERC20 returns the name, the symbol and the decimals of the ERC20 token.
+func (k Keeper) QueryERC20(ctx sdk.Context, contract common.Address) (name, symbol string, decimals uint8, err error) {
+	contractABI := types.ERC20WrapperContract.ABI
+	call := func(method string, result interface{}) error {
+		res, err := k.CallEVM(ctx, contractABI, types.ModuleAddress, contract, false, method)
+		if err != nil {
+			return err
+		}
+		if err := contractABI.UnpackIntoInterface(result, method, res.Ret); err != nil {
+			return errorsmod.Wrapf(types.ErrERC20Call, "failed to unpack %s: %s", method, err)
+		}
+		return nil
+	}
+
+	if err := call("name", &name); err != nil {
+		return "", "", 0, err
+	}
+	if err := call("symbol", &symbol); err != nil {
+		return "", "", 0, err
+	}
+	if err := call("decimals", &decimals); err != nil {
+		return "", "", 0, err
+	}
+	return name, symbol, decimals, nil
+}
+
+// DeployERC20Wrapper deploys the ERC20 wrapper of a native coin from the module address, the
+// decimals of the token being the exponent of the display unit of the coin.
+func (k Keeper) DeployERC20Wrapper(ctx sdk.Context, metadata banktypes.Metadata) (common.Address, error) {
+	var decimals uint8
+	for _, unit := range metadata.DenomUnits {
+		if unit.Denom != metadata.Display {
+			continue
+		}
+		if unit.Exponent > math.MaxUint8 {
+			return common.Address{}, errorsmod.Wrapf(types.ErrInvalidMetadata, "display exponent too large: %d", unit.Exponent)
+		}
+		decimals = uint8(unit.Exponent)
+	}
+
+	code, err := types.ERC20WrapperDeployCode(metadata.Name, metadata.Symbol, decimals)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to build the erc20 wrapper code: %w", err)
+	}
+
+	nonce := k.evmKeeper.GetNonce(ctx, types.ModuleAddress)
+	if _, err := k.CallEVMWithData(ctx, types.ModuleAddress, nil, code, true); err != nil {
+		return common.Address{}, errorsmod.Wrap(err, "failed to deploy the erc20 wrapper")
+	}
+
+	return crypto.CreateAddress(types.ModuleAddress, nonce), nil
+}
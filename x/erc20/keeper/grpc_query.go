@@ -0,0 +1,85 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/evmos/ethermint/x/erc20/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// TokenPairs implements the Query/TokenPairs gRPC method
+func (k Keeper) TokenPairs(c context.Context, req *types.QueryTokenPairsRequest) (*types.QueryTokenPairsResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPair)
+
+	pairs := []types.TokenPair{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var pair types.TokenPair
+		if err := k.cdc.Unmarshal(value, &pair); err != nil {
+			return err
+		}
+		pairs = append(pairs, pair)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTokenPairsResponse{
+		TokenPairs: pairs,
+		Pagination: pageRes,
+	}, nil
+}
+
+// TokenPair implements the Query/TokenPair gRPC method
+func (k Keeper) TokenPair(c context.Context, req *types.QueryTokenPairRequest) (*types.QueryTokenPairResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	pair, found := k.GetTokenPair(ctx, k.GetTokenPairID(ctx, req.Token))
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrTokenPairNotFound, "token %s", req.Token)
+	}
+
+	return &types.QueryTokenPairResponse{
+		TokenPair: pair,
+	}, nil
+}
+
+// Params implements the Query/Params gRPC method
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{
+		Params: params,
+	}, nil
+}
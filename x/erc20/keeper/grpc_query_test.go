@@ -0,0 +1,30 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/ethermint/x/erc20/types"
+)
+
+func (suite *KeeperTestSuite) TestQueryTokenPairs() {
+	k := suite.App.Erc20Keeper
+	ctx := sdk.WrapSDKContext(suite.Ctx)
+	pair := suite.registerCoin(testDenom, 100)
+
+	res, err := k.TokenPairs(ctx, &types.QueryTokenPairsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.TokenPair{pair}, res.TokenPairs)
+
+	for _, token := range []string{testDenom, pair.Erc20Address} {
+		res, err := k.TokenPair(ctx, &types.QueryTokenPairRequest{Token: token})
+		suite.Require().NoError(err)
+		suite.Require().Equal(pair, res.TokenPair)
+	}
+
+	_, err = k.TokenPair(ctx, &types.QueryTokenPairRequest{Token: "aunknown"})
+	suite.Require().ErrorIs(err, types.ErrTokenPairNotFound)
+
+	params, err := k.Params(ctx, &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DefaultParams(), params.Params)
+}
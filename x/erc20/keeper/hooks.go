@@ -0,0 +1,148 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/ethermint/x/erc20/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+var _ evmtypes.EvmHooks = Hooks{}
+
+// Hooks wraps the erc20 keeper to implement the EVM hooks.
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the EVM hooks converting the tokens transferred to the module address.
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// PostTxProcessing implements EvmHooks. The tokens of the enabled token pairs transferred to the
+// module address are converted into coins sent to the token owner: the tokens of a native coin
+// pair are burned and the escrowed coins are released, while the tokens of an ERC20 token pair
+// are kept in escrow and the same amount of coins is minted.
+//
+// The external ERC20 contracts can't be trusted to credit the amount of their Transfer events, so
+// the coins minted are bounded by the tokens escrowed by the module in excess of the coins supply.
+func (h Hooks) PostTxProcessing(ctx sdk.Context, _ core.Message, receipt *ethtypes.Receipt) error {
+	params := h.k.GetParams(ctx)
+	if !params.EnableErc20 || !params.EnableEVMHook {
+		return nil
+	}
+
+	contractABI := types.ERC20WrapperContract.ABI
+	transferEvent := contractABI.Events["Transfer"]
+
+	for _, log := range receipt.Logs {
+		// the Transfer event has the sender and the recipient as indexed topics
+		if len(log.Topics) != 3 || log.Topics[0] != transferEvent.ID {
+			continue
+		}
+
+		from := common.BytesToAddress(log.Topics[1].Bytes())
+		to := common.BytesToAddress(log.Topics[2].Bytes())
+		if to != types.ModuleAddress || from == (common.Address{}) {
+			continue
+		}
+
+		pair, found := h.k.GetTokenPair(ctx, h.k.GetERC20PairID(ctx, log.Address))
+		if !found || !pair.Enabled {
+			continue
+		}
+
+		out, err := transferEvent.Inputs.NonIndexed().Unpack(log.Data)
+		if err != nil || len(out) != 1 {
+			continue
+		}
+		amount, ok := out[0].(*big.Int)
+		if !ok || amount.Sign() == 0 {
+			continue
+		}
+
+		recipient := sdk.AccAddress(from.Bytes())
+		if h.k.bankKeeper.BlockedAddr(recipient) {
+			return errorsmod.Wrapf(errortypes.ErrUnauthorized, "%s is not allowed to receive the coins of %s", recipient, pair.Erc20Address)
+		}
+
+		if pair.IsNativeERC20() {
+			received, err := h.k.escrowedExcess(ctx, pair, log.Address)
+			if err != nil {
+				return err
+			}
+			if received.Sign() <= 0 {
+				continue
+			}
+			if received.Cmp(amount) < 0 {
+				amount = received
+			}
+		}
+
+		coins := sdk.NewCoins(sdk.NewCoin(pair.Denom, sdkmath.NewIntFromBigInt(amount)))
+
+		switch {
+		case pair.IsNativeCoin():
+			if _, err := h.k.CallEVM(ctx, contractABI, types.ModuleAddress, log.Address, true, "burn", types.ModuleAddress, amount); err != nil {
+				return err
+			}
+		case pair.IsNativeERC20():
+			if err := h.k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+				return err
+			}
+		default:
+			continue
+		}
+
+		if err := h.k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, coins); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeConvertERC20,
+				sdk.NewAttribute(sdk.AttributeKeySender, from.String()),
+				sdk.NewAttribute(types.AttributeKeyReceiver, recipient.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+				sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+				sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			),
+		)
+	}
+
+	return nil
+}
+
+// escrowedExcess returns the tokens of the ERC20 token pair escrowed by the module in excess of
+// the supply of the coins, the tokens received which aren't converted yet.
+func (k Keeper) escrowedExcess(ctx sdk.Context, pair types.TokenPair, contract common.Address) (*big.Int, error) {
+	escrowed, err := k.BalanceOf(ctx, contract, types.ModuleAddress)
+	if err != nil {
+		return nil, err
+	}
+	supply := k.bankKeeper.GetSupply(ctx, pair.Denom)
+	return escrowed.Sub(escrowed, supply.Amount.BigInt()), nil
+}
@@ -0,0 +1,141 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/ethermint/x/erc20/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// transferToModule sends an ethereum transaction transferring the tokens to the module address.
+func (suite *KeeperTestSuite) transferToModule(contract common.Address, amount int64) *evmtypes.MsgEthereumTxResponse {
+	data, err := types.ERC20WrapperContract.ABI.Pack("transfer", types.ModuleAddress, big.NewInt(amount))
+	suite.Require().NoError(err)
+
+	k := suite.App.EvmKeeper
+	tx := evmtypes.NewTx(
+		k.ChainID(),
+		k.GetNonce(suite.Ctx, suite.Address),
+		&contract,
+		big.NewInt(0),
+		100_000,
+		big.NewInt(0),
+		nil,
+		nil,
+		data,
+		nil,
+	)
+	tx.From = suite.Address.Bytes()
+	suite.Require().NoError(tx.Sign(ethtypes.LatestSignerForChainID(k.ChainID()), suite.Signer))
+
+	res, err := k.EthereumTx(sdk.WrapSDKContext(suite.Ctx), tx)
+	suite.Require().NoError(err)
+	return res
+}
+
+func (suite *KeeperTestSuite) TestPostTxProcessing() {
+	testCases := []struct {
+		name       string
+		malleate   func() (common.Address, string)
+		expConvert bool
+	}{
+		{
+			"native coin, escrowed coins are released",
+			func() (common.Address, string) {
+				pair := suite.registerCoin(testDenom, 100)
+				_, err := suite.App.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(suite.Ctx), types.NewMsgConvertCoin(sdk.NewInt64Coin(testDenom, 50), suite.Address, suite.accAddress()))
+				suite.Require().NoError(err)
+				return pair.GetERC20Contract(), testDenom
+			},
+			true,
+		},
+		{
+			"external erc20, coins are minted",
+			func() (common.Address, string) {
+				contract := suite.deployExternalERC20(50)
+				res, err := suite.App.Erc20Keeper.RegisterERC20(sdk.WrapSDKContext(suite.Ctx), &types.MsgRegisterERC20{
+					Authority:    suite.authority,
+					Erc20Address: contract.Hex(),
+				})
+				suite.Require().NoError(err)
+				return contract, res.TokenPair.Denom
+			},
+			true,
+		},
+		{
+			"unregistered erc20, tokens are not converted",
+			func() (common.Address, string) {
+				contract := suite.deployExternalERC20(50)
+				return contract, types.CreateDenom(contract)
+			},
+			false,
+		},
+		{
+			"evm hook disabled, tokens are not converted",
+			func() (common.Address, string) {
+				contract := suite.deployExternalERC20(50)
+				res, err := suite.App.Erc20Keeper.RegisterERC20(sdk.WrapSDKContext(suite.Ctx), &types.MsgRegisterERC20{
+					Authority:    suite.authority,
+					Erc20Address: contract.Hex(),
+				})
+				suite.Require().NoError(err)
+				suite.Require().NoError(suite.App.Erc20Keeper.SetParams(suite.Ctx, types.NewParams(true, false)))
+				return contract, res.TokenPair.Denom
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			contract, denom := tc.malleate()
+			before := suite.bankBalance(suite.accAddress(), denom)
+
+			res := suite.transferToModule(contract, 20)
+			suite.Require().False(res.Failed(), res.VmError)
+			suite.Require().Equal(int64(30), suite.balanceOf(contract, suite.Address))
+
+			converted := suite.bankBalance(suite.accAddress(), denom) - before
+			if !tc.expConvert {
+				suite.Require().Zero(converted)
+				suite.Require().Equal(int64(20), suite.balanceOf(contract, types.ModuleAddress))
+				return
+			}
+			suite.Require().Equal(int64(20), converted)
+
+			pair, found := suite.App.Erc20Keeper.GetTokenPair(suite.Ctx, suite.App.Erc20Keeper.GetERC20PairID(suite.Ctx, contract))
+			suite.Require().True(found)
+			if pair.IsNativeCoin() {
+				// the tokens transferred to the module are burned
+				suite.Require().Zero(suite.balanceOf(contract, types.ModuleAddress))
+			} else {
+				suite.Require().Equal(int64(20), suite.balanceOf(contract, types.ModuleAddress))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPostTxProcessingEscrowedExcess() {
+	suite.SetupTest()
+	contract := suite.deployExternalERC20(50)
+	res, err := suite.App.Erc20Keeper.RegisterERC20(sdk.WrapSDKContext(suite.Ctx), &types.MsgRegisterERC20{
+		Authority:    suite.authority,
+		Erc20Address: contract.Hex(),
+	})
+	suite.Require().NoError(err)
+	denom := res.TokenPair.Denom
+
+	// coins not backed by escrowed tokens reduce the amount converted
+	unbacked := sdk.NewCoins(sdk.NewInt64Coin(denom, 5))
+	suite.Require().NoError(suite.App.BankKeeper.MintCoins(suite.Ctx, types.ModuleName, unbacked))
+
+	tx := suite.transferToModule(contract, 20)
+	suite.Require().False(tx.Failed(), tx.VmError)
+	suite.Require().Equal(int64(15), suite.bankBalance(suite.accAddress(), denom))
+	suite.Require().Equal(int64(20), suite.balanceOf(contract, types.ModuleAddress))
+}
@@ -0,0 +1,73 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/ethermint/x/erc20/types"
+)
+
+// Keeper grants access to the erc20 module state.
+type Keeper struct {
+	// Protobuf codec
+	cdc codec.BinaryCodec
+	// Store key required for the erc20 KVStore.
+	storeKey storetypes.StoreKey
+	// the address capable of executing the governance messages. Typically, this should be the x/gov module account.
+	authority sdk.AccAddress
+
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+	evmKeeper     types.EVMKeeper
+}
+
+// NewKeeper generates new erc20 module keeper
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey storetypes.StoreKey,
+	authority sdk.AccAddress,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	evmKeeper types.EVMKeeper,
+) Keeper {
+	// ensure authority account is correctly formatted
+	if err := sdk.VerifyAddressFormat(authority); err != nil {
+		panic(err)
+	}
+
+	// ensure the module account is set
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic("the erc20 module account has not been set")
+	}
+
+	return Keeper{
+		cdc:           cdc,
+		storeKey:      storeKey,
+		authority:     authority,
+		accountKeeper: ak,
+		bankKeeper:    bk,
+		evmKeeper:     evmKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
@@ -0,0 +1,272 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/ethermint/testutil"
+	"github.com/evmos/ethermint/x/erc20/types"
+)
+
+const testDenom = "atest"
+
+type KeeperTestSuite struct {
+	testutil.BaseTestSuiteWithAccount
+	authority string
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.BaseTestSuiteWithAccount.SetupTest(suite.T())
+	suite.authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+}
+
+func (suite *KeeperTestSuite) accAddress() sdk.AccAddress {
+	return sdk.AccAddress(suite.Address.Bytes())
+}
+
+func testMetadata(denom string) banktypes.Metadata {
+	return banktypes.Metadata{
+		Description: "Test coin",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: denom, Exponent: 0},
+			{Denom: denom[1:], Exponent: 18},
+		},
+		Base:    denom,
+		Display: denom[1:],
+		Name:    "Test Coin",
+		Symbol:  "TEST",
+	}
+}
+
+// registerCoin funds the test account with the coin and registers its token pair.
+func (suite *KeeperTestSuite) registerCoin(denom string, amount int64) types.TokenPair {
+	coins := sdk.NewCoins(sdk.NewInt64Coin(denom, amount))
+	suite.Require().NoError(testutil.FundAccount(suite.App.BankKeeper, suite.Ctx, suite.accAddress(), coins))
+
+	res, err := suite.App.Erc20Keeper.RegisterCoin(sdk.WrapSDKContext(suite.Ctx), &types.MsgRegisterCoin{
+		Authority: suite.authority,
+		Metadata:  testMetadata(denom),
+	})
+	suite.Require().NoError(err)
+	return res.TokenPair
+}
+
+// deployExternalERC20 deploys an ERC20 contract owned by the test account, minting the amount
+// to the test account.
+func (suite *KeeperTestSuite) deployExternalERC20(amount int64) common.Address {
+	k := suite.App.Erc20Keeper
+	code, err := types.ERC20WrapperDeployCode("External Token", "EXT", 6)
+	suite.Require().NoError(err)
+
+	nonce := suite.App.EvmKeeper.GetNonce(suite.Ctx, suite.Address)
+	_, err = k.CallEVMWithData(suite.Ctx, suite.Address, nil, code, true)
+	suite.Require().NoError(err)
+	contract := crypto.CreateAddress(suite.Address, nonce)
+
+	_, err = k.CallEVM(suite.Ctx, types.ERC20WrapperContract.ABI, suite.Address, contract, true, "mint", suite.Address, big.NewInt(amount))
+	suite.Require().NoError(err)
+	return contract
+}
+
+func (suite *KeeperTestSuite) balanceOf(contract, account common.Address) int64 {
+	balance, err := suite.App.Erc20Keeper.BalanceOf(suite.Ctx, contract, account)
+	suite.Require().NoError(err)
+	return balance.Int64()
+}
+
+func (suite *KeeperTestSuite) bankBalance(addr sdk.AccAddress, denom string) int64 {
+	return suite.App.BankKeeper.GetBalance(suite.Ctx, addr, denom).Amount.Int64()
+}
+
+func (suite *KeeperTestSuite) TestRegisterCoin() {
+	k := suite.App.Erc20Keeper
+	pair := suite.registerCoin(testDenom, 100)
+
+	suite.Require().Equal(testDenom, pair.Denom)
+	suite.Require().True(pair.Enabled)
+	suite.Require().Equal(types.OWNER_MODULE, pair.ContractOwner)
+	suite.Require().True(k.IsDenomRegistered(suite.Ctx, testDenom))
+	suite.Require().True(k.IsERC20Registered(suite.Ctx, pair.GetERC20Contract()))
+
+	name, symbol, decimals, err := k.QueryERC20(suite.Ctx, pair.GetERC20Contract())
+	suite.Require().NoError(err)
+	suite.Require().Equal("Test Coin", name)
+	suite.Require().Equal("TEST", symbol)
+	suite.Require().Equal(uint8(18), decimals)
+
+	// the pair can't be registered twice
+	_, err = k.RegisterCoin(sdk.WrapSDKContext(suite.Ctx), &types.MsgRegisterCoin{
+		Authority: suite.authority,
+		Metadata:  testMetadata(testDenom),
+	})
+	suite.Require().ErrorIs(err, types.ErrTokenPairAlreadyExists)
+
+	// the coin must have a supply
+	_, err = k.RegisterCoin(sdk.WrapSDKContext(suite.Ctx), &types.MsgRegisterCoin{
+		Authority: suite.authority,
+		Metadata:  testMetadata("anosupply"),
+	})
+	suite.Require().ErrorIs(err, types.ErrInvalidMetadata)
+
+	// only the governance can register a pair
+	_, err = k.RegisterCoin(sdk.WrapSDKContext(suite.Ctx), &types.MsgRegisterCoin{
+		Authority: suite.accAddress().String(),
+		Metadata:  testMetadata(testDenom),
+	})
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+}
+
+func (suite *KeeperTestSuite) TestERC20Wrapper() {
+	k := suite.App.Erc20Keeper
+	contractABI := types.ERC20WrapperContract.ABI
+	pair := suite.registerCoin(testDenom, 100)
+	contract := pair.GetERC20Contract()
+	other := common.BytesToAddress([]byte("other"))
+
+	// only the module can mint and burn the tokens
+	_, err := k.CallEVM(suite.Ctx, contractABI, suite.Address, contract, true, "mint", suite.Address, big.NewInt(10))
+	suite.Require().ErrorIs(err, types.ErrERC20Call)
+	_, err = k.CallEVM(suite.Ctx, contractABI, types.ModuleAddress, contract, true, "mint", suite.Address, big.NewInt(10))
+	suite.Require().NoError(err)
+
+	res, err := k.CallEVM(suite.Ctx, contractABI, suite.Address, contract, false, "owner")
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.ModuleAddress, common.BytesToAddress(res.Ret))
+
+	// transfers
+	_, err = k.CallEVM(suite.Ctx, contractABI, suite.Address, contract, true, "transfer", other, big.NewInt(4))
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(6), suite.balanceOf(contract, suite.Address))
+	suite.Require().Equal(int64(4), suite.balanceOf(contract, other))
+	_, err = k.CallEVM(suite.Ctx, contractABI, suite.Address, contract, true, "transfer", other, big.NewInt(7))
+	suite.Require().ErrorIs(err, types.ErrERC20Call)
+
+	// allowances
+	_, err = k.CallEVM(suite.Ctx, contractABI, other, contract, true, "transferFrom", suite.Address, other, big.NewInt(1))
+	suite.Require().ErrorIs(err, types.ErrERC20Call)
+	_, err = k.CallEVM(suite.Ctx, contractABI, suite.Address, contract, true, "approve", other, big.NewInt(3))
+	suite.Require().NoError(err)
+	_, err = k.CallEVM(suite.Ctx, contractABI, other, contract, true, "transferFrom", suite.Address, other, big.NewInt(2))
+	suite.Require().NoError(err)
+	res, err = k.CallEVM(suite.Ctx, contractABI, other, contract, false, "allowance", suite.Address, other)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(1), new(big.Int).SetBytes(res.Ret).Int64())
+	suite.Require().Equal(int64(4), suite.balanceOf(contract, suite.Address))
+	suite.Require().Equal(int64(6), suite.balanceOf(contract, other))
+
+	// burns
+	_, err = k.CallEVM(suite.Ctx, contractABI, suite.Address, contract, true, "burn", other, big.NewInt(1))
+	suite.Require().ErrorIs(err, types.ErrERC20Call)
+	_, err = k.CallEVM(suite.Ctx, contractABI, types.ModuleAddress, contract, true, "burn", other, big.NewInt(6))
+	suite.Require().NoError(err)
+	res, err = k.CallEVM(suite.Ctx, contractABI, other, contract, false, "totalSupply")
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(4), new(big.Int).SetBytes(res.Ret).Int64())
+}
+
+func (suite *KeeperTestSuite) TestConvertNativeCoin() {
+	k := suite.App.Erc20Keeper
+	pair := suite.registerCoin(testDenom, 100)
+	contract := pair.GetERC20Contract()
+	moduleAcc := types.ModuleAddress.Bytes()
+
+	_, err := k.ConvertCoin(sdk.WrapSDKContext(suite.Ctx), types.NewMsgConvertCoin(sdk.NewInt64Coin(testDenom, 30), suite.Address, suite.accAddress()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(70), suite.bankBalance(suite.accAddress(), testDenom))
+	suite.Require().Equal(int64(30), suite.bankBalance(moduleAcc, testDenom))
+	suite.Require().Equal(int64(30), suite.balanceOf(contract, suite.Address))
+
+	_, err = k.ConvertERC20(sdk.WrapSDKContext(suite.Ctx), types.NewMsgConvertERC20(sdkmath.NewInt(10), suite.accAddress(), contract, suite.Address))
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(80), suite.bankBalance(suite.accAddress(), testDenom))
+	suite.Require().Equal(int64(20), suite.bankBalance(moduleAcc, testDenom))
+	suite.Require().Equal(int64(20), suite.balanceOf(contract, suite.Address))
+
+	// the tokens can't exceed the balance
+	_, err = k.ConvertERC20(sdk.WrapSDKContext(suite.Ctx), types.NewMsgConvertERC20(sdkmath.NewInt(21), suite.accAddress(), contract, suite.Address))
+	suite.Require().ErrorIs(err, types.ErrERC20Call)
+
+	// disabled pairs can't be converted
+	_, err = k.ToggleConversion(sdk.WrapSDKContext(suite.Ctx), &types.MsgToggleConversion{Authority: suite.authority, Token: testDenom})
+	suite.Require().NoError(err)
+	_, err = k.ConvertCoin(sdk.WrapSDKContext(suite.Ctx), types.NewMsgConvertCoin(sdk.NewInt64Coin(testDenom, 1), suite.Address, suite.accAddress()))
+	suite.Require().ErrorIs(err, types.ErrTokenPairDisabled)
+}
+
+func (suite *KeeperTestSuite) TestConvertExternalERC20() {
+	k := suite.App.Erc20Keeper
+	contract := suite.deployExternalERC20(100)
+
+	res, err := k.RegisterERC20(sdk.WrapSDKContext(suite.Ctx), &types.MsgRegisterERC20{
+		Authority:    suite.authority,
+		Erc20Address: contract.Hex(),
+	})
+	suite.Require().NoError(err)
+	pair := res.TokenPair
+	suite.Require().Equal(types.CreateDenom(contract), pair.Denom)
+	suite.Require().Equal(types.OWNER_EXTERNAL, pair.ContractOwner)
+
+	metadata, found := suite.App.BankKeeper.GetDenomMetaData(suite.Ctx, pair.Denom)
+	suite.Require().True(found)
+	suite.Require().Equal("External Token", metadata.Name)
+	suite.Require().Equal("EXT", metadata.Symbol)
+
+	_, err = k.ConvertERC20(sdk.WrapSDKContext(suite.Ctx), types.NewMsgConvertERC20(sdkmath.NewInt(40), suite.accAddress(), contract, suite.Address))
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(60), suite.balanceOf(contract, suite.Address))
+	suite.Require().Equal(int64(40), suite.balanceOf(contract, types.ModuleAddress))
+	suite.Require().Equal(int64(40), suite.bankBalance(suite.accAddress(), pair.Denom))
+
+	_, err = k.ConvertCoin(sdk.WrapSDKContext(suite.Ctx), types.NewMsgConvertCoin(sdk.NewInt64Coin(pair.Denom, 15), suite.Address, suite.accAddress()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(75), suite.balanceOf(contract, suite.Address))
+	suite.Require().Equal(int64(25), suite.balanceOf(contract, types.ModuleAddress))
+	suite.Require().Equal(int64(25), suite.bankBalance(suite.accAddress(), pair.Denom))
+	suite.Require().True(suite.App.BankKeeper.GetSupply(suite.Ctx, pair.Denom).Amount.Equal(sdkmath.NewInt(25)))
+
+	// the contract can't be registered twice
+	_, err = k.RegisterERC20(sdk.WrapSDKContext(suite.Ctx), &types.MsgRegisterERC20{
+		Authority:    suite.authority,
+		Erc20Address: contract.Hex(),
+	})
+	suite.Require().ErrorIs(err, types.ErrTokenPairAlreadyExists)
+}
+
+func (suite *KeeperTestSuite) TestConversionsDisabled() {
+	k := suite.App.Erc20Keeper
+	suite.registerCoin(testDenom, 100)
+
+	_, err := k.UpdateParams(sdk.WrapSDKContext(suite.Ctx), &types.MsgUpdateParams{
+		Authority: suite.authority,
+		Params:    types.NewParams(false, true),
+	})
+	suite.Require().NoError(err)
+
+	_, err = k.ConvertCoin(sdk.WrapSDKContext(suite.Ctx), types.NewMsgConvertCoin(sdk.NewInt64Coin(testDenom, 1), suite.Address, suite.accAddress()))
+	suite.Require().ErrorIs(err, types.ErrERC20Disabled)
+}
+
+func (suite *KeeperTestSuite) TestCallEVMGas() {
+	pair := suite.registerCoin(testDenom, 100)
+
+	// the gas used is consumed on the gas meter
+	ctx := suite.Ctx.WithGasMeter(sdk.NewGasMeter(1_000_000))
+	res, err := suite.App.Erc20Keeper.CallEVM(ctx, types.ERC20WrapperContract.ABI, suite.Address, pair.GetERC20Contract(), false, "balanceOf", suite.Address)
+	suite.Require().NoError(err)
+	suite.Require().NotZero(res.GasUsed)
+	suite.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed(), res.GasUsed)
+}
@@ -0,0 +1,243 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"context"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/ethermint/x/erc20/types"
+)
+
+var _ types.MsgServer = Keeper{}
+
+// ConvertCoin implements the gRPC MsgServer interface. The coins of a native coin pair are escrowed
+// and the same amount of tokens is minted, while the coins of an ERC20 token pair are burned and
+// the escrowed tokens are transferred to the receiver.
+func (k Keeper) ConvertCoin(goCtx context.Context, msg *types.MsgConvertCoin) (*types.MsgConvertCoinResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pair, err := k.getConversionPair(ctx, msg.Coin.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid sender address")
+	}
+	receiver := common.HexToAddress(msg.Receiver)
+	coins := sdk.NewCoins(msg.Coin)
+	amount := msg.Coin.Amount.BigInt()
+
+	if err := k.bankKeeper.IsSendEnabledCoins(ctx, msg.Coin); err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, coins); err != nil {
+		return nil, err
+	}
+
+	contract := pair.GetERC20Contract()
+	contractABI := types.ERC20WrapperContract.ABI
+
+	switch {
+	case pair.IsNativeCoin():
+		if _, err := k.CallEVM(ctx, contractABI, types.ModuleAddress, contract, true, "mint", receiver, amount); err != nil {
+			return nil, err
+		}
+	case pair.IsNativeERC20():
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+			return nil, err
+		}
+		if err := k.transferERC20(ctx, contract, types.ModuleAddress, receiver, amount); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, types.ErrUndefinedOwner
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConvertCoin,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Coin.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, msg.Coin.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+		),
+	)
+
+	return &types.MsgConvertCoinResponse{}, nil
+}
+
+// ConvertERC20 implements the gRPC MsgServer interface. The tokens of a native coin pair are burned
+// and the escrowed coins are sent to the receiver, while the tokens of an ERC20 token pair are
+// escrowed and the same amount of coins is minted.
+func (k Keeper) ConvertERC20(goCtx context.Context, msg *types.MsgConvertERC20) (*types.MsgConvertERC20Response, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pair, err := k.getConversionPair(ctx, msg.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	sender := common.HexToAddress(msg.Sender)
+	receiver, err := sdk.AccAddressFromBech32(msg.Receiver)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid receiver address")
+	}
+	coin := sdk.NewCoin(pair.Denom, msg.Amount)
+	coins := sdk.NewCoins(coin)
+	amount := msg.Amount.BigInt()
+
+	if err := k.bankKeeper.IsSendEnabledCoins(ctx, coin); err != nil {
+		return nil, err
+	}
+
+	contract := pair.GetERC20Contract()
+	contractABI := types.ERC20WrapperContract.ABI
+
+	switch {
+	case pair.IsNativeCoin():
+		if _, err := k.CallEVM(ctx, contractABI, types.ModuleAddress, contract, true, "burn", sender, amount); err != nil {
+			return nil, err
+		}
+	case pair.IsNativeERC20():
+		if err := k.transferERC20(ctx, contract, sender, types.ModuleAddress, amount); err != nil {
+			return nil, err
+		}
+		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, types.ErrUndefinedOwner
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, coins); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConvertERC20,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+		),
+	)
+
+	return &types.MsgConvertERC20Response{}, nil
+}
+
+// transferERC20 transfers the tokens of an external ERC20 contract, checking the balance of the
+// recipient increased by the amount, as the contracts can't be trusted to implement the transfers
+// as expected.
+func (k Keeper) transferERC20(ctx sdk.Context, contract, from, to common.Address, amount *big.Int) error {
+	before, err := k.BalanceOf(ctx, contract, to)
+	if err != nil {
+		return err
+	}
+
+	if _, err := k.CallEVM(ctx, types.ERC20WrapperContract.ABI, from, contract, true, "transfer", to, amount); err != nil {
+		return err
+	}
+
+	after, err := k.BalanceOf(ctx, contract, to)
+	if err != nil {
+		return err
+	}
+
+	if received := new(big.Int).Sub(after, before); received.Cmp(amount) != 0 {
+		return errorsmod.Wrapf(types.ErrBalanceInvariance, "%s received %s tokens instead of %s", to, received, amount)
+	}
+	return nil
+}
+
+// RegisterCoin implements the gRPC MsgServer interface. When a RegisterCoin
+// proposal passes, it registers the token pair of the native coin.
+func (k Keeper) RegisterCoin(goCtx context.Context, req *types.MsgRegisterCoin) (*types.MsgRegisterCoinResponse, error) {
+	if err := k.checkAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	pair, err := k.registerCoin(sdk.UnwrapSDKContext(goCtx), req.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterCoinResponse{TokenPair: pair}, nil
+}
+
+// RegisterERC20 implements the gRPC MsgServer interface. When a RegisterERC20
+// proposal passes, it registers the token pair of the ERC20 contract.
+func (k Keeper) RegisterERC20(goCtx context.Context, req *types.MsgRegisterERC20) (*types.MsgRegisterERC20Response, error) {
+	if err := k.checkAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	pair, err := k.registerERC20(sdk.UnwrapSDKContext(goCtx), common.HexToAddress(req.Erc20Address))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterERC20Response{TokenPair: pair}, nil
+}
+
+// ToggleConversion implements the gRPC MsgServer interface. When a ToggleConversion
+// proposal passes, it enables or disables the conversions of the token pair.
+func (k Keeper) ToggleConversion(goCtx context.Context, req *types.MsgToggleConversion) (*types.MsgToggleConversionResponse, error) {
+	if err := k.checkAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	if _, err := k.toggleConversion(sdk.UnwrapSDKContext(goCtx), req.Token); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgToggleConversionResponse{}, nil
+}
+
+// UpdateParams implements the gRPC MsgServer interface. When an UpdateParams
+// proposal passes, it updates the module parameters. The update can only be
+// performed if the requested authority is the Cosmos SDK governance module
+// account.
+func (k Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if err := k.checkAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+func (k Keeper) checkAuthority(authority string) error {
+	if k.authority.String() != authority {
+		return errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), authority)
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/ethermint/x/erc20/types"
+)
+
+// registerCoin registers a token pair for a native coin, deploying its ERC20 wrapper owned by
+// the module. The coin must have a supply, and its metadata is stored in the bank module if it
+// has none.
+func (k Keeper) registerCoin(ctx sdk.Context, metadata banktypes.Metadata) (types.TokenPair, error) {
+	if !k.GetParams(ctx).EnableErc20 {
+		return types.TokenPair{}, types.ErrERC20Disabled
+	}
+
+	denom := metadata.Base
+	if types.IsERC20Denom(denom) {
+		return types.TokenPair{}, errorsmod.Wrapf(types.ErrInvalidMetadata, "denom cannot have the %s prefix: %s", types.ERC20DenomPrefix, denom)
+	}
+
+	if k.IsDenomRegistered(ctx, denom) {
+		return types.TokenPair{}, errorsmod.Wrapf(types.ErrTokenPairAlreadyExists, "denom %s", denom)
+	}
+
+	if !k.bankKeeper.HasSupply(ctx, denom) {
+		return types.TokenPair{}, errorsmod.Wrapf(types.ErrInvalidMetadata, "denom %s has no supply", denom)
+	}
+
+	if existing, found := k.bankKeeper.GetDenomMetaData(ctx, denom); found {
+		if !proto.Equal(&existing, &metadata) {
+			return types.TokenPair{}, errorsmod.Wrapf(types.ErrInvalidMetadata, "metadata differs from the registered one of %s", denom)
+		}
+	} else {
+		k.bankKeeper.SetDenomMetaData(ctx, metadata)
+	}
+
+	address, err := k.DeployERC20Wrapper(ctx, metadata)
+	if err != nil {
+		return types.TokenPair{}, err
+	}
+
+	pair := types.NewTokenPair(address, denom, types.OWNER_MODULE)
+	k.SetTokenPair(ctx, pair)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterCoin,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+		),
+	)
+
+	return pair, nil
+}
+
+// registerERC20 registers a token pair for an ERC20 contract, the coins of the token having
+// the erc20/ prefixed address of the contract as denom.
+func (k Keeper) registerERC20(ctx sdk.Context, contract common.Address) (types.TokenPair, error) {
+	if !k.GetParams(ctx).EnableErc20 {
+		return types.TokenPair{}, types.ErrERC20Disabled
+	}
+
+	if k.IsERC20Registered(ctx, contract) {
+		return types.TokenPair{}, errorsmod.Wrapf(types.ErrTokenPairAlreadyExists, "erc20 contract %s", contract)
+	}
+
+	denom := types.CreateDenom(contract)
+	if k.IsDenomRegistered(ctx, denom) {
+		return types.TokenPair{}, errorsmod.Wrapf(types.ErrTokenPairAlreadyExists, "denom %s", denom)
+	}
+
+	// the decimals are only queried to ensure the contract implements the metadata extension
+	name, symbol, _, err := k.QueryERC20(ctx, contract)
+	if err != nil {
+		return types.TokenPair{}, err
+	}
+
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, denom); !found {
+		metadata := banktypes.Metadata{
+			Description: "Coins of the ERC20 token " + contract.String(),
+			DenomUnits:  []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}},
+			Base:        denom,
+			Display:     denom,
+			Name:        name,
+			Symbol:      symbol,
+		}
+		if err := metadata.Validate(); err != nil {
+			return types.TokenPair{}, errorsmod.Wrapf(types.ErrInvalidMetadata, "erc20 token %s: %s", contract, err)
+		}
+		k.bankKeeper.SetDenomMetaData(ctx, metadata)
+	}
+
+	pair := types.NewTokenPair(contract, denom, types.OWNER_EXTERNAL)
+	k.SetTokenPair(ctx, pair)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterERC20,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+		),
+	)
+
+	return pair, nil
+}
+
+// toggleConversion enables or disables the conversions of the token pair of a token.
+func (k Keeper) toggleConversion(ctx sdk.Context, token string) (types.TokenPair, error) {
+	pair, found := k.GetTokenPair(ctx, k.GetTokenPairID(ctx, token))
+	if !found {
+		return types.TokenPair{}, errorsmod.Wrapf(types.ErrTokenPairNotFound, "token %s", token)
+	}
+
+	pair.Enabled = !pair.Enabled
+	k.SetTokenPair(ctx, pair)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeToggleConversion,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyEnabled, fmt.Sprint(pair.Enabled)),
+		),
+	)
+
+	return pair, nil
+}
+
+// getConversionPair returns the enabled token pair of the token, failing if the conversions
+// are disabled.
+func (k Keeper) getConversionPair(ctx sdk.Context, token string) (types.TokenPair, error) {
+	if !k.GetParams(ctx).EnableErc20 {
+		return types.TokenPair{}, types.ErrERC20Disabled
+	}
+
+	pair, found := k.GetTokenPair(ctx, k.GetTokenPairID(ctx, token))
+	if !found {
+		return types.TokenPair{}, errorsmod.Wrapf(types.ErrTokenPairNotFound, "token %s", token)
+	}
+
+	if !pair.Enabled {
+		return types.TokenPair{}, errorsmod.Wrapf(types.ErrTokenPairDisabled, "token %s", token)
+	}
+
+	return pair, nil
+}
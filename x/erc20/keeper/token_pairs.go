@@ -0,0 +1,93 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/ethermint/x/erc20/types"
+)
+
+// GetTokenPairs returns all the registered token pairs.
+func (k Keeper) GetTokenPairs(ctx sdk.Context) []types.TokenPair {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPair)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	pairs := []types.TokenPair{}
+	for ; iterator.Valid(); iterator.Next() {
+		var pair types.TokenPair
+		k.cdc.MustUnmarshal(iterator.Value(), &pair)
+		pairs = append(pairs, pair)
+	}
+	return pairs
+}
+
+// GetTokenPair returns the token pair of the id.
+func (k Keeper) GetTokenPair(ctx sdk.Context, id []byte) (types.TokenPair, bool) {
+	if len(id) == 0 {
+		return types.TokenPair{}, false
+	}
+
+	bz := ctx.KVStore(k.storeKey).Get(types.TokenPairKey(id))
+	if len(bz) == 0 {
+		return types.TokenPair{}, false
+	}
+
+	var pair types.TokenPair
+	k.cdc.MustUnmarshal(bz, &pair)
+	return pair, true
+}
+
+// SetTokenPair stores the token pair and indexes it by its ERC20 contract and its denom.
+func (k Keeper) SetTokenPair(ctx sdk.Context, pair types.TokenPair) {
+	store := ctx.KVStore(k.storeKey)
+	id := pair.GetID()
+	store.Set(types.TokenPairKey(id), k.cdc.MustMarshal(&pair))
+	store.Set(types.TokenPairByERC20Key(pair.GetERC20Contract()), id)
+	store.Set(types.TokenPairByDenomKey(pair.Denom), id)
+}
+
+// GetTokenPairID returns the id of the token pair of a token, which is either the hex address
+// of an ERC20 contract or a denom. It returns nil if the token is not registered.
+func (k Keeper) GetTokenPairID(ctx sdk.Context, token string) []byte {
+	if common.IsHexAddress(token) {
+		return k.GetERC20PairID(ctx, common.HexToAddress(token))
+	}
+	return k.GetDenomPairID(ctx, token)
+}
+
+// GetERC20PairID returns the id of the token pair of an ERC20 contract.
+func (k Keeper) GetERC20PairID(ctx sdk.Context, address common.Address) []byte {
+	return ctx.KVStore(k.storeKey).Get(types.TokenPairByERC20Key(address))
+}
+
+// GetDenomPairID returns the id of the token pair of a denom.
+func (k Keeper) GetDenomPairID(ctx sdk.Context, denom string) []byte {
+	return ctx.KVStore(k.storeKey).Get(types.TokenPairByDenomKey(denom))
+}
+
+// IsERC20Registered returns true if the ERC20 contract is registered in a token pair.
+func (k Keeper) IsERC20Registered(ctx sdk.Context, address common.Address) bool {
+	return ctx.KVStore(k.storeKey).Has(types.TokenPairByERC20Key(address))
+}
+
+// IsDenomRegistered returns true if the denom is registered in a token pair.
+func (k Keeper) IsDenomRegistered(ctx sdk.Context, denom string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.TokenPairByDenomKey(denom))
+}
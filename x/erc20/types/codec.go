@@ -0,0 +1,70 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+var (
+	amino = codec.NewLegacyAmino()
+	// ModuleCdc references the global erc20 module codec. Note, the codec should
+	// ONLY be used in certain instances of tests and for JSON encoding.
+	ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	// AminoCdc is a amino codec created to support amino JSON compatible msgs.
+	AminoCdc = codec.NewAminoCodec(amino)
+)
+
+const (
+	// Amino names
+	convertCoinName      = "ethermint/erc20/MsgConvertCoin"
+	convertERC20Name     = "ethermint/erc20/MsgConvertERC20"
+	registerCoinName     = "ethermint/erc20/MsgRegisterCoin"
+	registerERC20Name    = "ethermint/erc20/MsgRegisterERC20"
+	toggleConversionName = "ethermint/erc20/MsgToggleConversion"
+	updateParamsName     = "ethermint/erc20/MsgUpdateParams"
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	amino.Seal()
+}
+
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgConvertCoin{},
+		&MsgConvertERC20{},
+		&MsgRegisterCoin{},
+		&MsgRegisterERC20{},
+		&MsgToggleConversion{},
+		&MsgUpdateParams{},
+	)
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgConvertCoin{}, convertCoinName, nil)
+	cdc.RegisterConcrete(&MsgConvertERC20{}, convertERC20Name, nil)
+	cdc.RegisterConcrete(&MsgRegisterCoin{}, registerCoinName, nil)
+	cdc.RegisterConcrete(&MsgRegisterERC20{}, registerERC20Name, nil)
+	cdc.RegisterConcrete(&MsgToggleConversion{}, toggleConversionName, nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+}
@@ -0,0 +1,57 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	// embed the compiled ERC20 wrapper
+	_ "embed"
+	"encoding/json"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+var (
+	//go:embed contracts/ERC20Wrapper.json
+	erc20WrapperJSON []byte
+
+	// ERC20WrapperContract is the ERC20 contract deployed by the module for the native coins,
+	// the owner being allowed to mint and burn the tokens.
+	ERC20WrapperContract evmtypes.CompiledContract
+)
+
+func init() {
+	err := json.Unmarshal(erc20WrapperJSON, &ERC20WrapperContract)
+	if err != nil {
+		panic(err)
+	}
+
+	if len(ERC20WrapperContract.Bin) == 0 {
+		panic("load contract failed")
+	}
+}
+
+// ERC20WrapperDeployCode returns the creation code of the ERC20 wrapper of a native coin, the
+// token metadata being passed as the constructor arguments.
+func ERC20WrapperDeployCode(name, symbol string, decimals uint8) ([]byte, error) {
+	args, err := ERC20WrapperContract.ABI.Pack("", name, symbol, decimals)
+	if err != nil {
+		return nil, err
+	}
+
+	code := make([]byte, 0, len(ERC20WrapperContract.Bin)+len(args))
+	code = append(code, ERC20WrapperContract.Bin...)
+	return append(code, args...), nil
+}
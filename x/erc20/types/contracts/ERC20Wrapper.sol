@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity ^0.8.10;
+
+// ERC20Wrapper is the ERC20 token deployed by the erc20 module for the native coins, the module
+// owning the contract being the only account allowed to mint and burn the tokens.
+//
+// The token follows the @openzeppelin/contracts v4.8 ERC20 and Ownable implementations, the
+// storage layout being the same: balances (0), allowances (1), total supply (2), name (3),
+// symbol (4) and owner (5).
+contract ERC20Wrapper {
+    mapping(address => uint256) private _balances;
+    mapping(address => mapping(address => uint256)) private _allowances;
+    uint256 private _totalSupply;
+    string private _name;
+    string private _symbol;
+    address private _owner;
+
+    uint8 private immutable _decimals;
+
+    event Transfer(address indexed from, address indexed to, uint256 value);
+    event Approval(address indexed owner, address indexed spender, uint256 value);
+
+    modifier onlyOwner() {
+        require(msg.sender == _owner, "Ownable: caller is not the owner");
+        _;
+    }
+
+    constructor(string memory name_, string memory symbol_, uint8 decimals_) {
+        _name = name_;
+        _symbol = symbol_;
+        _decimals = decimals_;
+        _owner = msg.sender;
+    }
+
+    function owner() public view returns (address) {
+        return _owner;
+    }
+
+    function name() public view returns (string memory) {
+        return _name;
+    }
+
+    function symbol() public view returns (string memory) {
+        return _symbol;
+    }
+
+    function decimals() public view returns (uint8) {
+        return _decimals;
+    }
+
+    function totalSupply() public view returns (uint256) {
+        return _totalSupply;
+    }
+
+    function balanceOf(address account) public view returns (uint256) {
+        return _balances[account];
+    }
+
+    function allowance(address owner_, address spender) public view returns (uint256) {
+        return _allowances[owner_][spender];
+    }
+
+    function transfer(address to, uint256 amount) public returns (bool) {
+        _transfer(msg.sender, to, amount);
+        return true;
+    }
+
+    function approve(address spender, uint256 amount) public returns (bool) {
+        _approve(msg.sender, spender, amount);
+        return true;
+    }
+
+    function transferFrom(address from, address to, uint256 amount) public returns (bool) {
+        uint256 currentAllowance = _allowances[from][msg.sender];
+        if (currentAllowance != type(uint256).max) {
+            require(currentAllowance >= amount, "ERC20: insufficient allowance");
+            unchecked {
+                _approve(from, msg.sender, currentAllowance - amount);
+            }
+        }
+        _transfer(from, to, amount);
+        return true;
+    }
+
+    function mint(address to, uint256 amount) public onlyOwner {
+        require(to != address(0), "ERC20: mint to the zero address");
+        _totalSupply += amount;
+        unchecked {
+            _balances[to] += amount;
+        }
+        emit Transfer(address(0), to, amount);
+    }
+
+    function burn(address from, uint256 amount) public onlyOwner {
+        require(from != address(0), "ERC20: burn from the zero address");
+        uint256 balance = _balances[from];
+        require(balance >= amount, "ERC20: burn amount exceeds balance");
+        unchecked {
+            _balances[from] = balance - amount;
+            _totalSupply -= amount;
+        }
+        emit Transfer(from, address(0), amount);
+    }
+
+    function _transfer(address from, address to, uint256 amount) internal {
+        require(from != address(0), "ERC20: transfer from the zero address");
+        require(to != address(0), "ERC20: transfer to the zero address");
+        uint256 balance = _balances[from];
+        require(balance >= amount, "ERC20: transfer amount exceeds balance");
+        unchecked {
+            _balances[from] = balance - amount;
+            _balances[to] += amount;
+        }
+        emit Transfer(from, to, amount);
+    }
+
+    function _approve(address owner_, address spender, uint256 amount) internal {
+        require(owner_ != address(0), "ERC20: approve from the zero address");
+        require(spender != address(0), "ERC20: approve to the zero address");
+        _allowances[owner_][spender] = amount;
+        emit Approval(owner_, spender, amount);
+    }
+}
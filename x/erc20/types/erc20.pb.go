@@ -0,0 +1,733 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ethermint/erc20/v1/erc20.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Owner enumerates the owners of the ERC20 contract of a token pair.
+type Owner int32
+
+const (
+	// OWNER_UNSPECIFIED defines an invalid owner.
+	OWNER_UNSPECIFIED Owner = 0
+	// OWNER_MODULE defines a native coin whose ERC20 wrapper is deployed and owned by the module.
+	OWNER_MODULE Owner = 1
+	// OWNER_EXTERNAL defines an ERC20 token deployed outside of the module.
+	OWNER_EXTERNAL Owner = 2
+)
+
+var Owner_name = map[int32]string{
+	0: "OWNER_UNSPECIFIED",
+	1: "OWNER_MODULE",
+	2: "OWNER_EXTERNAL",
+}
+
+var Owner_value = map[string]int32{
+	"OWNER_UNSPECIFIED": 0,
+	"OWNER_MODULE":      1,
+	"OWNER_EXTERNAL":    2,
+}
+
+func (x Owner) String() string {
+	return proto.EnumName(Owner_name, int32(x))
+}
+
+func (Owner) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_038a52a4564e16dc, []int{0}
+}
+
+// TokenPair links a bank denom to an ERC20 contract, allowing to convert one into the other.
+type TokenPair struct {
+	// erc20_address is the hex address of the ERC20 contract.
+	Erc20Address string `protobuf:"bytes,1,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	// denom is the bank denom of the coin.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// enabled defines whether the conversions are enabled.
+	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// contract_owner is the owner of the ERC20 contract.
+	ContractOwner Owner `protobuf:"varint,4,opt,name=contract_owner,json=contractOwner,proto3,enum=ethermint.erc20.v1.Owner" json:"contract_owner,omitempty"`
+}
+
+func (m *TokenPair) Reset()         { *m = TokenPair{} }
+func (m *TokenPair) String() string { return proto.CompactTextString(m) }
+func (*TokenPair) ProtoMessage()    {}
+func (*TokenPair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_038a52a4564e16dc, []int{0}
+}
+func (m *TokenPair) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenPair.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenPair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenPair.Merge(m, src)
+}
+func (m *TokenPair) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenPair) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenPair.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenPair proto.InternalMessageInfo
+
+func (m *TokenPair) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
+	}
+	return ""
+}
+
+func (m *TokenPair) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *TokenPair) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *TokenPair) GetContractOwner() Owner {
+	if m != nil {
+		return m.ContractOwner
+	}
+	return OWNER_UNSPECIFIED
+}
+
+// Params defines the parameters of the erc20 module.
+type Params struct {
+	// enable_erc20 toggles the conversions between coins and ERC20 tokens.
+	EnableErc20 bool `protobuf:"varint,1,opt,name=enable_erc20,json=enableErc20,proto3" json:"enable_erc20,omitempty"`
+	// enable_evm_hook toggles the conversion of the ERC20 tokens transferred to the module address
+	// by ethereum transactions.
+	EnableEVMHook bool `protobuf:"varint,2,opt,name=enable_evm_hook,json=enableEvmHook,proto3" json:"enable_evm_hook,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_038a52a4564e16dc, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetEnableErc20() bool {
+	if m != nil {
+		return m.EnableErc20
+	}
+	return false
+}
+
+func (m *Params) GetEnableEVMHook() bool {
+	if m != nil {
+		return m.EnableEVMHook
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterEnum("ethermint.erc20.v1.Owner", Owner_name, Owner_value)
+	proto.RegisterType((*TokenPair)(nil), "ethermint.erc20.v1.TokenPair")
+	proto.RegisterType((*Params)(nil), "ethermint.erc20.v1.Params")
+}
+
+func init() { proto.RegisterFile("ethermint/erc20/v1/erc20.proto", fileDescriptor_038a52a4564e16dc) }
+
+var fileDescriptor_038a52a4564e16dc = []byte{
+	// 386 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0x4f, 0x8f, 0xd2, 0x40,
+	0x18, 0xc6, 0x3b, 0x08, 0x08, 0x23, 0xc5, 0x32, 0xc1, 0xa4, 0x72, 0x18, 0x10, 0x2f, 0x8d, 0x87,
+	0x56, 0xf0, 0xa4, 0x27, 0x41, 0xc6, 0x88, 0xe1, 0x5f, 0x2a, 0xa8, 0xf1, 0xd2, 0x94, 0x76, 0x04,
+	0x82, 0xed, 0x90, 0x69, 0xad, 0xfa, 0x0d, 0x3c, 0xfa, 0x11, 0x4c, 0xbc, 0xf8, 0x51, 0xf6, 0xc8,
+	0x71, 0x4f, 0x9b, 0x4d, 0xb9, 0xec, 0xc7, 0xd8, 0x74, 0x06, 0x76, 0x0f, 0x7b, 0x7b, 0x9f, 0xdf,
+	0xfb, 0xbc, 0x33, 0x4f, 0xf2, 0x40, 0x4c, 0xe3, 0x35, 0xe5, 0xc1, 0x26, 0x8c, 0x2d, 0xca, 0xbd,
+	0xee, 0x73, 0x2b, 0xe9, 0xc8, 0xc1, 0xdc, 0x71, 0x16, 0x33, 0x84, 0x6e, 0xf6, 0xa6, 0xc4, 0x49,
+	0xa7, 0x51, 0x5f, 0xb1, 0x15, 0x13, 0x6b, 0x2b, 0x9b, 0xa4, 0xb3, 0xfd, 0x1f, 0xc0, 0xf2, 0x9c,
+	0x6d, 0x69, 0x38, 0x73, 0x37, 0x1c, 0x3d, 0x85, 0xaa, 0xf0, 0x3b, 0xae, 0xef, 0x73, 0x1a, 0x45,
+	0x3a, 0x68, 0x01, 0xa3, 0x6c, 0x57, 0x04, 0xec, 0x49, 0x86, 0xea, 0xb0, 0xe0, 0xd3, 0x90, 0x05,
+	0x7a, 0x4e, 0x2c, 0xa5, 0x40, 0x3a, 0xbc, 0x4f, 0x43, 0x77, 0xf9, 0x8d, 0xfa, 0xfa, 0xbd, 0x16,
+	0x30, 0x4a, 0xf6, 0x49, 0xa2, 0xd7, 0xb0, 0xea, 0xb1, 0x30, 0xe6, 0xae, 0x17, 0x3b, 0xec, 0x47,
+	0x48, 0xb9, 0x9e, 0x6f, 0x01, 0xa3, 0xda, 0x7d, 0x6c, 0xde, 0x4d, 0x69, 0x4e, 0x33, 0x83, 0xad,
+	0x9e, 0x0e, 0x84, 0x7c, 0x95, 0xbf, 0xfa, 0xdb, 0x04, 0xed, 0xaf, 0xb0, 0x38, 0x73, 0xb9, 0x1b,
+	0x44, 0xe8, 0x09, 0xac, 0xc8, 0xc7, 0x1d, 0x71, 0x27, 0x52, 0x96, 0xec, 0x07, 0x92, 0x91, 0x0c,
+	0xa1, 0x97, 0xf0, 0xe1, 0xc9, 0x92, 0x04, 0xce, 0x9a, 0xb1, 0xad, 0x88, 0x5b, 0xea, 0xd7, 0xd2,
+	0x8b, 0xa6, 0x4a, 0xa4, 0xf3, 0xe3, 0xf8, 0x1d, 0x63, 0x5b, 0x5b, 0x3d, 0x1e, 0x26, 0x41, 0x26,
+	0x9f, 0xbd, 0x87, 0x05, 0xf1, 0x2d, 0x7a, 0x04, 0x6b, 0xd3, 0x4f, 0x13, 0x62, 0x3b, 0x8b, 0xc9,
+	0x87, 0x19, 0x79, 0x33, 0x7c, 0x3b, 0x24, 0x03, 0x4d, 0x41, 0x1a, 0xac, 0x48, 0x3c, 0x9e, 0x0e,
+	0x16, 0x23, 0xa2, 0x01, 0x84, 0x60, 0x55, 0x12, 0xf2, 0x79, 0x4e, 0xec, 0x49, 0x6f, 0xa4, 0xe5,
+	0x1a, 0xf9, 0xdf, 0xff, 0xb0, 0xd2, 0xef, 0x9f, 0xa5, 0x18, 0xec, 0x53, 0x0c, 0x2e, 0x53, 0x0c,
+	0xfe, 0x1c, 0xb0, 0xb2, 0x3f, 0x60, 0xe5, 0xfc, 0x80, 0x95, 0x2f, 0xc6, 0x6a, 0x13, 0xaf, 0xbf,
+	0x2f, 0x4d, 0x8f, 0x05, 0x16, 0x4d, 0x02, 0x16, 0x59, 0xb7, 0x9d, 0xfe, 0x3c, 0xb6, 0x1a, 0xff,
+	0xda, 0xd1, 0x68, 0x59, 0x14, 0x4d, 0xbd, 0xb8, 0x1e, 0x00, 0x7d, 0x99, 0x54, 0x80, 0xf5, 0x01,
+	0x00, 0x00,
+}
+
+func (this *TokenPair) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TokenPair)
+	if !ok {
+		that2, ok := that.(TokenPair)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Erc20Address != that1.Erc20Address {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	if this.ContractOwner != that1.ContractOwner {
+		return false
+	}
+	return true
+}
+func (m *TokenPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContractOwner != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.ContractOwner))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EnableEVMHook {
+		i--
+		if m.EnableEVMHook {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EnableErc20 {
+		i--
+		if m.EnableErc20 {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintErc20(dAtA []byte, offset int, v uint64) int {
+	offset -= sovErc20(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TokenPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	if m.ContractOwner != 0 {
+		n += 1 + sovErc20(uint64(m.ContractOwner))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EnableErc20 {
+		n += 2
+	}
+	if m.EnableEVMHook {
+		n += 2
+	}
+	return n
+}
+
+func sovErc20(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozErc20(x uint64) (n int) {
+	return sovErc20(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TokenPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractOwner", wireType)
+			}
+			m.ContractOwner = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractOwner |= Owner(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableErc20", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableErc20 = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableEVMHook", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableEVMHook = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipErc20(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthErc20
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupErc20
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthErc20
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthErc20        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowErc20          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupErc20 = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,61 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+const (
+	codeErrERC20Disabled = uint32(iota) + 2 // NOTE: code 1 is reserved for internal errors
+	codeErrEVMHookDisabled
+	codeErrTokenPairNotFound
+	codeErrTokenPairAlreadyExists
+	codeErrTokenPairDisabled
+	codeErrInvalidMetadata
+	codeErrUndefinedOwner
+	codeErrBalanceInvariance
+	codeErrERC20Call
+)
+
+var (
+	// ErrERC20Disabled returns an error if the conversions are disabled.
+	ErrERC20Disabled = errorsmod.Register(ModuleName, codeErrERC20Disabled, "erc20 conversions are disabled")
+
+	// ErrEVMHookDisabled returns an error if the conversions on the module transfers are disabled.
+	ErrEVMHookDisabled = errorsmod.Register(ModuleName, codeErrEVMHookDisabled, "erc20 evm hook is disabled")
+
+	// ErrTokenPairNotFound returns an error if the token pair of a token is not registered.
+	ErrTokenPairNotFound = errorsmod.Register(ModuleName, codeErrTokenPairNotFound, "token pair not found")
+
+	// ErrTokenPairAlreadyExists returns an error if the token is already registered in a token pair.
+	ErrTokenPairAlreadyExists = errorsmod.Register(ModuleName, codeErrTokenPairAlreadyExists, "token pair already exists")
+
+	// ErrTokenPairDisabled returns an error if the conversions of a token pair are disabled.
+	ErrTokenPairDisabled = errorsmod.Register(ModuleName, codeErrTokenPairDisabled, "token pair conversions are disabled")
+
+	// ErrInvalidMetadata returns an error if the bank metadata of a coin can't be registered.
+	ErrInvalidMetadata = errorsmod.Register(ModuleName, codeErrInvalidMetadata, "invalid coin metadata")
+
+	// ErrUndefinedOwner returns an error if the owner of a token pair contract is unspecified.
+	ErrUndefinedOwner = errorsmod.Register(ModuleName, codeErrUndefinedOwner, "undefined owner of the contract")
+
+	// ErrBalanceInvariance returns an error if an ERC20 transfer didn't move the expected amount.
+	ErrBalanceInvariance = errorsmod.Register(ModuleName, codeErrBalanceInvariance, "unexpected balance change")
+
+	// ErrERC20Call returns an error if a call to an ERC20 contract failed.
+	ErrERC20Call = errorsmod.Register(ModuleName, codeErrERC20Call, "erc20 contract call failed")
+)
@@ -0,0 +1,62 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DefaultGenesisState sets default erc20 genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params:     DefaultParams(),
+		TokenPairs: []TokenPair{},
+	}
+}
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, pairs []TokenPair) *GenesisState {
+	return &GenesisState{
+		Params:     params,
+		TokenPairs: pairs,
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seenERC20 := make(map[string]bool)
+	seenDenom := make(map[string]bool)
+
+	for _, pair := range gs.TokenPairs {
+		if err := pair.Validate(); err != nil {
+			return err
+		}
+
+		address := strings.ToLower(pair.Erc20Address)
+		if seenERC20[address] {
+			return fmt.Errorf("duplicated token pair of the erc20 contract %s", pair.Erc20Address)
+		}
+		if seenDenom[pair.Denom] {
+			return fmt.Errorf("duplicated token pair of the denom %s", pair.Denom)
+		}
+		seenERC20[address] = true
+		seenDenom[pair.Denom] = true
+	}
+
+	return gs.Params.Validate()
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisValidate(t *testing.T) {
+	contract := common.HexToAddress("0x5a443704dd4B594B382c22a083e2BD3090A6feF3")
+	wrapper := common.HexToAddress("0xd567B3d7B8FE3C79a1AD8dA978812cfC4Fa05e75")
+
+	testCases := []struct {
+		name     string
+		genState *GenesisState
+		expPass  bool
+	}{
+		{"default", DefaultGenesisState(), true},
+		{
+			"valid pairs",
+			NewGenesisState(DefaultParams(), []TokenPair{
+				NewTokenPair(contract, CreateDenom(contract), OWNER_EXTERNAL),
+				NewTokenPair(wrapper, "atest", OWNER_MODULE),
+			}),
+			true,
+		},
+		{
+			"duplicated erc20 contract",
+			NewGenesisState(DefaultParams(), []TokenPair{
+				NewTokenPair(wrapper, "atest", OWNER_MODULE),
+				NewTokenPair(wrapper, "aother", OWNER_MODULE),
+			}),
+			false,
+		},
+		{
+			"duplicated denom",
+			NewGenesisState(DefaultParams(), []TokenPair{
+				NewTokenPair(contract, "atest", OWNER_MODULE),
+				NewTokenPair(wrapper, "atest", OWNER_MODULE),
+			}),
+			false,
+		},
+		{
+			"invalid pair",
+			NewGenesisState(DefaultParams(), []TokenPair{
+				NewTokenPair(wrapper, "atest", OWNER_UNSPECIFIED),
+			}),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// ModuleName string name of module
+	ModuleName = "erc20"
+
+	// StoreKey key for the token pairs and the module parameters
+	StoreKey = ModuleName
+
+	// RouterKey uses module name for routing
+	RouterKey = ModuleName
+)
+
+// ModuleAddress is the ethereum address of the module account, escrowing the coins and the tokens
+// of the token pairs, and owning the ERC20 wrappers of the native coins.
+var ModuleAddress = common.BytesToAddress(authtypes.NewModuleAddress(ModuleName))
+
+const (
+	prefixTokenPair = iota + 1
+	prefixTokenPairByERC20
+	prefixTokenPairByDenom
+	prefixParams
+)
+
+var (
+	KeyPrefixTokenPair        = []byte{prefixTokenPair}
+	KeyPrefixTokenPairByERC20 = []byte{prefixTokenPairByERC20}
+	KeyPrefixTokenPairByDenom = []byte{prefixTokenPairByDenom}
+	ParamsKey                 = []byte{prefixParams}
+)
+
+// TokenPairKey returns the key of a token pair.
+func TokenPairKey(id []byte) []byte {
+	return append(KeyPrefixTokenPair, id...)
+}
+
+// TokenPairByERC20Key returns the key of the token pair id of an ERC20 contract.
+func TokenPairByERC20Key(address common.Address) []byte {
+	return append(KeyPrefixTokenPairByERC20, address.Bytes()...)
+}
+
+// TokenPairByDenomKey returns the key of the token pair id of a denom.
+func TokenPairByDenomKey(denom string) []byte {
+	return append(KeyPrefixTokenPairByDenom, []byte(denom)...)
+}
+
+// TokenPairID returns the id of the token pair of an ERC20 contract and a denom.
+func TokenPairID(address common.Address, denom string) []byte {
+	return crypto.Keccak256(address.Bytes(), []byte(denom))
+}
@@ -0,0 +1,193 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	ethermint "github.com/evmos/ethermint/types"
+)
+
+var (
+	_ sdk.Msg = &MsgConvertCoin{}
+	_ sdk.Msg = &MsgConvertERC20{}
+	_ sdk.Msg = &MsgRegisterCoin{}
+	_ sdk.Msg = &MsgRegisterERC20{}
+	_ sdk.Msg = &MsgToggleConversion{}
+	_ sdk.Msg = &MsgUpdateParams{}
+)
+
+// NewMsgConvertCoin creates a new MsgConvertCoin instance
+func NewMsgConvertCoin(coin sdk.Coin, receiver common.Address, sender sdk.AccAddress) *MsgConvertCoin {
+	return &MsgConvertCoin{
+		Coin:     coin,
+		Receiver: receiver.Hex(),
+		Sender:   sender.String(),
+	}
+}
+
+func (m *MsgConvertCoin) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+func (m *MsgConvertCoin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	if err := ethermint.ValidateAddress(m.Receiver); err != nil {
+		return errorsmod.Wrap(err, "invalid receiver address")
+	}
+
+	if !m.Coin.IsValid() || !m.Coin.IsPositive() {
+		return errorsmod.Wrapf(errortypes.ErrInvalidCoins, "invalid coin: %s", m.Coin)
+	}
+
+	return nil
+}
+
+func (m MsgConvertCoin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// NewMsgConvertERC20 creates a new MsgConvertERC20 instance
+func NewMsgConvertERC20(amount sdkmath.Int, receiver sdk.AccAddress, contract, sender common.Address) *MsgConvertERC20 {
+	return &MsgConvertERC20{
+		ContractAddress: contract.Hex(),
+		Amount:          amount,
+		Receiver:        receiver.String(),
+		Sender:          sender.Hex(),
+	}
+}
+
+func (m *MsgConvertERC20) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{common.HexToAddress(m.Sender).Bytes()}
+}
+
+func (m *MsgConvertERC20) ValidateBasic() error {
+	if err := ethermint.ValidateAddress(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Receiver); err != nil {
+		return errorsmod.Wrap(err, "invalid receiver address")
+	}
+
+	if err := ethermint.ValidateNonZeroAddress(m.ContractAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid contract address")
+	}
+
+	if m.Amount.IsNil() || !m.Amount.IsPositive() {
+		return errorsmod.Wrapf(errortypes.ErrInvalidCoins, "invalid amount: %s", m.Amount)
+	}
+
+	return nil
+}
+
+func (m MsgConvertERC20) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+func (m *MsgRegisterCoin) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+func (m *MsgRegisterCoin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if err := m.Metadata.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidMetadata, err.Error())
+	}
+
+	if IsERC20Denom(m.Metadata.Base) {
+		return errorsmod.Wrapf(ErrInvalidMetadata, "denom cannot have the %s prefix: %s", ERC20DenomPrefix, m.Metadata.Base)
+	}
+
+	return nil
+}
+
+func (m MsgRegisterCoin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+func (m *MsgRegisterERC20) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+func (m *MsgRegisterERC20) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if err := ethermint.ValidateNonZeroAddress(m.Erc20Address); err != nil {
+		return errorsmod.Wrap(err, "invalid erc20 address")
+	}
+
+	return nil
+}
+
+func (m MsgRegisterERC20) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+func (m *MsgToggleConversion) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+func (m *MsgToggleConversion) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if err := ethermint.ValidateAddress(m.Token); err != nil {
+		if err := sdk.ValidateDenom(m.Token); err != nil {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid token, neither an erc20 address nor a denom: %s", m.Token)
+		}
+	}
+
+	return nil
+}
+
+func (m MsgToggleConversion) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return m.Params.Validate()
+}
+
+func (m MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
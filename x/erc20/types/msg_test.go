@@ -0,0 +1,130 @@
+package types
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type MsgsTestSuite struct {
+	suite.Suite
+	authority string
+	addr      common.Address
+}
+
+func TestMsgsTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgsTestSuite))
+}
+
+func (suite *MsgsTestSuite) SetupTest() {
+	suite.authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	suite.addr = common.HexToAddress("0x5a443704dd4B594B382c22a083e2BD3090A6feF3")
+}
+
+func (suite *MsgsTestSuite) TestMsgConvertCoinValidateBasic() {
+	sender := sdk.AccAddress(suite.addr.Bytes())
+	testCases := []struct {
+		name    string
+		msg     *MsgConvertCoin
+		expPass bool
+	}{
+		{"pass - valid msg", NewMsgConvertCoin(sdk.NewInt64Coin("atest", 1), suite.addr, sender), true},
+		{"fail - zero amount", NewMsgConvertCoin(sdk.NewInt64Coin("atest", 0), suite.addr, sender), false},
+		{"fail - invalid receiver", &MsgConvertCoin{Coin: sdk.NewInt64Coin("atest", 1), Receiver: "invalid", Sender: sender.String()}, false},
+		{"fail - invalid sender", &MsgConvertCoin{Coin: sdk.NewInt64Coin("atest", 1), Receiver: suite.addr.Hex(), Sender: "invalid"}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgConvertERC20ValidateBasic() {
+	receiver := sdk.AccAddress(suite.addr.Bytes())
+	testCases := []struct {
+		name    string
+		msg     *MsgConvertERC20
+		expPass bool
+	}{
+		{"pass - valid msg", NewMsgConvertERC20(sdkmath.NewInt(1), receiver, suite.addr, suite.addr), true},
+		{"fail - zero amount", NewMsgConvertERC20(sdkmath.ZeroInt(), receiver, suite.addr, suite.addr), false},
+		{"fail - zero contract", NewMsgConvertERC20(sdkmath.NewInt(1), receiver, common.Address{}, suite.addr), false},
+		{"fail - invalid sender", &MsgConvertERC20{ContractAddress: suite.addr.Hex(), Amount: sdkmath.NewInt(1), Receiver: receiver.String(), Sender: "invalid"}, false},
+		{"fail - invalid receiver", &MsgConvertERC20{ContractAddress: suite.addr.Hex(), Amount: sdkmath.NewInt(1), Receiver: "invalid", Sender: suite.addr.Hex()}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgRegisterCoinValidateBasic() {
+	metadata := banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "atest", Exponent: 0}, {Denom: "test", Exponent: 18}},
+		Base:       "atest",
+		Display:    "test",
+		Name:       "Test Coin",
+		Symbol:     "TEST",
+	}
+	erc20Metadata := metadata
+	erc20Metadata.Base = CreateDenom(suite.addr)
+	erc20Metadata.DenomUnits = []*banktypes.DenomUnit{{Denom: erc20Metadata.Base, Exponent: 0}, {Denom: "test", Exponent: 18}}
+
+	testCases := []struct {
+		name    string
+		msg     *MsgRegisterCoin
+		expPass bool
+	}{
+		{"pass - valid msg", &MsgRegisterCoin{Authority: suite.authority, Metadata: metadata}, true},
+		{"fail - invalid authority", &MsgRegisterCoin{Authority: "invalid", Metadata: metadata}, false},
+		{"fail - invalid metadata", &MsgRegisterCoin{Authority: suite.authority, Metadata: banktypes.Metadata{Base: "atest"}}, false},
+		{"fail - erc20 denom", &MsgRegisterCoin{Authority: suite.authority, Metadata: erc20Metadata}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgToggleConversionValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *MsgToggleConversion
+		expPass bool
+	}{
+		{"pass - erc20 address", &MsgToggleConversion{Authority: suite.authority, Token: suite.addr.Hex()}, true},
+		{"pass - denom", &MsgToggleConversion{Authority: suite.authority, Token: "atest"}, true},
+		{"fail - invalid token", &MsgToggleConversion{Authority: suite.authority, Token: "1"}, false},
+		{"fail - invalid authority", &MsgToggleConversion{Authority: "invalid", Token: "atest"}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
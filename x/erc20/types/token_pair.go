@@ -0,0 +1,95 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	ethermint "github.com/evmos/ethermint/types"
+)
+
+// ERC20DenomPrefix is the prefix of the denoms of the coins minted for the external ERC20 tokens.
+const ERC20DenomPrefix = "erc20/"
+
+// NewTokenPair returns an enabled token pair.
+func NewTokenPair(address common.Address, denom string, owner Owner) TokenPair {
+	return TokenPair{
+		Erc20Address:  address.String(),
+		Denom:         denom,
+		Enabled:       true,
+		ContractOwner: owner,
+	}
+}
+
+// CreateDenom returns the denom of the coins of an external ERC20 token.
+func CreateDenom(address common.Address) string {
+	return ERC20DenomPrefix + address.String()
+}
+
+// IsERC20Denom returns true if the denom is the one of an external ERC20 token.
+func IsERC20Denom(denom string) bool {
+	return strings.HasPrefix(denom, ERC20DenomPrefix)
+}
+
+// GetID returns the id of the token pair.
+func (tp TokenPair) GetID() []byte {
+	return TokenPairID(tp.GetERC20Contract(), tp.Denom)
+}
+
+// GetERC20Contract returns the address of the ERC20 contract.
+func (tp TokenPair) GetERC20Contract() common.Address {
+	return common.HexToAddress(tp.Erc20Address)
+}
+
+// IsNativeCoin returns true if the ERC20 contract is the wrapper deployed by the module for a
+// native coin.
+func (tp TokenPair) IsNativeCoin() bool {
+	return tp.ContractOwner == OWNER_MODULE
+}
+
+// IsNativeERC20 returns true if the ERC20 contract was deployed outside of the module.
+func (tp TokenPair) IsNativeERC20() bool {
+	return tp.ContractOwner == OWNER_EXTERNAL
+}
+
+// Validate performs a stateless validation of the token pair.
+func (tp TokenPair) Validate() error {
+	if err := sdk.ValidateDenom(tp.Denom); err != nil {
+		return err
+	}
+
+	if err := ethermint.ValidateNonZeroAddress(tp.Erc20Address); err != nil {
+		return err
+	}
+
+	switch tp.ContractOwner {
+	case OWNER_MODULE:
+		if IsERC20Denom(tp.Denom) {
+			return fmt.Errorf("native coin denom cannot have the %s prefix: %s", ERC20DenomPrefix, tp.Denom)
+		}
+	case OWNER_EXTERNAL:
+		if tp.Denom != CreateDenom(tp.GetERC20Contract()) {
+			return fmt.Errorf("invalid denom of the erc20 token %s: %s", tp.Erc20Address, tp.Denom)
+		}
+	default:
+		return ErrUndefinedOwner
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenPairValidate(t *testing.T) {
+	contract := common.HexToAddress("0x5a443704dd4B594B382c22a083e2BD3090A6feF3")
+
+	testCases := []struct {
+		name    string
+		pair    TokenPair
+		expPass bool
+	}{
+		{"native coin", NewTokenPair(contract, "atest", OWNER_MODULE), true},
+		{"external erc20", NewTokenPair(contract, CreateDenom(contract), OWNER_EXTERNAL), true},
+		{"unspecified owner", NewTokenPair(contract, "atest", OWNER_UNSPECIFIED), false},
+		{"invalid denom", NewTokenPair(contract, "1test", OWNER_MODULE), false},
+		{"zero address", NewTokenPair(common.Address{}, "atest", OWNER_MODULE), false},
+		{"native coin with erc20 denom", NewTokenPair(contract, CreateDenom(contract), OWNER_MODULE), false},
+		{"external erc20 with other denom", NewTokenPair(contract, "atest", OWNER_EXTERNAL), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.pair.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestERC20WrapperDeployCode(t *testing.T) {
+	code, err := ERC20WrapperDeployCode("Test Coin", "TEST", 18)
+	require.NoError(t, err)
+	require.Equal(t, []byte(ERC20WrapperContract.Bin), code[:len(ERC20WrapperContract.Bin)])
+
+	args, err := ERC20WrapperContract.ABI.Constructor.Inputs.Unpack(code[len(ERC20WrapperContract.Bin):])
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{"Test Coin", "TEST", uint8(18)}, args)
+}
@@ -83,6 +83,9 @@ func InitGenesis(
 		}
 	}
 
+	// the chains started from the genesis track the EVM state root from the first block
+	k.SetStateRoot(ctx, common.Hash{})
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -464,7 +464,7 @@ func (suite *HandlerTestSuite) TestERC20TransferReverted() {
 		suite.Run(tc.msg, func() {
 			suite.SetupTest()
 			k := suite.App.EvmKeeper
-			k.SetHooks(tc.hooks)
+			k.CleanHooks().SetHooks(tc.hooks)
 
 			// add some fund to pay gas fee
 			k.SetBalance(suite.Ctx, suite.Address, big.NewInt(1000000000000000))
@@ -553,7 +553,7 @@ func (suite *HandlerTestSuite) TestContractDeploymentRevert() {
 			k := suite.App.EvmKeeper
 
 			// test with different hooks scenarios
-			k.SetHooks(tc.hooks)
+			k.CleanHooks().SetHooks(tc.hooks)
 
 			nonce := k.GetNonce(suite.Ctx, suite.Address)
 			ctorArgs, err := types.ERC20Contract.ABI.Pack("", suite.Address, big.NewInt(0))
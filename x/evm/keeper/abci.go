@@ -29,8 +29,8 @@ func (k *Keeper) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 }
 
 // EndBlock also retrieves the bloom filter value from the transient store and commits it to the
-// KVStore. The EVM end block logic doesn't update the validator set, thus it returns
-// an empty slice.
+// KVStore, the same goes for the EVM state root reached by the transactions of the block once enabled.
+// The EVM end block logic doesn't update the validator set, thus it returns an empty slice.
 func (k *Keeper) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
 	// Gas costs are handled within msg handler so costs should be ignored
 	infCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
@@ -38,5 +38,13 @@ func (k *Keeper) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.Valida
 	bloom := ethtypes.BytesToBloom(k.GetBlockBloomTransient(infCtx).Bytes())
 	k.EmitBlockBloomEvent(infCtx, bloom)
 
+	if k.IsStateRootEnabled(infCtx) {
+		root := k.GetStateRootTransient(infCtx)
+		if root != k.GetStateRoot(infCtx) {
+			k.SetStateRoot(infCtx, root)
+		}
+		k.EmitBlockStateRootEvent(infCtx, root)
+	}
+
 	return []abci.ValidatorUpdate{}
 }
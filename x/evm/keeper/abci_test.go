@@ -25,7 +25,23 @@ func (suite *ABCITestSuite) TestInitGenesis() {
 	res := suite.App.EvmKeeper.EndBlock(suite.Ctx, types.RequestEndBlock{})
 	suite.Require().Equal([]types.ValidatorUpdate{}, res)
 
-	// should emit 1 EventTypeBlockBloom event on EndBlock
+	// should emit 1 EventTypeBlockBloom and 1 EventTypeBlockStateRoot event on EndBlock
+	suite.Require().Equal(2, len(em.Events()))
+	suite.Require().Equal(evmtypes.EventTypeBlockBloom, em.Events()[0].Type)
+	suite.Require().Equal(evmtypes.EventTypeBlockStateRoot, em.Events()[1].Type)
+}
+
+func (suite *ABCITestSuite) TestEndBlockStateRootDisabled() {
+	suite.SetupTest()
+	// the chains upgraded from a version without the state root don't track it until the upgrade
+	suite.Ctx.KVStore(suite.App.GetKey(evmtypes.StoreKey)).Delete(evmtypes.KeyPrefixStateRoot)
+	suite.Require().False(suite.App.EvmKeeper.IsStateRootEnabled(suite.Ctx))
+
+	em := suite.Ctx.EventManager()
+	suite.App.EvmKeeper.EndBlock(suite.Ctx, types.RequestEndBlock{})
+
+	// should only emit 1 EventTypeBlockBloom event on EndBlock
 	suite.Require().Equal(1, len(em.Events()))
 	suite.Require().Equal(evmtypes.EventTypeBlockBloom, em.Events()[0].Type)
+	suite.Require().False(suite.App.EvmKeeper.IsStateRootEnabled(suite.Ctx))
 }
@@ -0,0 +1,182 @@
+package keeper_test
+
+import (
+	"math/big"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestEVMCallAuthorization() {
+	var (
+		contract  common.Address
+		granter   sdk.AccAddress
+		grantee   sdk.AccAddress
+		recipient common.Address
+	)
+
+	supply := sdkmath.NewIntWithDecimal(1000, 18).BigInt()
+	amount := big.NewInt(100)
+	transferSelector := types.ERC20Contract.ABI.Methods["transfer"].ID
+
+	grant := func(authorization authz.Authorization, expiration time.Time) {
+		err := suite.App.AuthzKeeper.SaveGrant(suite.Ctx, grantee, granter, authorization, &expiration)
+		suite.Require().NoError(err)
+	}
+	transfer := func(value int64) *types.MsgEVMCall {
+		data, err := types.ERC20Contract.ABI.Pack("transfer", recipient, amount)
+		suite.Require().NoError(err)
+		return types.NewMsgEVMCall(granter, contract, data, sdkmath.NewInt(value), 100000)
+	}
+	balanceOf := func(addr common.Address) *big.Int {
+		data, err := types.ERC20Contract.ABI.Pack("balanceOf", addr)
+		suite.Require().NoError(err)
+		res, err := suite.App.EvmKeeper.EVMCall(sdk.WrapSDKContext(suite.Ctx), types.NewMsgEVMCall(granter, contract, data, sdkmath.ZeroInt(), 100000))
+		suite.Require().NoError(err)
+		out, err := types.ERC20Contract.ABI.Unpack("balanceOf", res.Ret)
+		suite.Require().NoError(err)
+		return out[0].(*big.Int)
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func() *types.MsgEVMCall
+		expPass  bool
+	}{
+		{
+			"no grant",
+			func() *types.MsgEVMCall {
+				return transfer(0)
+			},
+			false,
+		},
+		{
+			"allowed contract and selector",
+			func() *types.MsgEVMCall {
+				grant(types.NewEVMCallAuthorization([]common.Address{contract}, [][]byte{transferSelector}, sdkmath.ZeroInt()), suite.Ctx.BlockTime().Add(time.Hour))
+				return transfer(0)
+			},
+			true,
+		},
+		{
+			"any selector",
+			func() *types.MsgEVMCall {
+				grant(types.NewEVMCallAuthorization([]common.Address{contract}, nil, sdkmath.ZeroInt()), suite.Ctx.BlockTime().Add(time.Hour))
+				return transfer(0)
+			},
+			true,
+		},
+		{
+			"contract not allowed",
+			func() *types.MsgEVMCall {
+				grant(types.NewEVMCallAuthorization([]common.Address{tests.GenerateAddress()}, nil, sdkmath.ZeroInt()), suite.Ctx.BlockTime().Add(time.Hour))
+				return transfer(0)
+			},
+			false,
+		},
+		{
+			"selector not allowed",
+			func() *types.MsgEVMCall {
+				approveSelector := types.ERC20Contract.ABI.Methods["approve"].ID
+				grant(types.NewEVMCallAuthorization([]common.Address{contract}, [][]byte{approveSelector}, sdkmath.ZeroInt()), suite.Ctx.BlockTime().Add(time.Hour))
+				return transfer(0)
+			},
+			false,
+		},
+		{
+			"value above spend limit",
+			func() *types.MsgEVMCall {
+				grant(types.NewEVMCallAuthorization([]common.Address{contract}, nil, sdkmath.NewInt(1)), suite.Ctx.BlockTime().Add(time.Hour))
+				return transfer(2)
+			},
+			false,
+		},
+		{
+			"expired grant",
+			func() *types.MsgEVMCall {
+				grant(types.NewEVMCallAuthorization([]common.Address{contract}, nil, sdkmath.ZeroInt()), suite.Ctx.BlockTime().Add(time.Hour))
+				suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(2 * time.Hour))
+				return transfer(0)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			granter = sdk.AccAddress(suite.Address.Bytes())
+			grantee = sdk.AccAddress(tests.GenerateAddress().Bytes())
+			recipient = tests.GenerateAddress()
+			contract = suite.DeployTestContract(suite.T(), suite.Address, supply, suite.enableFeemarket)
+
+			msg := tc.malleate()
+			msgExec := authz.NewMsgExec(grantee, []sdk.Msg{msg})
+			_, err := suite.App.AuthzKeeper.Exec(sdk.WrapSDKContext(suite.Ctx), &msgExec)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				suite.Require().Zero(balanceOf(recipient).Sign())
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(amount, balanceOf(recipient))
+			suite.Require().Equal(new(big.Int).Sub(supply, amount), balanceOf(suite.Address))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestEVMCallSpendLimit() {
+	suite.SetupTest()
+	granter := sdk.AccAddress(suite.Address.Bytes())
+	grantee := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	recipient := tests.GenerateAddress()
+	expiration := suite.Ctx.BlockTime().Add(time.Hour)
+
+	vmdb := suite.StateDB()
+	vmdb.AddBalance(suite.Address, big.NewInt(1000))
+	suite.Require().NoError(vmdb.Commit())
+
+	authorization := types.NewEVMCallAuthorization([]common.Address{recipient}, nil, sdkmath.NewInt(150))
+	err := suite.App.AuthzKeeper.SaveGrant(suite.Ctx, grantee, granter, authorization, &expiration)
+	suite.Require().NoError(err)
+
+	msgExec := authz.NewMsgExec(grantee, []sdk.Msg{types.NewMsgEVMCall(granter, recipient, nil, sdkmath.NewInt(100), 100000)})
+	_, err = suite.App.AuthzKeeper.Exec(sdk.WrapSDKContext(suite.Ctx), &msgExec)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(100), suite.App.EvmKeeper.GetBalance(suite.Ctx, recipient.Bytes(), suite.denom).Int64())
+
+	// the spend limit is decreased by the value transferred
+	updated, _ := suite.App.AuthzKeeper.GetAuthorization(suite.Ctx, grantee, granter, authorization.MsgTypeURL())
+	suite.Require().Equal(sdkmath.NewInt(50), updated.(*types.EVMCallAuthorization).SpendLimit)
+
+	_, err = suite.App.AuthzKeeper.Exec(sdk.WrapSDKContext(suite.Ctx), &msgExec)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestEVMCallGasLimit() {
+	suite.SetupTest()
+	recipient := tests.GenerateAddress()
+	supply := sdkmath.NewIntWithDecimal(1000, 18).BigInt()
+	contract := suite.DeployTestContract(suite.T(), suite.Address, supply, suite.enableFeemarket)
+	data, err := types.ERC20Contract.ABI.Pack("transfer", recipient, big.NewInt(100))
+	suite.Require().NoError(err)
+	msg := types.NewMsgEVMCall(sdk.AccAddress(suite.Address.Bytes()), contract, data, sdkmath.ZeroInt(), types.MaxEVMCallGasLimit)
+
+	// the gas limit is capped by the gas remaining in the transaction
+	ctx := suite.Ctx.WithGasMeter(sdk.NewGasMeter(10000))
+	_, err = suite.App.EvmKeeper.EVMCall(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().Error(err)
+	suite.Require().LessOrEqual(ctx.GasMeter().GasConsumed(), uint64(10000))
+
+	ctx = suite.Ctx.WithGasMeter(sdk.NewGasMeter(1000000))
+	res, err := suite.App.EvmKeeper.EVMCall(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().NoError(err)
+	suite.Require().LessOrEqual(res.GasUsed, ctx.GasMeter().GasConsumed())
+}
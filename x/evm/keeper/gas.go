@@ -18,6 +18,7 @@ package keeper
 import (
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/params"
 
@@ -40,11 +41,11 @@ func (k *Keeper) GetEthIntrinsicGas(ctx sdk.Context, msg core.Message, cfg *para
 	return core.IntrinsicGas(msg.Data, msg.AccessList, isContractCreation, homestead, istanbul, shanghai)
 }
 
-// RefundGas transfers the leftover gas to the sender of the message, caped to half of the total gas
-// consumed in the transaction. Additionally, the function sets the total gas consumed to the value
-// returned by the EVM execution, thus ignoring the previous intrinsic gas consumed during in the
-// AnteHandler.
-func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64, denom string) error {
+// RefundGas transfers the leftover gas to the payer of the fees, the sender of the message or the
+// granter sponsoring it, caped to half of the total gas consumed in the transaction. Additionally,
+// the function sets the total gas consumed to the value returned by the EVM execution, thus ignoring
+// the previous intrinsic gas consumed during in the AnteHandler.
+func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, payer common.Address, leftoverGas uint64, denom string) error {
 	// Return EVM tokens for remaining gas, exchanged at the original rate.
 	remaining := new(big.Int).Mul(new(big.Int).SetUint64(leftoverGas), msg.GasPrice)
 
@@ -56,9 +57,9 @@ func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64
 		// positive amount refund
 		refundedCoins := sdk.Coins{sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(remaining))}
 
-		// refund to sender from the fee collector module account, which is the escrow account in charge of collecting tx fees
+		// refund to the payer from the fee collector module account, which is the escrow account in charge of collecting tx fees
 
-		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, msg.From.Bytes(), refundedCoins)
+		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, payer.Bytes(), refundedCoins)
 		if err != nil {
 			err = errorsmod.Wrapf(errortypes.ErrInsufficientFunds, "fee collector account failed to refund fees: %s", err.Error())
 			return errorsmod.Wrapf(err, "failed to refund %d leftover gas (%s)", leftoverGas, refundedCoins.String())
@@ -70,6 +71,59 @@ func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64
 	return nil
 }
 
+// UseGrantedFees charges the fee allowance of the granter sponsoring the transaction for the gas
+// used only, the leftover gas being refunded to the granter. It's a no-op if there's no fee grant
+// keeper.
+func (k *Keeper) UseGrantedFees(ctx sdk.Context, msg core.Message, msgEth sdk.Msg, granter common.Address, gasUsed uint64, denom string) error {
+	if k.feeGrantKeeper == nil {
+		return nil
+	}
+
+	used := new(big.Int).Mul(new(big.Int).SetUint64(gasUsed), msg.GasPrice)
+	if used.Sign() <= 0 {
+		return nil
+	}
+
+	fees := sdk.Coins{sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(used))}
+	if err := k.feeGrantKeeper.UseGrantedFees(ctx, granter.Bytes(), msg.From.Bytes(), fees, []sdk.Msg{msgEth}); err != nil {
+		return errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", granter, msg.From)
+	}
+
+	return nil
+}
+
+// BurnBaseFee burns the base fee portion of the fees paid for the gas used, according to the burn ratio
+// of the fee market params, so only the priority tip is left in the fee collector. It must be called
+// after the leftover gas is refunded.
+func (k *Keeper) BurnBaseFee(ctx sdk.Context, baseFee *big.Int, gasUsed uint64, denom string) error {
+	if baseFee == nil || baseFee.Sign() <= 0 || gasUsed == 0 {
+		return nil
+	}
+
+	ratio := k.feeMarketKeeper.GetParams(ctx).BaseFeeBurnRatio()
+	if !ratio.IsPositive() {
+		return nil
+	}
+
+	baseFees := new(big.Int).Mul(baseFee, new(big.Int).SetUint64(gasUsed))
+	amount := sdk.NewDecFromBigInt(baseFees).Mul(ratio).TruncateInt()
+	if !amount.IsPositive() {
+		return nil
+	}
+	burnedCoins := sdk.Coins{sdk.NewCoin(denom, amount)}
+
+	// the fee collector can't burn, so the fees are burned through the evm module account
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, burnedCoins); err != nil {
+		return errorsmod.Wrapf(err, "failed to move base fees (%s) from the fee collector", burnedCoins)
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burnedCoins); err != nil {
+		return errorsmod.Wrapf(err, "failed to burn base fees (%s)", burnedCoins)
+	}
+
+	k.feeMarketKeeper.AddBurnedFees(ctx, burnedCoins)
+	return nil
+}
+
 // ResetGasMeterAndConsumeGas reset first the gas meter consumed value to zero and set it back to the new value
 // 'gasUsed'
 func (k *Keeper) ResetGasMeterAndConsumeGas(ctx sdk.Context, gasUsed uint64) {
@@ -37,10 +37,12 @@ import (
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 	ethparams "github.com/ethereum/go-ethereum/params"
 
 	rpctypes "github.com/evmos/ethermint/rpc/types"
 	ethermint "github.com/evmos/ethermint/types"
+	"github.com/evmos/ethermint/x/evm/statedb"
 	"github.com/evmos/ethermint/x/evm/types"
 )
 
@@ -48,6 +50,9 @@ var _ types.QueryServer = Keeper{}
 
 const (
 	defaultTraceTimeout = 5 * time.Second
+	// maxAccessListIterations is the maximum number of executions of the tx to compute its access list,
+	// each one is applied with the access list of the previous one until it doesn't change anymore.
+	maxAccessListIterations = 16
 )
 
 // Account implements the Query/Account gRPC method
@@ -247,13 +252,8 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	var overrides rpctypes.StateOverride
-	if len(req.Overrides) > 0 {
-		if err := json.Unmarshal(req.Overrides, &overrides); err != nil {
-			return nil, status.Error(codes.InvalidArgument, err.Error())
-		}
-
-		cfg.Overrides = &overrides
+	if err := setCallOverrides(cfg, req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	// ApplyMessageWithConfig expect correct nonce set in msg
@@ -274,6 +274,124 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 	return res, nil
 }
 
+// setCallOverrides decodes the json encoded state and block overrides of the request into the config,
+// the base fee of the config follows the block overrides so that the message gas price is consistent.
+func setCallOverrides(cfg *EVMConfig, req *types.EthCallRequest) error {
+	if len(req.Overrides) > 0 {
+		var overrides rpctypes.StateOverride
+		if err := json.Unmarshal(req.Overrides, &overrides); err != nil {
+			return err
+		}
+		cfg.Overrides = &overrides
+	}
+
+	if len(req.BlockOverrides) > 0 {
+		var blockOverrides rpctypes.BlockOverrides
+		if err := json.Unmarshal(req.BlockOverrides, &blockOverrides); err != nil {
+			return err
+		}
+		cfg.BlockOverrides = &blockOverrides
+		if blockOverrides.BaseFee != nil {
+			cfg.BaseFee = blockOverrides.BaseFee.ToInt()
+		}
+	}
+	return nil
+}
+
+// EthCallBundle implements eth_callBundle rpc api. The calls are applied in order on the same StateDB, so
+// each one sees the state changes of the previous ones, and nothing is committed. The nonce of the sender
+// is increased after each call as the ante handler would do, and the gas cap is shared by the whole bundle.
+// The calls without nonce use the one of the sender, the others fail if their nonce doesn't match it.
+func (k Keeper) EthCallBundle(c context.Context, req *types.EthCallBundleRequest) (*types.EthCallBundleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	chainID, err := getChainID(ctx, req.ChainId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID, common.Hash{})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	stateDB := statedb.NewWithParams(ctx, &k, cfg.TxConfig, cfg.Params)
+	if len(req.Overrides) > 0 {
+		var overrides rpctypes.StateOverride
+		if err := json.Unmarshal(req.Overrides, &overrides); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if err := overrides.Apply(stateDB); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	gasCap := req.GasCap
+	logIndex := uint(0)
+	results := make([]*types.MsgEthereumTxResponse, len(req.Args))
+	for i, bz := range req.Args {
+		var args types.TransactionArgs
+		if err := json.Unmarshal(bz, &args); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "call %d: %s", i, err.Error())
+		}
+
+		// a zero gas cap means no cap, it can't be reached by the previous calls
+		if req.GasCap != 0 && gasCap == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "call %d: bundle gas cap %d reached", i, req.GasCap)
+		}
+
+		// the nonce of the signed txs is kept, it must follow the one of the previous calls
+		nonce := stateDB.GetNonce(args.GetFrom())
+		if args.Nonce == nil {
+			args.Nonce = (*hexutil.Uint64)(&nonce)
+		} else if err := checkBundleNonce(args.GetFrom(), uint64(*args.Nonce), nonce); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "call %d: %s", i, err.Error())
+		}
+
+		msg, err := args.ToMessage(gasCap, cfg.BaseFee)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "call %d: %s", i, err.Error())
+		}
+
+		cfg.TxConfig = statedb.NewTxConfig(cfg.TxConfig.BlockHash, common.Hash{}, uint(i), logIndex)
+		stateDB.SetTxConfig(cfg.TxConfig)
+
+		res, err := k.ApplyMessageWithStateDB(ctx, msg, cfg, stateDB)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "call %d: %s", i, err.Error())
+		}
+
+		// the nonce of contract creations is already increased by the state transition
+		if msg.To != nil {
+			stateDB.SetNonce(msg.From, msg.Nonce+1)
+		}
+
+		if res.GasUsed < gasCap {
+			gasCap -= res.GasUsed
+		} else {
+			gasCap = 0
+		}
+		logIndex += uint(len(res.Logs))
+		results[i] = res
+	}
+
+	return &types.EthCallBundleResponse{Results: results}, nil
+}
+
+// checkBundleNonce returns an error if the nonce of a bundle tx doesn't match the one of its sender.
+func checkBundleNonce(from common.Address, txNonce, stateNonce uint64) error {
+	switch {
+	case txNonce < stateNonce:
+		return fmt.Errorf("%w: address %s, tx: %d state: %d", core.ErrNonceTooLow, from, txNonce, stateNonce)
+	case txNonce > stateNonce:
+		return fmt.Errorf("%w: address %s, tx: %d state: %d", core.ErrNonceTooHigh, from, txNonce, stateNonce)
+	}
+	return nil
+}
+
 // EstimateGas implements eth_estimateGas rpc api.
 func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*types.EstimateGasResponse, error) {
 	if req == nil {
@@ -296,6 +414,15 @@ func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*type
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID, common.Hash{})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to load evm config")
+	}
+
+	if err := setCallOverrides(cfg, req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	// Binary search the gas requirement, as it may be higher than the amount used
 	var (
 		lo     = ethparams.TxGas - 1
@@ -306,6 +433,8 @@ func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*type
 	// Determine the highest gas limit can be used during the estimation.
 	if args.Gas != nil && uint64(*args.Gas) >= ethparams.TxGas {
 		hi = uint64(*args.Gas)
+	} else if cfg.BlockOverrides != nil && cfg.BlockOverrides.GasLimit != nil {
+		hi = uint64(*cfg.BlockOverrides.GasLimit)
 	} else {
 		// Query block gas limit
 		params := ctx.ConsensusParams()
@@ -323,10 +452,6 @@ func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*type
 		hi = req.GasCap
 	}
 	gasCap = hi
-	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID, common.Hash{})
-	if err != nil {
-		return nil, status.Error(codes.Internal, "failed to load evm config")
-	}
 
 	// ApplyMessageWithConfig expect correct nonce set in msg
 	nonce := k.GetNonce(ctx, args.GetFrom())
@@ -467,6 +592,86 @@ func execTrace[T traceRequest](
 	return resultData, nil
 }
 
+// CreateAccessList implements the `eth_createAccessList` rpc api. Like geth, the message is run with the access list
+// tracer and the access list found by the previous run until it's stable, the gas used is the one of the last run.
+func (k Keeper) CreateAccessList(c context.Context, req *types.EthCallRequest) (*types.CreateAccessListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var args types.TransactionArgs
+	if err := json.Unmarshal(req.Args, &args); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	chainID, err := getChainID(ctx, req.ChainId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID, common.Hash{})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if err := setCallOverrides(cfg, req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// ApplyMessageWithConfig expect correct nonce set in msg
+	from := args.GetFrom()
+	nonce := k.GetNonce(ctx, from)
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	// the sender, the recipient and the precompiles are always warm, so they are excluded from the list
+	var to common.Address
+	if args.To != nil {
+		to = *args.To
+	} else {
+		to = crypto.CreateAddress(from, nonce)
+	}
+	rules := cfg.ChainConfig.Rules(big.NewInt(ctx.BlockHeight()), cfg.ChainConfig.MergeNetsplitBlock != nil, uint64(ctx.BlockHeader().Time.Unix()))
+	precompiles := k.precompileAddresses(ctx, rules)
+
+	var accessList ethtypes.AccessList
+	if args.AccessList != nil {
+		accessList = *args.AccessList
+	}
+	prevTracer := logger.NewAccessListTracer(accessList, from, to, precompiles)
+	for i := 0; i < maxAccessListIterations; i++ {
+		// stop when the RPC call is cancelled or times out, as each iteration executes the whole tx
+		select {
+		case <-c.Done():
+			return nil, status.FromContextError(c.Err()).Err()
+		default:
+		}
+
+		accessList = prevTracer.AccessList()
+		args.AccessList = &accessList
+		msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		tracer := logger.NewAccessListTracer(accessList, from, to, precompiles)
+		cfg.Tracer = tracer
+		// pass false to not commit StateDB
+		res, err := k.ApplyMessageWithConfig(ctx, msg, cfg, false)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if tracer.Equal(prevTracer) {
+			return &types.CreateAccessListResponse{
+				AccessList: types.NewAccessList(&accessList),
+				GasUsed:    res.GasUsed,
+				VmError:    res.VmError,
+			}, nil
+		}
+		prevTracer = tracer
+	}
+
+	return nil, status.Errorf(codes.Internal, "access list didn't converge after %d iterations", maxAccessListIterations)
+}
+
 // TraceTx configures a new tracer according to the provided configuration, and
 // executes the given message in the provided environment. The return value will
 // be tracer dependent.
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"math"
@@ -17,12 +18,15 @@ import (
 	ethlogger "github.com/ethereum/go-ethereum/eth/tracers/logger"
 	ethparams "github.com/ethereum/go-ethereum/params"
 	"github.com/evmos/ethermint/app"
+	rpctypes "github.com/evmos/ethermint/rpc/types"
 	"github.com/evmos/ethermint/tests"
 	"github.com/evmos/ethermint/testutil"
 	"github.com/evmos/ethermint/x/evm/statedb"
 	feemarkettypes "github.com/evmos/ethermint/x/feemarket/types"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -1443,6 +1447,217 @@ func (suite *GRPCServerTestSuiteSuite) TestEthCall() {
 	}
 }
 
+func (suite *GRPCServerTestSuiteSuite) TestEthCallOverrides() {
+	// returns the current block number: NUMBER PUSH1 0 MSTORE PUSH1 32 PUSH1 0 RETURN
+	code := hexutil.Bytes(common.FromHex("0x4360005260206000f3"))
+	contract := tests.GenerateAddress()
+	overrides, err := json.Marshal(rpctypes.StateOverride{contract: rpctypes.OverrideAccount{Code: &code}})
+	suite.Require().NoError(err)
+	args, err := json.Marshal(&types.TransactionArgs{To: &contract})
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name           string
+		blockOverrides []byte
+		expPass        bool
+		expNumber      int64
+	}{
+		{"invalid block overrides", []byte("invalid"), false, 0},
+		{"no block overrides", nil, true, suite.Ctx.BlockHeight()},
+		{"override block number", []byte(`{"number":"0x64"}`), true, 100},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			res, err := suite.EvmQueryClient.EthCall(suite.Ctx, &types.EthCallRequest{
+				Args:           args,
+				GasCap:         uint64(config.DefaultGasCap),
+				Overrides:      overrides,
+				BlockOverrides: tc.blockOverrides,
+			})
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Empty(res.VmError)
+			suite.Require().Equal(tc.expNumber, new(big.Int).SetBytes(res.Ret).Int64())
+		})
+	}
+
+	// the gas limit of the overridden block caps the estimation
+	transfer, err := json.Marshal(&types.TransactionArgs{To: &common.Address{}})
+	suite.Require().NoError(err)
+	_, err = suite.EvmQueryClient.EstimateGas(suite.Ctx, &types.EthCallRequest{
+		Args:           transfer,
+		GasCap:         uint64(config.DefaultGasCap),
+		BlockOverrides: []byte(`{"gasLimit":"0x5000"}`),
+	})
+	suite.Require().Error(err)
+	res, err := suite.EvmQueryClient.EstimateGas(suite.Ctx, &types.EthCallRequest{
+		Args:           transfer,
+		GasCap:         uint64(config.DefaultGasCap),
+		BlockOverrides: []byte(`{"gasLimit":"0x10000"}`),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(ethparams.TxGas, res.Gas)
+}
+
+func (suite *GRPCServerTestSuiteSuite) TestCreateAccessList() {
+	contractAddr := suite.deployTestContract(suite.Address)
+	suite.Commit()
+
+	recipient := tests.GenerateAddress()
+	transferData, err := types.ERC20Contract.ABI.Pack("transfer", recipient, big.NewInt(1000))
+	suite.Require().NoError(err)
+	args, err := json.Marshal(&types.TransactionArgs{
+		From: &suite.Address,
+		To:   &contractAddr,
+		Data: (*hexutil.Bytes)(&transferData),
+	})
+	suite.Require().NoError(err)
+
+	_, err = suite.EvmQueryClient.CreateAccessList(suite.Ctx, &types.EthCallRequest{
+		Args:   []byte("invalid"),
+		GasCap: uint64(config.DefaultGasCap),
+	})
+	suite.Require().Error(err)
+
+	res, err := suite.EvmQueryClient.CreateAccessList(suite.Ctx, &types.EthCallRequest{
+		Args:   args,
+		GasCap: uint64(config.DefaultGasCap),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.VmError)
+
+	// the sender, the recipient and the precompiles are excluded from the list
+	accessList := *res.AccessList.ToEthAccessList()
+	suite.Require().Len(accessList, 1)
+	suite.Require().Equal(contractAddr, accessList[0].Address)
+	suite.Require().Len(accessList[0].StorageKeys, 2)
+	suite.Require().Greater(res.GasUsed, ethparams.TxGas)
+
+	// the computation stops when the call is cancelled
+	cancelled, cancel := context.WithCancel(suite.Ctx.Context())
+	cancel()
+	_, err = suite.App.EvmKeeper.CreateAccessList(sdk.WrapSDKContext(suite.Ctx.WithContext(cancelled)), &types.EthCallRequest{
+		Args:   args,
+		GasCap: uint64(config.DefaultGasCap),
+	})
+	suite.Require().Equal(codes.Canceled, status.Code(err))
+}
+
+func (suite *GRPCServerTestSuiteSuite) TestEthCallBundle() {
+	var req *types.EthCallBundleRequest
+
+	address := tests.GenerateAddress()
+	recipient := tests.GenerateAddress()
+	contractAddr := crypto.CreateAddress(address, 0)
+	supply := sdkmath.NewIntWithDecimal(1000, 18).BigInt()
+
+	ctorArgs, err := types.ERC20Contract.ABI.Pack("", address, supply)
+	suite.Require().NoError(err)
+	deployData := append(types.ERC20Contract.Bin, ctorArgs...)
+	transferData, err := types.ERC20Contract.ABI.Pack("transfer", recipient, big.NewInt(10))
+	suite.Require().NoError(err)
+	balanceData, err := types.ERC20Contract.ABI.Pack("balanceOf", recipient)
+	suite.Require().NoError(err)
+
+	// deploys the contract, transfers tokens then queries the balance of the recipient, the nonces
+	// are set by the query if none is given
+	bundle := func(nonces ...uint64) [][]byte {
+		calls := []types.TransactionArgs{
+			{From: &address, Data: (*hexutil.Bytes)(&deployData)},
+			{From: &address, To: &contractAddr, Data: (*hexutil.Bytes)(&transferData)},
+			{From: &address, To: &contractAddr, Data: (*hexutil.Bytes)(&balanceData)},
+		}
+		args := make([][]byte, len(calls))
+		for i := range calls {
+			if len(nonces) > 0 {
+				calls[i].Nonce = (*hexutil.Uint64)(&nonces[i])
+			}
+			bz, err := json.Marshal(&calls[i])
+			suite.Require().NoError(err)
+			args[i] = bz
+		}
+		return args
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"invalid args",
+			func() {
+				req = &types.EthCallBundleRequest{Args: [][]byte{[]byte("invalid args")}, GasCap: uint64(config.DefaultGasCap)}
+			},
+			false,
+		},
+		{
+			"bundle gas cap reached",
+			func() {
+				req = &types.EthCallBundleRequest{Args: bundle(), GasCap: 1_000_000}
+			},
+			false,
+		},
+		{
+			"nonce too low",
+			func() {
+				req = &types.EthCallBundleRequest{Args: bundle(0, 0, 1), GasCap: uint64(config.DefaultGasCap)}
+			},
+			false,
+		},
+		{
+			"nonce too high",
+			func() {
+				req = &types.EthCallBundleRequest{Args: bundle(0, 2, 3), GasCap: uint64(config.DefaultGasCap)}
+			},
+			false,
+		},
+		{
+			"pass - each call sees the state of the previous ones",
+			func() {
+				req = &types.EthCallBundleRequest{Args: bundle(), GasCap: uint64(config.DefaultGasCap)}
+			},
+			true,
+		},
+		{
+			"pass - the nonces of the calls are kept",
+			func() {
+				req = &types.EthCallBundleRequest{Args: bundle(0, 1, 2), GasCap: uint64(config.DefaultGasCap)}
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			res, err := suite.EvmQueryClient.EthCallBundle(suite.Ctx, req)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Len(res.Results, 3)
+			for _, result := range res.Results {
+				suite.Require().False(result.Failed(), result.VmError)
+			}
+
+			// the transfer log is attributed to the second call
+			suite.Require().Len(res.Results[1].Logs, 1)
+			suite.Require().Equal(uint64(1), res.Results[1].Logs[0].TxIndex)
+			suite.Require().Equal(big.NewInt(10), new(big.Int).SetBytes(res.Results[2].Ret))
+
+			// nothing is committed
+			suite.Require().Equal(uint64(0), suite.App.EvmKeeper.GetNonce(suite.Ctx, address))
+			suite.Require().Nil(suite.App.EvmKeeper.GetAccount(suite.Ctx, contractAddr))
+		})
+	}
+}
+
 func (suite *GRPCServerTestSuiteSuite) TestEmptyRequest() {
 	testCases := []struct {
 		name      string
@@ -1490,6 +1705,12 @@ func (suite *GRPCServerTestSuiteSuite) TestEmptyRequest() {
 				return suite.App.EvmKeeper.EthCall(suite.Ctx, nil)
 			},
 		},
+		{
+			"EthCallBundle method",
+			func() (interface{}, error) {
+				return suite.App.EvmKeeper.EthCallBundle(suite.Ctx, nil)
+			},
+		},
 		{
 			"EstimateGas method",
 			func() (interface{}, error) {
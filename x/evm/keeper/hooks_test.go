@@ -72,7 +72,7 @@ func (suite *HookTestSuite) TestEvmHooks() {
 	for _, tc := range testCases {
 		suite.SetupTest(suite.T())
 		hook := tc.setupHook()
-		suite.App.EvmKeeper.SetHooks(keeper.NewMultiEvmHooks(hook))
+		suite.App.EvmKeeper.CleanHooks().SetHooks(keeper.NewMultiEvmHooks(hook))
 
 		k := suite.App.EvmKeeper
 		txHash := common.BigToHash(big.NewInt(1))
@@ -62,6 +62,8 @@ type Keeper struct {
 	stakingKeeper types.StakingKeeper
 	// fetch EIP1559 base fee and parameters
 	feeMarketKeeper types.FeeMarketKeeper
+	// charge the fee allowances of the sponsored transactions
+	feeGrantKeeper types.FeeGrantKeeper
 
 	// chain ID number obtained from the context's chain id
 	eip155ChainID *big.Int
@@ -90,6 +92,7 @@ func NewKeeper(
 	bankKeeper types.BankKeeper,
 	sk types.StakingKeeper,
 	fmk types.FeeMarketKeeper,
+	fgk types.FeeGrantKeeper,
 	tracer string,
 	ss paramstypes.Subspace,
 	customContractFns []CustomContractFn,
@@ -113,6 +116,7 @@ func NewKeeper(
 		bankKeeper:        bankKeeper,
 		stakingKeeper:     sk,
 		feeMarketKeeper:   fmk,
+		feeGrantKeeper:    fgk,
 		storeKey:          storeKey,
 		transientKey:      transientKey,
 		tracer:            tracer,
@@ -165,6 +169,77 @@ func (k Keeper) EmitBlockBloomEvent(ctx sdk.Context, bloom ethtypes.Bloom) {
 	)
 }
 
+// ----------------------------------------------------------------------------
+// State Root
+// ----------------------------------------------------------------------------
+
+// EmitBlockStateRootEvent emit the EVM state root reached at the end of the block
+func (k Keeper) EmitBlockStateRootEvent(ctx sdk.Context, root common.Hash) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBlockStateRoot,
+			sdk.NewAttribute(types.AttributeKeyStateRoot, root.Hex()),
+		),
+	)
+}
+
+// GetStateRoot returns the EVM state root committed at the end of the last block
+func (k Keeper) GetStateRoot(ctx sdk.Context) common.Hash {
+	store := ctx.KVStore(k.storeKey)
+	return common.BytesToHash(store.Get(types.KeyPrefixStateRoot))
+}
+
+// IsStateRootEnabled returns true if the EVM state root is tracked, its tracking being enabled by the
+// genesis or by the upgrade setting the initial state root as it changes the app hash.
+func (k Keeper) IsStateRootEnabled(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.KeyPrefixStateRoot)
+}
+
+// SetStateRoot persists the EVM state root reached at the end of the block
+func (k Keeper) SetStateRoot(ctx sdk.Context, root common.Hash) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPrefixStateRoot, root.Bytes())
+}
+
+// GetStateRootTransient returns the intermediate EVM state root after the last transaction executed in
+// the current block, it defaults to the state root of the previous block.
+func (k Keeper) GetStateRootTransient(ctx sdk.Context) common.Hash {
+	store := ctx.TransientStore(k.transientKey)
+	bz := store.Get(types.KeyPrefixTransientStateRoot)
+	if len(bz) == 0 {
+		return k.GetStateRoot(ctx)
+	}
+
+	return common.BytesToHash(bz)
+}
+
+// SetStateRootTransient sets the intermediate EVM state root to the transient store. This value is
+// reset on every block.
+func (k Keeper) SetStateRootTransient(ctx sdk.Context, root common.Hash) {
+	store := ctx.TransientStore(k.transientKey)
+	store.Set(types.KeyPrefixTransientStateRoot, root.Bytes())
+}
+
+// GetFeePayerTransient returns the granter paying the fees of the transaction, if it is sponsored
+// through a fee allowance.
+func (k Keeper) GetFeePayerTransient(ctx sdk.Context, txHash common.Hash) (common.Address, bool) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientFeePayer)
+	bz := store.Get(txHash.Bytes())
+	if len(bz) == 0 {
+		return common.Address{}, false
+	}
+
+	return common.BytesToAddress(bz), true
+}
+
+// SetFeePayerTransient sets the granter paying the fees of the transaction to the transient store.
+// This value is reset on every block.
+func (k Keeper) SetFeePayerTransient(ctx sdk.Context, txHash common.Hash, payer common.Address) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientFeePayer)
+	store.Set(txHash.Bytes(), payer.Bytes())
+}
+
 // GetAuthority returns the x/evm module authority address
 func (k Keeper) GetAuthority() sdk.AccAddress {
 	return k.authority
@@ -264,6 +339,13 @@ func (k *Keeper) SetHooks(eh types.EvmHooks) *Keeper {
 	return k
 }
 
+// CleanHooks resets the hooks of the EVM module, allowing to replace the hooks set by the app.
+// NOTE: This is solely to be used for testing purposes.
+func (k *Keeper) CleanHooks() *Keeper {
+	k.hooks = nil
+	return k
+}
+
 // PostTxProcessing delegate the call to the hooks. If no hook has been registered, this function returns with a `nil` error
 func (k *Keeper) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
 	if k.hooks == nil {
@@ -18,6 +18,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"strconv"
 
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -29,7 +30,11 @@ import (
 	"github.com/armon/go-metrics"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/vm"
 
+	ethermint "github.com/evmos/ethermint/types"
 	"github.com/evmos/ethermint/x/evm/types"
 )
 
@@ -144,3 +149,69 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// EVMCall implements the gRPC MsgServer interface. It calls the contract with the sender as caller,
+// the sender being the granter when executed through an EVMCallAuthorization. The gas used by the call
+// is consumed on the cosmos gas meter, the call fails if the execution is reverted.
+func (k *Keeper) EVMCall(goCtx context.Context, msg *types.MsgEVMCall) (*types.MsgEVMCallResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	// the call cannot use more gas than remaining in the transaction or than the block gas limit
+	gasLimit := msg.GasLimit
+	if remaining := ctx.GasMeter().GasRemaining(); gasLimit > remaining {
+		gasLimit = remaining
+	}
+	if blockGasLimit := ethermint.BlockGasLimit(ctx); blockGasLimit > 0 && gasLimit > blockGasLimit {
+		gasLimit = blockGasLimit
+	}
+
+	from := common.BytesToAddress(sender)
+	to := common.HexToAddress(msg.To)
+	ethMsg := core.Message{
+		From:              from,
+		To:                &to,
+		Nonce:             k.GetNonce(ctx, from),
+		Value:             msg.Value.BigInt(),
+		GasLimit:          gasLimit,
+		GasPrice:          big.NewInt(0),
+		GasFeeCap:         big.NewInt(0),
+		GasTipCap:         big.NewInt(0),
+		Data:              msg.Data,
+		SkipAccountChecks: true,
+	}
+
+	res, err := k.ApplyMessage(ctx, ethMsg, nil, true)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to apply message")
+	}
+
+	ctx.GasMeter().ConsumeGas(res.GasUsed, "evm call")
+
+	if res.Failed() {
+		vmErr := res.VmError
+		if vmErr == vm.ErrExecutionReverted.Error() {
+			vmErr = types.NewExecErrorWithReason(res.Ret).Error()
+		}
+		return nil, errorsmod.Wrap(types.ErrVMExecution, vmErr)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.To),
+		),
+	})
+
+	return &types.MsgEVMCallResponse{
+		Ret:     res.Ret,
+		Logs:    res.Logs,
+		GasUsed: res.GasUsed,
+	}, nil
+}
@@ -94,6 +94,15 @@ func (k *Keeper) NewEVM(
 	return evm
 }
 
+// precompileAddresses returns the addresses of the default and the custom precompiled contracts
+func (k *Keeper) precompileAddresses(ctx sdk.Context, rules params.Rules) []common.Address {
+	addresses := vm.DefaultActivePrecompiles(rules)
+	for _, fn := range k.customContractFns {
+		addresses = append(addresses, fn(ctx, rules).Address())
+	}
+	return addresses
+}
+
 // GetHashFn implements vm.GetHashFunc for Ethermint. It handles 3 cases:
 //  1. The requested height matches the current height from context (and thus same epoch number)
 //  2. The requested height is from an previous height from the same chain epoch
@@ -224,9 +233,10 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, msgEth *types.MsgEthereumTx)
 		contractAddr = crypto.CreateAddress(msg.From, msg.Nonce)
 	}
 
+	stateRootEnabled := k.IsStateRootEnabled(ctx)
+
 	receipt := &ethtypes.Receipt{
 		Type:              ethTx.Type(),
-		PostState:         nil, // TODO: intermediate state root
 		CumulativeGasUsed: cumulativeGasUsed,
 		Bloom:             bloomReceipt,
 		Logs:              logs,
@@ -237,6 +247,9 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, msgEth *types.MsgEthereumTx)
 		BlockNumber:       big.NewInt(ctx.BlockHeight()),
 		TransactionIndex:  cfg.TxConfig.TxIndex,
 	}
+	if stateRootEnabled {
+		receipt.PostState = k.GetStateRootTransient(tmpCtx).Bytes()
+	}
 
 	if !res.Failed() {
 		receipt.Status = ethtypes.ReceiptStatusSuccessful
@@ -248,6 +261,10 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, msgEth *types.MsgEthereumTx)
 
 			// If the tx failed in post processing hooks, we should clear the logs
 			res.Logs = nil
+			// and the state changes of the tx are reverted as well
+			if stateRootEnabled {
+				receipt.PostState = k.GetStateRootTransient(ctx).Bytes()
+			}
 		} else if commit != nil {
 			// PostTxProcessing is successful, commit the tmpCtx
 			commit()
@@ -257,8 +274,23 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, msgEth *types.MsgEthereumTx)
 	}
 
 	// refund gas in order to match the Ethereum gas consumption instead of the default SDK one.
-	if err = k.RefundGas(ctx, msg, msg.GasLimit-res.GasUsed, cfg.Params.EvmDenom); err != nil {
-		return nil, errorsmod.Wrapf(err, "failed to refund gas leftover gas to sender %s", msg.From)
+	payer := msg.From
+	granter, sponsored := k.GetFeePayerTransient(ctx, cfg.TxConfig.TxHash)
+	if sponsored {
+		payer = granter
+	}
+	if err = k.RefundGas(ctx, msg, payer, msg.GasLimit-res.GasUsed, cfg.Params.EvmDenom); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to refund gas leftover gas to %s", payer)
+	}
+
+	if sponsored {
+		if err = k.UseGrantedFees(ctx, msg, msgEth, granter, res.GasUsed, cfg.Params.EvmDenom); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to use the fee allowance")
+		}
+	}
+
+	if err = k.BurnBaseFee(ctx, cfg.BaseFee, res.GasUsed, cfg.Params.EvmDenom); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to burn base fee")
 	}
 
 	if len(receipt.Logs) > 0 {
@@ -268,6 +300,7 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, msgEth *types.MsgEthereumTx)
 	}
 
 	k.SetTxIndexTransient(ctx, uint64(cfg.TxConfig.TxIndex)+1)
+	res.StateRoot = receipt.PostState
 
 	totalGasUsed, err := k.AddTransientGasUsed(ctx, res.GasUsed)
 	if err != nil {
@@ -302,7 +335,7 @@ func (k *Keeper) ApplyMessage(ctx sdk.Context, msg core.Message, tracer vm.EVMLo
 //
 // It's called in three scenarios:
 // 1. `ApplyTransaction`, in the transaction processing flow.
-// 2. `EthCall/EthEstimateGas` grpc query handler.
+// 2. `EthCall/EthEstimateGas/EthCallBundle` grpc query handler.
 // 3. Called by other native modules directly.
 //
 // # Prechecks and Preprocessing
@@ -342,6 +375,39 @@ func (k *Keeper) ApplyMessageWithConfig(
 	msg core.Message,
 	cfg *EVMConfig,
 	commit bool,
+) (*types.MsgEthereumTxResponse, error) {
+	stateDB := statedb.NewWithParams(ctx, k, cfg.TxConfig, cfg.Params)
+	if cfg.Overrides != nil {
+		if err := cfg.Overrides.Apply(stateDB); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to apply state override")
+		}
+	}
+
+	res, err := k.ApplyMessageWithStateDB(ctx, msg, cfg, stateDB)
+	if err != nil {
+		return nil, err
+	}
+
+	// The dirty states in `StateDB` is either committed or discarded after return
+	if commit {
+		if err := stateDB.Commit(); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to commit stateDB")
+		}
+		k.SetStateRootTransient(ctx, types.IntermediateStateRoot(k.GetStateRootTransient(ctx), stateDB.Commitment()))
+	}
+
+	return res, nil
+}
+
+// ApplyMessageWithStateDB applies the message on top of the given StateDB without committing it, the state
+// overrides of the config are ignored. It allows to apply several messages on the same StateDB, each one
+// seeing the state changes of the previous ones, the caller is expected to set the TxConfig of the StateDB
+// in between. The logs of the response are the ones emitted by the message.
+func (k *Keeper) ApplyMessageWithStateDB(
+	ctx sdk.Context,
+	msg core.Message,
+	cfg *EVMConfig,
+	stateDB *statedb.StateDB,
 ) (*types.MsgEthereumTxResponse, error) {
 	var (
 		ret   []byte // return bytes from evm execution
@@ -355,14 +421,7 @@ func (k *Keeper) ApplyMessageWithConfig(
 		return nil, errorsmod.Wrap(types.ErrCallDisabled, "failed to call contract")
 	}
 
-	stateDB := statedb.NewWithParams(ctx, k, cfg.TxConfig, cfg.Params)
-	var evm *vm.EVM
-	if cfg.Overrides != nil {
-		if err := cfg.Overrides.Apply(stateDB); err != nil {
-			return nil, errorsmod.Wrap(err, "failed to apply state override")
-		}
-	}
-	evm = k.NewEVM(ctx, msg, cfg, stateDB)
+	evm := k.NewEVM(ctx, msg, cfg, stateDB)
 	leftoverGas := msg.GasLimit
 	sender := vm.AccountRef(msg.From)
 	// Allow the tracer captures the tx level events, mainly the gas consumption.
@@ -409,7 +468,7 @@ func (k *Keeper) ApplyMessageWithConfig(
 	// Execute the preparatory steps for state transition which includes:
 	// - prepare accessList(post-berlin)
 	// - reset transient storage(eip 1153)
-	stateDB.Prepare(rules, msg.From, cfg.CoinBase, msg.To, vm.DefaultActivePrecompiles(rules), msg.AccessList)
+	stateDB.Prepare(rules, msg.From, cfg.CoinBase, msg.To, evm.ActivePrecompiles(rules), msg.AccessList)
 
 	if contractCreation {
 		// take over the nonce management from evm:
@@ -443,13 +502,6 @@ func (k *Keeper) ApplyMessageWithConfig(
 		vmError = vmErr.Error()
 	}
 
-	// The dirty states in `StateDB` is either committed or discarded after return
-	if commit {
-		if err := stateDB.Commit(); err != nil {
-			return nil, errorsmod.Wrap(err, "failed to commit stateDB")
-		}
-	}
-
 	// calculate a minimum amount of gas to be charged to sender if GasLimit
 	// is considerably higher than GasUsed to stay more aligned with Tendermint gas mechanics
 	// for more info https://github.com/evmos/ethermint/issues/1085
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	tmtypes "github.com/cometbft/cometbft/types"
@@ -14,6 +15,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
@@ -508,7 +510,7 @@ func (suite *StateTransitionTestSuite) TestRefundGas() {
 			refund := keeper.GasToRefund(vmdb.GetRefund(), gasUsed, tc.refundQuotient)
 			suite.Require().Equal(tc.expGasRefund, refund)
 
-			err = suite.App.EvmKeeper.RefundGas(suite.Ctx, m, refund, "maal")
+			err = suite.App.EvmKeeper.RefundGas(suite.Ctx, m, m.From, refund, "maal")
 			if tc.noError {
 				suite.Require().NoError(err)
 			} else {
@@ -519,6 +521,97 @@ func (suite *StateTransitionTestSuite) TestRefundGas() {
 	suite.mintFeeCollector = false
 }
 
+func (suite *StateTransitionTestSuite) TestBurnBaseFee() {
+	testCases := []struct {
+		name      string
+		burn      bool
+		ratio     sdk.Dec
+		baseFee   *big.Int
+		gasUsed   uint64
+		expBurned int64
+		expErr    bool
+	}{
+		{"burning disabled", false, sdk.OneDec(), big.NewInt(100), 100, 0, false},
+		{"burn the whole base fee", true, sdk.OneDec(), big.NewInt(100), 100, 10000, false},
+		{"burn half of the base fee", true, sdk.NewDecWithPrec(5, 1), big.NewInt(100), 100, 5000, false},
+		{"zero ratio", true, sdk.ZeroDec(), big.NewInt(100), 100, 0, false},
+		{"nil base fee", true, sdk.OneDec(), nil, 100, 0, false},
+		{"insufficient fee collector balance", true, sdk.OneDec(), big.NewInt(1000), 100, 0, true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.mintFeeCollector = true
+			suite.SetupTest() // reset
+
+			fmParams := suite.App.FeeMarketKeeper.GetParams(suite.Ctx)
+			fmParams.BurnBaseFee = tc.burn
+			fmParams.BurnRatio = tc.ratio
+			suite.Require().NoError(suite.App.FeeMarketKeeper.SetParams(suite.Ctx, fmParams))
+
+			denom := suite.App.EvmKeeper.GetParams(suite.Ctx).EvmDenom
+			feeCollector := suite.App.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+			balanceBefore := suite.App.BankKeeper.GetBalance(suite.Ctx, feeCollector, denom).Amount
+			supplyBefore := suite.App.BankKeeper.GetSupply(suite.Ctx, denom).Amount
+
+			err := suite.App.EvmKeeper.BurnBaseFee(suite.Ctx, tc.baseFee, tc.gasUsed, denom)
+			if tc.expErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			burned := sdkmath.NewInt(tc.expBurned)
+			suite.Require().Equal(balanceBefore.Sub(burned), suite.App.BankKeeper.GetBalance(suite.Ctx, feeCollector, denom).Amount)
+			suite.Require().Equal(supplyBefore.Sub(burned), suite.App.BankKeeper.GetSupply(suite.Ctx, denom).Amount)
+			suite.Require().Equal(burned, suite.App.FeeMarketKeeper.GetBurnedFees(suite.Ctx).AmountOf(denom))
+		})
+	}
+	suite.mintFeeCollector = false
+}
+
+func (suite *StateTransitionTestSuite) TestUseGrantedFees() {
+	testCases := []struct {
+		name     string
+		limit    int64
+		grant    bool
+		gasUsed  uint64
+		expLimit int64
+		expErr   bool
+	}{
+		{"charged for the gas used", 10000, true, 100, 9000, false},
+		{"no gas used", 10000, true, 0, 10000, false},
+		{"spend limit too low", 500, true, 100, 500, true},
+		{"no allowance", 0, false, 100, 0, true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			denom := suite.App.EvmKeeper.GetParams(suite.Ctx).EvmDenom
+			granter := tests.GenerateAddress()
+			msg := core.Message{From: tests.GenerateAddress(), GasPrice: big.NewInt(10)}
+			if tc.grant {
+				allowance := &feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin(denom, tc.limit))}
+				err := suite.App.FeeGrantKeeper.GrantAllowance(suite.Ctx, granter.Bytes(), msg.From.Bytes(), allowance)
+				suite.Require().NoError(err)
+			}
+
+			err := suite.App.EvmKeeper.UseGrantedFees(suite.Ctx, msg, &types.MsgEthereumTx{}, granter, tc.gasUsed, denom)
+			if tc.expErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			allowance, err := suite.App.FeeGrantKeeper.GetAllowance(suite.Ctx, granter.Bytes(), msg.From.Bytes())
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expLimit, allowance.(*feegrant.BasicAllowance).SpendLimit.AmountOf(denom).Int64())
+		})
+	}
+}
+
 func (suite *StateTransitionTestSuite) TestResetGasMeterAndConsumeGas() {
 	testCases := []struct {
 		name        string
@@ -730,6 +823,69 @@ func (suite *StateTransitionTestSuite) TestApplyMessageWithConfig() {
 	}
 }
 
+func (suite *StateTransitionTestSuite) TestIntermediateStateRoot() {
+	suite.SetupTest()
+
+	proposerAddress := suite.Ctx.BlockHeader().ProposerAddress
+	config, err := suite.App.EvmKeeper.EVMConfig(suite.Ctx, proposerAddress, big.NewInt(9000), common.Hash{})
+	suite.Require().NoError(err)
+	config.TxConfig = suite.App.EvmKeeper.TxConfig(suite.Ctx, common.Hash{})
+	signer := ethtypes.LatestSignerForChainID(suite.App.EvmKeeper.ChainID())
+	chainCfg := suite.App.EvmKeeper.GetParams(suite.Ctx).ChainConfig.EthereumConfig(suite.App.EvmKeeper.ChainID())
+
+	parent := suite.App.EvmKeeper.GetStateRoot(suite.Ctx)
+	suite.Require().Equal(parent, suite.App.EvmKeeper.GetStateRootTransient(suite.Ctx))
+
+	roots := make([]common.Hash, 0, 2)
+	for i := 0; i < 2; i++ {
+		msg, err := newNativeMessage(
+			suite.StateDB().GetNonce(suite.Address),
+			suite.Ctx.BlockHeight(),
+			suite.Address,
+			chainCfg,
+			suite.Signer,
+			signer,
+			ethtypes.AccessListTxType,
+			nil,
+			nil,
+		)
+		suite.Require().NoError(err)
+		res, err := suite.App.EvmKeeper.ApplyMessageWithConfig(suite.Ctx, msg, config, true)
+		suite.Require().NoError(err)
+		suite.Require().False(res.Failed())
+
+		root := suite.App.EvmKeeper.GetStateRootTransient(suite.Ctx)
+		suite.Require().NotEqual(parent, root)
+		roots = append(roots, root)
+		parent = root
+	}
+	suite.Require().NotEqual(roots[0], roots[1])
+
+	// a message which isn't committed doesn't change the root
+	msg, err := newNativeMessage(
+		suite.StateDB().GetNonce(suite.Address),
+		suite.Ctx.BlockHeight(),
+		suite.Address,
+		chainCfg,
+		suite.Signer,
+		signer,
+		ethtypes.AccessListTxType,
+		nil,
+		nil,
+	)
+	suite.Require().NoError(err)
+	_, err = suite.App.EvmKeeper.ApplyMessageWithConfig(suite.Ctx, msg, config, false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(roots[1], suite.App.EvmKeeper.GetStateRootTransient(suite.Ctx))
+
+	// the root of the block is persisted and emitted at the end of the block
+	suite.App.EvmKeeper.EndBlock(suite.Ctx, abci.RequestEndBlock{})
+	suite.Require().Equal(roots[1], suite.App.EvmKeeper.GetStateRoot(suite.Ctx))
+	events := suite.Ctx.EventManager().Events()
+	suite.Require().Equal(types.EventTypeBlockStateRoot, events[len(events)-1].Type)
+	suite.Require().Equal(roots[1].Hex(), events[len(events)-1].Attributes[0].Value)
+}
+
 func (suite *StateTransitionTestSuite) createContractGethMsg(nonce uint64, signer ethtypes.Signer, gasPrice *big.Int) (core.Message, error) {
 	ethMsg, err := utiltx.CreateContractMsgTx(nonce, signer, gasPrice, suite.Address, suite.Signer)
 	if err != nil {
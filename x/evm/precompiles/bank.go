@@ -0,0 +1,120 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package precompiles
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/evmos/ethermint/x/evm/statedb"
+)
+
+var bankABI = mustParseABI(`[
+  {"type":"function","name":"balanceOf","stateMutability":"view","inputs":[{"name":"account","type":"address"},{"name":"denom","type":"string"}],"outputs":[{"name":"","type":"uint256"}]},
+  {"type":"function","name":"totalSupply","stateMutability":"view","inputs":[{"name":"denom","type":"string"}],"outputs":[{"name":"","type":"uint256"}]},
+  {"type":"function","name":"transfer","stateMutability":"nonpayable","inputs":[{"name":"recipient","type":"address"},{"name":"denom","type":"string"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+  {"type":"event","name":"Transfer","anonymous":false,"inputs":[{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},{"name":"denom","type":"string","indexed":false},{"name":"amount","type":"uint256","indexed":false}]}
+]`)
+
+var _ vm.PrecompiledContract = (*BankContract)(nil)
+
+// BankContract exposes the x/bank module to the EVM, it allows contracts to query and transfer native denoms.
+type BankContract struct {
+	baseContract
+	bankKeeper bankkeeper.Keeper
+	msgServer  banktypes.MsgServer
+}
+
+// NewBankContract creates the bank precompiled contract
+func NewBankContract(bankKeeper bankkeeper.Keeper) *BankContract {
+	return &BankContract{
+		baseContract: newBaseContract(BankContractAddress, bankABI, map[string]uint64{
+			"balanceOf":   GasQuery,
+			"totalSupply": GasQuery,
+			"transfer":    30_000,
+		}),
+		bankKeeper: bankKeeper,
+		msgServer:  bankkeeper.NewMsgServerImpl(bankKeeper),
+	}
+}
+
+// Run implements vm.PrecompiledContract
+func (bc *BankContract) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error) {
+	stateDB, method, args, err := bc.setup(evm, contract, readonly)
+	if err != nil {
+		return nil, err
+	}
+
+	switch method.Name {
+	case "balanceOf":
+		account := args[0].(common.Address)
+		denom := args[1].(string)
+		balance := bc.bankKeeper.GetBalance(stateDB.CacheContext(), sdk.AccAddress(account.Bytes()), denom)
+		return method.Outputs.Pack(balance.Amount.BigInt())
+	case "totalSupply":
+		denom := args[0].(string)
+		supply := bc.bankKeeper.GetSupply(stateDB.CacheContext(), denom)
+		return method.Outputs.Pack(supply.Amount.BigInt())
+	case "transfer":
+		recipient := args[0].(common.Address)
+		denom := args[1].(string)
+		amount := args[2].(*big.Int)
+		msg := &banktypes.MsgSend{
+			FromAddress: sdk.AccAddress(contract.CallerAddress.Bytes()).String(),
+			ToAddress:   sdk.AccAddress(recipient.Bytes()).String(),
+			Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdk.NewIntFromBigInt(amount))),
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, err
+		}
+		if err := stateDB.ExecuteNativeAction(bc.address, bc.eventConverter(), func(ctx sdk.Context) error {
+			_, err := bc.msgServer.Send(sdk.WrapSDKContext(ctx), msg)
+			return err
+		}); err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(true)
+	default:
+		return nil, ErrUnknownMethod
+	}
+}
+
+func (bc *BankContract) eventConverter() statedb.EventConverter {
+	return eventConverter(map[string]statedb.EventConverter{
+		banktypes.EventTypeTransfer: func(event sdk.Event) (*ethtypes.Log, error) {
+			attrs := eventAttributes(event)
+			from, err := accAddressToEth(attrs[banktypes.AttributeKeySender])
+			if err != nil {
+				return nil, err
+			}
+			to, err := accAddressToEth(attrs[banktypes.AttributeKeyRecipient])
+			if err != nil {
+				return nil, err
+			}
+			coin, err := sdk.ParseCoinNormalized(attrs[sdk.AttributeKeyAmount])
+			if err != nil {
+				return nil, err
+			}
+			return bc.newLog("Transfer", from, to, coin.Denom, coin.Amount.BigInt())
+		},
+	})
+}
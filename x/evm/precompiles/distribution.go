@@ -0,0 +1,111 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package precompiles
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/evmos/ethermint/x/evm/statedb"
+)
+
+var distributionABI = mustParseABI(`[
+  {"type":"function","name":"delegationRewards","stateMutability":"view","inputs":[{"name":"delegator","type":"address"},{"name":"validator","type":"string"}],"outputs":[{"name":"","type":"tuple[]","components":[{"name":"denom","type":"string"},{"name":"amount","type":"uint256"}]}]},
+  {"type":"function","name":"withdrawDelegatorRewards","stateMutability":"nonpayable","inputs":[{"name":"validator","type":"string"}],"outputs":[{"name":"","type":"tuple[]","components":[{"name":"denom","type":"string"},{"name":"amount","type":"uint256"}]}]},
+  {"type":"event","name":"WithdrawRewards","anonymous":false,"inputs":[{"name":"delegator","type":"address","indexed":true},{"name":"validator","type":"string","indexed":false},{"name":"amount","type":"tuple[]","indexed":false,"components":[{"name":"denom","type":"string"},{"name":"amount","type":"uint256"}]}]}
+]`)
+
+var _ vm.PrecompiledContract = (*DistributionContract)(nil)
+
+// DistributionContract exposes the x/distribution module to the EVM, the caller of the contract is the delegator.
+type DistributionContract struct {
+	baseContract
+	querier   distrtypes.QueryServer
+	msgServer distrtypes.MsgServer
+}
+
+// NewDistributionContract creates the distribution precompiled contract
+func NewDistributionContract(distrKeeper distrkeeper.Keeper) *DistributionContract {
+	return &DistributionContract{
+		baseContract: newBaseContract(DistributionContractAddress, distributionABI, map[string]uint64{
+			"delegationRewards":        GasQuery,
+			"withdrawDelegatorRewards": 50_000,
+		}),
+		querier:   distrkeeper.NewQuerier(distrKeeper),
+		msgServer: distrkeeper.NewMsgServerImpl(distrKeeper),
+	}
+}
+
+// Run implements vm.PrecompiledContract
+func (dc *DistributionContract) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error) {
+	stateDB, method, args, err := dc.setup(evm, contract, readonly)
+	if err != nil {
+		return nil, err
+	}
+
+	switch method.Name {
+	case "delegationRewards":
+		delegator := args[0].(common.Address)
+		res, err := dc.querier.DelegationRewards(sdk.WrapSDKContext(stateDB.CacheContext()), &distrtypes.QueryDelegationRewardsRequest{
+			DelegatorAddress: sdk.AccAddress(delegator.Bytes()).String(),
+			ValidatorAddress: args[1].(string),
+		})
+		if err != nil {
+			return nil, err
+		}
+		rewards, _ := res.Rewards.TruncateDecimal()
+		return method.Outputs.Pack(NewCoinsFromSDK(rewards))
+	case "withdrawDelegatorRewards":
+		msg := &distrtypes.MsgWithdrawDelegatorReward{
+			DelegatorAddress: sdk.AccAddress(contract.CallerAddress.Bytes()).String(),
+			ValidatorAddress: args[0].(string),
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, err
+		}
+		var res *distrtypes.MsgWithdrawDelegatorRewardResponse
+		if err := stateDB.ExecuteNativeAction(dc.address, dc.eventConverter(), func(ctx sdk.Context) error {
+			res, err = dc.msgServer.WithdrawDelegatorReward(sdk.WrapSDKContext(ctx), msg)
+			return err
+		}); err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(NewCoinsFromSDK(res.Amount))
+	default:
+		return nil, ErrUnknownMethod
+	}
+}
+
+func (dc *DistributionContract) eventConverter() statedb.EventConverter {
+	return eventConverter(map[string]statedb.EventConverter{
+		distrtypes.EventTypeWithdrawRewards: func(event sdk.Event) (*ethtypes.Log, error) {
+			attrs := eventAttributes(event)
+			delegator, err := accAddressToEth(attrs[distrtypes.AttributeKeyDelegator])
+			if err != nil {
+				return nil, err
+			}
+			amount, err := sdk.ParseCoinsNormalized(attrs[sdk.AttributeKeyAmount])
+			if err != nil {
+				return nil, err
+			}
+			return dc.newLog("WithdrawRewards", delegator, attrs[distrtypes.AttributeKeyValidator], NewCoinsFromSDK(amount))
+		},
+	})
+}
@@ -0,0 +1,99 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package precompiles
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/evmos/ethermint/x/evm/statedb"
+)
+
+var govABI = mustParseABI(`[
+  {"type":"function","name":"vote","stateMutability":"nonpayable","inputs":[{"name":"proposalId","type":"uint64"},{"name":"option","type":"int32"},{"name":"metadata","type":"string"}],"outputs":[{"name":"","type":"bool"}]},
+  {"type":"event","name":"Vote","anonymous":false,"inputs":[{"name":"voter","type":"address","indexed":true},{"name":"proposalId","type":"uint64","indexed":true},{"name":"option","type":"string","indexed":false}]}
+]`)
+
+var _ vm.PrecompiledContract = (*GovContract)(nil)
+
+// GovContract exposes the x/gov module to the EVM, the caller of the contract is the voter.
+type GovContract struct {
+	baseContract
+	msgServer govv1.MsgServer
+}
+
+// NewGovContract creates the gov precompiled contract
+func NewGovContract(govKeeper *govkeeper.Keeper) *GovContract {
+	return &GovContract{
+		baseContract: newBaseContract(GovContractAddress, govABI, map[string]uint64{
+			"vote": 30_000,
+		}),
+		msgServer: govkeeper.NewMsgServerImpl(govKeeper),
+	}
+}
+
+// Run implements vm.PrecompiledContract
+func (gc *GovContract) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error) {
+	stateDB, method, args, err := gc.setup(evm, contract, readonly)
+	if err != nil {
+		return nil, err
+	}
+
+	switch method.Name {
+	case "vote":
+		msg := govv1.NewMsgVote(
+			sdk.AccAddress(contract.CallerAddress.Bytes()),
+			args[0].(uint64),
+			govv1.VoteOption(args[1].(int32)),
+			args[2].(string),
+		)
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, err
+		}
+		if err := stateDB.ExecuteNativeAction(gc.address, gc.eventConverter(), func(ctx sdk.Context) error {
+			_, err := gc.msgServer.Vote(sdk.WrapSDKContext(ctx), msg)
+			return err
+		}); err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(true)
+	default:
+		return nil, ErrUnknownMethod
+	}
+}
+
+func (gc *GovContract) eventConverter() statedb.EventConverter {
+	return eventConverter(map[string]statedb.EventConverter{
+		govtypes.EventTypeProposalVote: func(event sdk.Event) (*ethtypes.Log, error) {
+			attrs := eventAttributes(event)
+			voter, err := accAddressToEth(attrs[govtypes.AttributeKeyVoter])
+			if err != nil {
+				return nil, err
+			}
+			proposalID, err := strconv.ParseUint(attrs[govtypes.AttributeKeyProposalID], 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			return gc.newLog("Vote", voter, proposalID, attrs[govtypes.AttributeKeyOption])
+		},
+	})
+}
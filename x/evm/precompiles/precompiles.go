@@ -0,0 +1,230 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package precompiles
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/evmos/ethermint/x/evm/statedb"
+)
+
+// Fixed addresses of the built-in stateful precompiled contracts.
+var (
+	BankContractAddress         = common.BytesToAddress([]byte{100})
+	StakingContractAddress      = common.BytesToAddress([]byte{101})
+	DistributionContractAddress = common.BytesToAddress([]byte{102})
+	GovContractAddress          = common.BytesToAddress([]byte{103})
+)
+
+const (
+	// GasPerInputByte is charged for every byte of the call input on top of the method cost.
+	GasPerInputByte uint64 = 3
+	// GasQuery is the flat cost of read-only methods.
+	GasQuery uint64 = 5_000
+)
+
+var (
+	// ErrUnknownMethod is returned when the input doesn't match any method of the contract ABI.
+	ErrUnknownMethod = errors.New("unknown method")
+	// ErrNonPayable is returned when value is sent to a precompile.
+	ErrNonPayable = errors.New("precompile is not payable")
+	// ErrUnsupportedStateDB is returned when the EVM doesn't run on top of the native `statedb.StateDB`.
+	ErrUnsupportedStateDB = errors.New("precompile requires a statedb with native action support")
+)
+
+// ExtStateDB defines the extra methods a `vm.StateDB` must implement to support stateful precompiles.
+type ExtStateDB interface {
+	vm.StateDB
+	ExecuteNativeAction(contract common.Address, converter statedb.EventConverter, action func(ctx sdk.Context) error) error
+	CacheContext() sdk.Context
+}
+
+var _ ExtStateDB = (*statedb.StateDB)(nil)
+
+// Coin is the ABI representation of a native coin.
+type Coin struct {
+	Denom  string
+	Amount *big.Int
+}
+
+// NewCoinsFromSDK converts sdk coins into their ABI representation.
+func NewCoinsFromSDK(coins sdk.Coins) []Coin {
+	result := make([]Coin, len(coins))
+	for i, coin := range coins {
+		result[i] = Coin{Denom: coin.Denom, Amount: coin.Amount.BigInt()}
+	}
+	return result
+}
+
+// baseContract implements the boilerplate shared by the built-in precompiles:
+// ABI decoding, gas calculation and event conversion.
+type baseContract struct {
+	address common.Address
+	abi     abi.ABI
+	// gas costs of each method, indexed by method name
+	gasCosts map[string]uint64
+}
+
+func newBaseContract(address common.Address, contractABI abi.ABI, gasCosts map[string]uint64) baseContract {
+	return baseContract{
+		address:  address,
+		abi:      contractABI,
+		gasCosts: gasCosts,
+	}
+}
+
+// mustParseABI parses the json ABI of a precompile, it panics on error since the ABIs are constants.
+func mustParseABI(abiJSON string) abi.ABI {
+	contractABI, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		panic(fmt.Errorf("invalid precompile abi: %w", err))
+	}
+	return contractABI
+}
+
+// Address implements vm.PrecompiledContract
+func (bc baseContract) Address() common.Address {
+	return bc.address
+}
+
+// ABI returns the contract ABI
+func (bc baseContract) ABI() abi.ABI {
+	return bc.abi
+}
+
+// RequiredGas implements vm.PrecompiledContract, unknown methods are charged the base cost only,
+// they'll fail in `Run` anyway.
+func (bc baseContract) RequiredGas(input []byte) uint64 {
+	cost := uint64(len(input)) * GasPerInputByte
+	if len(input) < 4 {
+		return cost
+	}
+	method, err := bc.abi.MethodById(input[:4])
+	if err != nil {
+		return cost
+	}
+	return cost + bc.gasCosts[method.Name]
+}
+
+// setup validates the common preconditions of a precompile call and decodes the input.
+func (bc baseContract) setup(evm *vm.EVM, contract *vm.Contract, readonly bool) (ExtStateDB, *abi.Method, []interface{}, error) {
+	stateDB, ok := evm.StateDB.(ExtStateDB)
+	if !ok {
+		return nil, nil, nil, ErrUnsupportedStateDB
+	}
+	if contract.Value() != nil && contract.Value().Sign() != 0 {
+		return nil, nil, nil, ErrNonPayable
+	}
+	if len(contract.Input) < 4 {
+		return nil, nil, nil, ErrUnknownMethod
+	}
+	method, err := bc.abi.MethodById(contract.Input[:4])
+	if err != nil {
+		return nil, nil, nil, ErrUnknownMethod
+	}
+	if readonly && !method.IsConstant() {
+		return nil, nil, nil, vm.ErrWriteProtection
+	}
+	args, err := method.Inputs.Unpack(contract.Input[4:])
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("invalid input for method %s: %w", method.Name, err)
+	}
+	return stateDB, method, args, nil
+}
+
+// newLog builds an ethereum log for the event, the indexed arguments are encoded into topics,
+// the others into data, the argument order must follow the event declaration.
+func (bc baseContract) newLog(name string, args ...interface{}) (*ethtypes.Log, error) {
+	event, ok := bc.abi.Events[name]
+	if !ok {
+		return nil, fmt.Errorf("event %s not found", name)
+	}
+	if len(args) != len(event.Inputs) {
+		return nil, fmt.Errorf("event %s expects %d arguments, got %d", name, len(event.Inputs), len(args))
+	}
+
+	topics := []common.Hash{event.ID}
+	var nonIndexed []interface{}
+	for i, input := range event.Inputs {
+		if !input.Indexed {
+			nonIndexed = append(nonIndexed, args[i])
+			continue
+		}
+		topic, err := abi.MakeTopics([]interface{}{args[i]})
+		if err != nil {
+			return nil, err
+		}
+		topics = append(topics, topic[0][0])
+	}
+
+	data, err := event.Inputs.NonIndexed().Pack(nonIndexed...)
+	if err != nil {
+		return nil, err
+	}
+	return &ethtypes.Log{
+		Address: bc.address,
+		Topics:  topics,
+		Data:    data,
+	}, nil
+}
+
+// eventConverter returns an `statedb.EventConverter` which dispatches native events by type,
+// events without a registered converter are ignored.
+func eventConverter(converters map[string]statedb.EventConverter) statedb.EventConverter {
+	return func(event sdk.Event) (*ethtypes.Log, error) {
+		converter, ok := converters[event.Type]
+		if !ok {
+			return nil, nil
+		}
+		return converter(event)
+	}
+}
+
+// eventAttributes returns the event attributes as a map
+func eventAttributes(event sdk.Event) map[string]string {
+	attrs := make(map[string]string, len(event.Attributes))
+	for _, attr := range event.Attributes {
+		attrs[attr.Key] = attr.Value
+	}
+	return attrs
+}
+
+// accAddressToEth converts a bech32 account address into an ethereum address
+func accAddressToEth(bech32 string) (common.Address, error) {
+	addr, err := sdk.AccAddressFromBech32(bech32)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return common.BytesToAddress(addr), nil
+}
+
+// parseCoinAmount parses the amount of a single coin from an event attribute
+func parseCoinAmount(value string) (*big.Int, error) {
+	coin, err := sdk.ParseCoinNormalized(value)
+	if err != nil {
+		return nil, err
+	}
+	return coin.Amount.BigInt(), nil
+}
@@ -0,0 +1,219 @@
+package precompiles_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/evmos/ethermint/testutil"
+	"github.com/evmos/ethermint/x/evm/precompiles"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+const testDenom = "ucoin"
+
+type PrecompilesTestSuite struct {
+	testutil.BaseTestSuiteWithAccount
+}
+
+func TestPrecompilesTestSuite(t *testing.T) {
+	suite.Run(t, new(PrecompilesTestSuite))
+}
+
+func (suite *PrecompilesTestSuite) SetupTest() {
+	suite.BaseTestSuiteWithAccount.SetupTest(suite.T())
+}
+
+// applyCall executes a call to the precompile through the evm keeper and commits the result.
+func (suite *PrecompilesTestSuite) applyCall(to common.Address, input []byte) *types.MsgEthereumTxResponse {
+	msg := core.Message{
+		From:      suite.Address,
+		To:        &to,
+		Value:     big.NewInt(0),
+		GasLimit:  1_000_000,
+		GasPrice:  big.NewInt(0),
+		GasFeeCap: big.NewInt(0),
+		GasTipCap: big.NewInt(0),
+		Data:      input,
+	}
+	res, err := suite.App.EvmKeeper.ApplyMessage(suite.Ctx, msg, nil, true)
+	suite.Require().NoError(err)
+	return res
+}
+
+// newEVM creates an evm on top of a fresh statedb, to inspect the precompiles at a lower level.
+func (suite *PrecompilesTestSuite) newEVM() (*vm.EVM, *statedb.StateDB) {
+	cfg, err := suite.App.EvmKeeper.EVMConfig(suite.Ctx, suite.Ctx.BlockHeader().ProposerAddress, suite.App.EvmKeeper.ChainID(), common.Hash{})
+	suite.Require().NoError(err)
+	stateDB := statedb.New(suite.Ctx, suite.App.EvmKeeper, cfg.TxConfig)
+	msg := core.Message{From: suite.Address, Value: big.NewInt(0), GasPrice: big.NewInt(0)}
+	return suite.App.EvmKeeper.NewEVM(suite.Ctx, msg, cfg, stateDB), stateDB
+}
+
+func (suite *PrecompilesTestSuite) fund(coins sdk.Coins) {
+	err := testutil.FundAccount(suite.App.BankKeeper, suite.Ctx, sdk.AccAddress(suite.Address.Bytes()), coins)
+	suite.Require().NoError(err)
+}
+
+func (suite *PrecompilesTestSuite) TestBankTransfer() {
+	suite.fund(sdk.NewCoins(sdk.NewInt64Coin(testDenom, 1000)))
+	bankABI := precompiles.NewBankContract(suite.App.BankKeeper).ABI()
+	recipient := common.BigToAddress(big.NewInt(0xdead))
+
+	input, err := bankABI.Pack("transfer", recipient, testDenom, big.NewInt(100))
+	suite.Require().NoError(err)
+	res := suite.applyCall(precompiles.BankContractAddress, input)
+	suite.Require().False(res.Failed(), res.VmError)
+
+	balance := suite.App.BankKeeper.GetBalance(suite.Ctx, sdk.AccAddress(recipient.Bytes()), testDenom)
+	suite.Require().Equal(int64(100), balance.Amount.Int64())
+
+	suite.Require().Len(res.Logs, 1)
+	log := res.Logs[0]
+	suite.Require().Equal(precompiles.BankContractAddress.Hex(), log.Address)
+	suite.Require().Equal(bankABI.Events["Transfer"].ID.Hex(), log.Topics[0])
+	suite.Require().Equal(common.BytesToHash(suite.Address.Bytes()).Hex(), log.Topics[1])
+	suite.Require().Equal(common.BytesToHash(recipient.Bytes()).Hex(), log.Topics[2])
+	data, err := bankABI.Events["Transfer"].Inputs.NonIndexed().Unpack(log.Data)
+	suite.Require().NoError(err)
+	suite.Require().Equal(testDenom, data[0])
+	suite.Require().Equal(big.NewInt(100), data[1])
+
+	input, err = bankABI.Pack("balanceOf", suite.Address, testDenom)
+	suite.Require().NoError(err)
+	res = suite.applyCall(precompiles.BankContractAddress, input)
+	suite.Require().False(res.Failed(), res.VmError)
+	out, err := bankABI.Unpack("balanceOf", res.Ret)
+	suite.Require().NoError(err)
+	suite.Require().Equal(big.NewInt(900), out[0])
+}
+
+func (suite *PrecompilesTestSuite) TestBankTransferRevert() {
+	suite.fund(sdk.NewCoins(sdk.NewInt64Coin(testDenom, 1000)))
+	bankABI := precompiles.NewBankContract(suite.App.BankKeeper).ABI()
+	recipient := common.BigToAddress(big.NewInt(0xdead))
+	input, err := bankABI.Pack("transfer", recipient, testDenom, big.NewInt(100))
+	suite.Require().NoError(err)
+
+	evm, stateDB := suite.newEVM()
+	snapshot := stateDB.Snapshot()
+	_, _, err = evm.Call(vm.AccountRef(suite.Address), precompiles.BankContractAddress, input, 1_000_000, big.NewInt(0))
+	suite.Require().NoError(err)
+	suite.Require().Len(stateDB.Logs(), 1)
+	stateDB.RevertToSnapshot(snapshot)
+	suite.Require().NoError(stateDB.Commit())
+
+	suite.Require().Empty(stateDB.Logs())
+	balance := suite.App.BankKeeper.GetBalance(suite.Ctx, sdk.AccAddress(suite.Address.Bytes()), testDenom)
+	suite.Require().Equal(int64(1000), balance.Amount.Int64())
+}
+
+func (suite *PrecompilesTestSuite) TestReadonly() {
+	suite.fund(sdk.NewCoins(sdk.NewInt64Coin(testDenom, 1000)))
+	bankABI := precompiles.NewBankContract(suite.App.BankKeeper).ABI()
+
+	evm, _ := suite.newEVM()
+	input, err := bankABI.Pack("transfer", common.BigToAddress(big.NewInt(0xdead)), testDenom, big.NewInt(100))
+	suite.Require().NoError(err)
+	_, _, err = evm.StaticCall(vm.AccountRef(suite.Address), precompiles.BankContractAddress, input, 1_000_000)
+	suite.Require().ErrorIs(err, vm.ErrWriteProtection)
+
+	input, err = bankABI.Pack("totalSupply", testDenom)
+	suite.Require().NoError(err)
+	ret, _, err := evm.StaticCall(vm.AccountRef(suite.Address), precompiles.BankContractAddress, input, 1_000_000)
+	suite.Require().NoError(err)
+	out, err := bankABI.Unpack("totalSupply", ret)
+	suite.Require().NoError(err)
+	suite.Require().Equal(1, out[0].(*big.Int).Cmp(big.NewInt(999)))
+}
+
+func (suite *PrecompilesTestSuite) TestStakingAndDistribution() {
+	bondDenom := suite.App.StakingKeeper.BondDenom(suite.Ctx)
+	suite.fund(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000)))
+	valAddr := sdk.ValAddress(suite.Address.Bytes())
+	suite.Require().NoError(suite.App.DistrKeeper.Hooks().AfterValidatorCreated(suite.Ctx, valAddr))
+
+	stakingABI := precompiles.NewStakingContract(suite.App.StakingKeeper).ABI()
+	input, err := stakingABI.Pack("delegate", valAddr.String(), big.NewInt(600))
+	suite.Require().NoError(err)
+	res := suite.applyCall(precompiles.StakingContractAddress, input)
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().NotEmpty(res.Logs)
+	suite.Require().Equal(stakingABI.Events["Delegate"].ID.Hex(), res.Logs[len(res.Logs)-1].Topics[0])
+
+	input, err = stakingABI.Pack("delegation", suite.Address, valAddr.String())
+	suite.Require().NoError(err)
+	res = suite.applyCall(precompiles.StakingContractAddress, input)
+	suite.Require().False(res.Failed(), res.VmError)
+	out, err := stakingABI.Unpack("delegation", res.Ret)
+	suite.Require().NoError(err)
+	suite.Require().Equal(big.NewInt(600), out[1])
+
+	// allocate rewards to the validator in the next block, the only delegator gets them all
+	suite.Ctx = suite.Ctx.WithBlockHeight(suite.Ctx.BlockHeight() + 1)
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100))
+	suite.Require().NoError(suite.App.BankKeeper.MintCoins(suite.Ctx, minttypes.ModuleName, rewards))
+	suite.Require().NoError(suite.App.BankKeeper.SendCoinsFromModuleToModule(suite.Ctx, minttypes.ModuleName, distrtypes.ModuleName, rewards))
+	validator, found := suite.App.StakingKeeper.GetValidator(suite.Ctx, valAddr)
+	suite.Require().True(found)
+	suite.App.DistrKeeper.AllocateTokensToValidator(suite.Ctx, validator, sdk.NewDecCoinsFromCoins(rewards...))
+
+	distrABI := precompiles.NewDistributionContract(suite.App.DistrKeeper).ABI()
+	input, err = distrABI.Pack("delegationRewards", suite.Address, valAddr.String())
+	suite.Require().NoError(err)
+	res = suite.applyCall(precompiles.DistributionContractAddress, input)
+	suite.Require().False(res.Failed(), res.VmError)
+	out, err = distrABI.Unpack("delegationRewards", res.Ret)
+	suite.Require().NoError(err)
+	suite.Require().Len(out[0], 1)
+
+	before := suite.App.BankKeeper.GetBalance(suite.Ctx, sdk.AccAddress(suite.Address.Bytes()), bondDenom)
+	input, err = distrABI.Pack("withdrawDelegatorRewards", valAddr.String())
+	suite.Require().NoError(err)
+	res = suite.applyCall(precompiles.DistributionContractAddress, input)
+	suite.Require().False(res.Failed(), res.VmError)
+	after := suite.App.BankKeeper.GetBalance(suite.Ctx, sdk.AccAddress(suite.Address.Bytes()), bondDenom)
+	suite.Require().True(after.Amount.GT(before.Amount))
+	suite.Require().Equal(distrABI.Events["WithdrawRewards"].ID.Hex(), res.Logs[len(res.Logs)-1].Topics[0])
+}
+
+func (suite *PrecompilesTestSuite) TestGovVote() {
+	proposer := sdk.AccAddress(suite.Address.Bytes())
+	proposal, err := suite.App.GovKeeper.SubmitProposal(suite.Ctx, nil, "", "title", "summary", proposer)
+	suite.Require().NoError(err)
+	suite.App.GovKeeper.ActivateVotingPeriod(suite.Ctx, proposal)
+
+	govABI := precompiles.NewGovContract(&suite.App.GovKeeper).ABI()
+	input, err := govABI.Pack("vote", proposal.Id, int32(govv1.OptionYes), "")
+	suite.Require().NoError(err)
+	res := suite.applyCall(precompiles.GovContractAddress, input)
+	suite.Require().False(res.Failed(), res.VmError)
+
+	vote, found := suite.App.GovKeeper.GetVote(suite.Ctx, proposal.Id, proposer)
+	suite.Require().True(found)
+	suite.Require().Equal(govv1.OptionYes, vote.Options[0].Option)
+	suite.Require().Equal(sdkmath.LegacyOneDec().String(), vote.Options[0].Weight)
+	suite.Require().Len(res.Logs, 1)
+	suite.Require().Equal(common.BigToHash(new(big.Int).SetUint64(proposal.Id)).Hex(), res.Logs[0].Topics[2])
+}
+
+func (suite *PrecompilesTestSuite) TestNonPayable() {
+	suite.fund(sdk.NewCoins(sdk.NewInt64Coin(types.DefaultEVMDenom, 1000)))
+	bankABI := precompiles.NewBankContract(suite.App.BankKeeper).ABI()
+	input, err := bankABI.Pack("totalSupply", testDenom)
+	suite.Require().NoError(err)
+
+	evm, _ := suite.newEVM()
+	_, _, err = evm.Call(vm.AccountRef(suite.Address), precompiles.BankContractAddress, input, 1_000_000, big.NewInt(1))
+	suite.Require().ErrorIs(err, precompiles.ErrNonPayable)
+}
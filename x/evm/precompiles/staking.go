@@ -0,0 +1,193 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package precompiles
+
+import (
+	"math/big"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/evmos/ethermint/x/evm/statedb"
+)
+
+var stakingABI = mustParseABI(`[
+  {"type":"function","name":"delegation","stateMutability":"view","inputs":[{"name":"delegator","type":"address"},{"name":"validator","type":"string"}],"outputs":[{"name":"shares","type":"uint256"},{"name":"balance","type":"uint256"}]},
+  {"type":"function","name":"delegate","stateMutability":"nonpayable","inputs":[{"name":"validator","type":"string"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+  {"type":"function","name":"undelegate","stateMutability":"nonpayable","inputs":[{"name":"validator","type":"string"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"completionTime","type":"int64"}]},
+  {"type":"function","name":"redelegate","stateMutability":"nonpayable","inputs":[{"name":"srcValidator","type":"string"},{"name":"dstValidator","type":"string"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"completionTime","type":"int64"}]},
+  {"type":"event","name":"Delegate","anonymous":false,"inputs":[{"name":"delegator","type":"address","indexed":true},{"name":"validator","type":"string","indexed":false},{"name":"amount","type":"uint256","indexed":false}]},
+  {"type":"event","name":"Unbond","anonymous":false,"inputs":[{"name":"delegator","type":"address","indexed":true},{"name":"validator","type":"string","indexed":false},{"name":"amount","type":"uint256","indexed":false},{"name":"completionTime","type":"int64","indexed":false}]},
+  {"type":"event","name":"Redelegate","anonymous":false,"inputs":[{"name":"delegator","type":"address","indexed":true},{"name":"srcValidator","type":"string","indexed":false},{"name":"dstValidator","type":"string","indexed":false},{"name":"amount","type":"uint256","indexed":false},{"name":"completionTime","type":"int64","indexed":false}]}
+]`)
+
+var _ vm.PrecompiledContract = (*StakingContract)(nil)
+
+// StakingContract exposes the x/staking module to the EVM, the caller of the contract is the delegator,
+// amounts are denominated in the bond denom.
+type StakingContract struct {
+	baseContract
+	stakingKeeper *stakingkeeper.Keeper
+	msgServer     stakingtypes.MsgServer
+}
+
+// NewStakingContract creates the staking precompiled contract
+func NewStakingContract(stakingKeeper *stakingkeeper.Keeper) *StakingContract {
+	return &StakingContract{
+		baseContract: newBaseContract(StakingContractAddress, stakingABI, map[string]uint64{
+			"delegation": GasQuery,
+			"delegate":   60_000,
+			"undelegate": 60_000,
+			"redelegate": 80_000,
+		}),
+		stakingKeeper: stakingKeeper,
+		msgServer:     stakingkeeper.NewMsgServerImpl(stakingKeeper),
+	}
+}
+
+// Run implements vm.PrecompiledContract
+func (sc *StakingContract) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error) {
+	stateDB, method, args, err := sc.setup(evm, contract, readonly)
+	if err != nil {
+		return nil, err
+	}
+
+	delegator := sdk.AccAddress(contract.CallerAddress.Bytes())
+	converter := sc.eventConverter(contract.CallerAddress)
+	switch method.Name {
+	case "delegation":
+		account := args[0].(common.Address)
+		valAddr, err := sdk.ValAddressFromBech32(args[1].(string))
+		if err != nil {
+			return nil, err
+		}
+		ctx := stateDB.CacheContext()
+		shares, balance := big.NewInt(0), big.NewInt(0)
+		delegation, found := sc.stakingKeeper.GetDelegation(ctx, sdk.AccAddress(account.Bytes()), valAddr)
+		if found {
+			shares = delegation.Shares.BigInt()
+			if validator, found := sc.stakingKeeper.GetValidator(ctx, valAddr); found {
+				balance = validator.TokensFromShares(delegation.Shares).TruncateInt().BigInt()
+			}
+		}
+		return method.Outputs.Pack(shares, balance)
+	case "delegate":
+		if err := stateDB.ExecuteNativeAction(sc.address, converter, func(ctx sdk.Context) error {
+			msg := &stakingtypes.MsgDelegate{
+				DelegatorAddress: delegator.String(),
+				ValidatorAddress: args[0].(string),
+				Amount:           sc.bondCoin(ctx, args[1].(*big.Int)),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			_, err := sc.msgServer.Delegate(sdk.WrapSDKContext(ctx), msg)
+			return err
+		}); err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(true)
+	case "undelegate":
+		var res *stakingtypes.MsgUndelegateResponse
+		if err := stateDB.ExecuteNativeAction(sc.address, converter, func(ctx sdk.Context) error {
+			msg := &stakingtypes.MsgUndelegate{
+				DelegatorAddress: delegator.String(),
+				ValidatorAddress: args[0].(string),
+				Amount:           sc.bondCoin(ctx, args[1].(*big.Int)),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			res, err = sc.msgServer.Undelegate(sdk.WrapSDKContext(ctx), msg)
+			return err
+		}); err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(res.CompletionTime.Unix())
+	case "redelegate":
+		var res *stakingtypes.MsgBeginRedelegateResponse
+		if err := stateDB.ExecuteNativeAction(sc.address, converter, func(ctx sdk.Context) error {
+			msg := &stakingtypes.MsgBeginRedelegate{
+				DelegatorAddress:    delegator.String(),
+				ValidatorSrcAddress: args[0].(string),
+				ValidatorDstAddress: args[1].(string),
+				Amount:              sc.bondCoin(ctx, args[2].(*big.Int)),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			res, err = sc.msgServer.BeginRedelegate(sdk.WrapSDKContext(ctx), msg)
+			return err
+		}); err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(res.CompletionTime.Unix())
+	default:
+		return nil, ErrUnknownMethod
+	}
+}
+
+func (sc *StakingContract) bondCoin(ctx sdk.Context, amount *big.Int) sdk.Coin {
+	return sdk.NewCoin(sc.stakingKeeper.BondDenom(ctx), sdk.NewIntFromBigInt(amount))
+}
+
+// eventConverter converts the staking events, which don't carry the delegator address,
+// so it's captured from the caller of the precompile.
+func (sc *StakingContract) eventConverter(delegator common.Address) statedb.EventConverter {
+	return eventConverter(map[string]statedb.EventConverter{
+		stakingtypes.EventTypeDelegate: func(event sdk.Event) (*ethtypes.Log, error) {
+			attrs := eventAttributes(event)
+			amount, err := parseCoinAmount(attrs[sdk.AttributeKeyAmount])
+			if err != nil {
+				return nil, err
+			}
+			return sc.newLog("Delegate", delegator, attrs[stakingtypes.AttributeKeyValidator], amount)
+		},
+		stakingtypes.EventTypeUnbond: func(event sdk.Event) (*ethtypes.Log, error) {
+			attrs := eventAttributes(event)
+			amount, err := parseCoinAmount(attrs[sdk.AttributeKeyAmount])
+			if err != nil {
+				return nil, err
+			}
+			completionTime, err := time.Parse(time.RFC3339, attrs[stakingtypes.AttributeKeyCompletionTime])
+			if err != nil {
+				return nil, err
+			}
+			return sc.newLog("Unbond", delegator, attrs[stakingtypes.AttributeKeyValidator], amount, completionTime.Unix())
+		},
+		stakingtypes.EventTypeRedelegate: func(event sdk.Event) (*ethtypes.Log, error) {
+			attrs := eventAttributes(event)
+			amount, err := parseCoinAmount(attrs[sdk.AttributeKeyAmount])
+			if err != nil {
+				return nil, err
+			}
+			completionTime, err := time.Parse(time.RFC3339, attrs[stakingtypes.AttributeKeyCompletionTime])
+			if err != nil {
+				return nil, err
+			}
+			return sc.newLog(
+				"Redelegate", delegator,
+				attrs[stakingtypes.AttributeKeySrcValidator], attrs[stakingtypes.AttributeKeyDstValidator],
+				amount, completionTime.Unix(),
+			)
+		},
+	})
+}
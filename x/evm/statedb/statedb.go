@@ -84,6 +84,9 @@ type StateDB struct {
 	// handle balances natively
 	evmDenom string
 	err      error
+
+	// hash of the EVM state changes written by Commit
+	commitment common.Hash
 }
 
 // New creates a new state from a given trie.
@@ -124,6 +127,14 @@ func (s *StateDB) Keeper() Keeper {
 	return s.keeper
 }
 
+// SetTxConfig starts a new transaction on the StateDB, the logs and the refund counter of the previous
+// one are discarded, it's used to apply several messages on the same StateDB.
+func (s *StateDB) SetTxConfig(txConfig TxConfig) {
+	s.txConfig = txConfig
+	s.logs = nil
+	s.refund = 0
+}
+
 // AddLog adds a log, called by evm.
 func (s *StateDB) AddLog(log *ethtypes.Log) {
 	s.journal.append(addLogChange{})
@@ -605,13 +616,20 @@ func (s *StateDB) Commit() error {
 		s.ctx.EventManager().EmitEvents(s.nativeEvents)
 	}
 
+	// the commitment hashes the writes in the deterministic order they are applied
+	hasher := crypto.NewKeccakState()
 	for _, addr := range s.journal.sortedDirties() {
 		obj := s.stateObjects[addr]
+		hasher.Write(addr.Bytes()) //nolint:errcheck
 		if obj.suicided {
+			hasher.Write([]byte{1}) //nolint:errcheck
 			if err := s.keeper.DeleteAccount(s.ctx, obj.Address()); err != nil {
 				return errorsmod.Wrap(err, "failed to delete account")
 			}
 		} else {
+			hasher.Write([]byte{0})                          //nolint:errcheck
+			hasher.Write(sdk.Uint64ToBigEndian(obj.Nonce())) //nolint:errcheck
+			hasher.Write(obj.CodeHash())                     //nolint:errcheck
 			codeDirty := obj.codeDirty()
 			if codeDirty && obj.code != nil {
 				s.keeper.SetCode(s.ctx, obj.CodeHash(), obj.code)
@@ -627,13 +645,23 @@ func (s *StateDB) Commit() error {
 				if value == obj.originStorage[key] {
 					continue
 				}
+				hasher.Write(key.Bytes())   //nolint:errcheck
+				hasher.Write(value.Bytes()) //nolint:errcheck
 				s.keeper.SetState(s.ctx, obj.Address(), key, value.Bytes())
 			}
 		}
 	}
+	hasher.Read(s.commitment[:]) //nolint:errcheck
 	return nil
 }
 
+// Commitment returns the hash of the EVM state changes written by the last Commit: the nonce and code hash
+// of the dirty accounts, the deleted accounts and the changed storage slots, ordered by address and key.
+// The balances are managed by the bank module and are not part of it.
+func (s *StateDB) Commitment() common.Hash {
+	return s.commitment
+}
+
 func (s *StateDB) emitNativeEvents(contract common.Address, converter EventConverter, events []sdk.Event) {
 	if converter == nil {
 		return
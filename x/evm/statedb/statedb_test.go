@@ -821,7 +821,7 @@ func newTestKeeper(t *testing.T, cms sdk.MultiStore) (sdk.Context, *evmkeeper.Ke
 	}
 	evmKeeper := evmkeeper.NewKeeper(
 		appCodec, testStoreKeys[evmtypes.StoreKey], testTransientKeys[evmtypes.TransientKey], authtypes.NewModuleAddress(govtypes.ModuleName),
-		accountKeeper, bankKeeper, nil, nil,
+		accountKeeper, bankKeeper, nil, nil, nil,
 		"",
 		paramstypes.Subspace{}, nil,
 		allKeys,
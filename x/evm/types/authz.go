@@ -0,0 +1,140 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"bytes"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// selectorLength is the length of the function selectors of the contract calls.
+const selectorLength = 4
+
+var _ authz.Authorization = &EVMCallAuthorization{}
+
+// NewEVMCallAuthorization creates an authorization to call the given contracts, restricted to the
+// given function selectors if any, transferring at most spendLimit in total.
+func NewEVMCallAuthorization(contracts []common.Address, selectors [][]byte, spendLimit sdkmath.Int) *EVMCallAuthorization {
+	a := &EVMCallAuthorization{
+		AllowedContracts: make([]string, len(contracts)),
+		SpendLimit:       spendLimit,
+	}
+	for i, contract := range contracts {
+		a.AllowedContracts[i] = contract.Hex()
+	}
+	for _, selector := range selectors {
+		a.AllowedSelectors = append(a.AllowedSelectors, hexutil.Encode(selector))
+	}
+	return a
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a EVMCallAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgEVMCall{})
+}
+
+// Accept implements Authorization.Accept. The call is accepted if it targets one of the allowed
+// contracts and functions and its value is within the spend limit, which is decreased by the value.
+func (a EVMCallAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	msgCall, ok := msg.(*MsgEVMCall)
+	if !ok {
+		return authz.AcceptResponse{}, errortypes.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	to := common.HexToAddress(msgCall.To)
+	if !a.isContractAllowed(ctx, to) {
+		return authz.AcceptResponse{}, errortypes.ErrUnauthorized.Wrapf("cannot call contract %s", to)
+	}
+
+	if !a.isSelectorAllowed(ctx, msgCall.Data) {
+		return authz.AcceptResponse{}, errortypes.ErrUnauthorized.Wrapf("cannot call function of input %s", hexutil.Encode(msgCall.Data))
+	}
+
+	if msgCall.Value.IsZero() {
+		return authz.AcceptResponse{Accept: true}, nil
+	}
+
+	if msgCall.Value.GT(a.SpendLimit) {
+		return authz.AcceptResponse{}, errortypes.ErrInsufficientFunds.Wrapf("requested value is more than spend limit")
+	}
+
+	return authz.AcceptResponse{
+		Accept: true,
+		Updated: &EVMCallAuthorization{
+			AllowedContracts: a.AllowedContracts,
+			AllowedSelectors: a.AllowedSelectors,
+			SpendLimit:       a.SpendLimit.Sub(msgCall.Value),
+		},
+	}, nil
+}
+
+func (a EVMCallAuthorization) isContractAllowed(ctx sdk.Context, to common.Address) bool {
+	for _, contract := range a.AllowedContracts {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "evm call authorization")
+		if common.HexToAddress(contract) == to {
+			return true
+		}
+	}
+	return false
+}
+
+func (a EVMCallAuthorization) isSelectorAllowed(ctx sdk.Context, data []byte) bool {
+	if len(a.AllowedSelectors) == 0 {
+		return true
+	}
+	if len(data) < selectorLength {
+		return false
+	}
+	for _, selector := range a.AllowedSelectors {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "evm call authorization")
+		if bytes.Equal(common.FromHex(selector), data[:selectorLength]) {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a EVMCallAuthorization) ValidateBasic() error {
+	if len(a.AllowedContracts) == 0 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "allowed contracts cannot be empty")
+	}
+	for _, contract := range a.AllowedContracts {
+		if !common.IsHexAddress(contract) {
+			return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid contract address %s", contract)
+		}
+	}
+
+	for _, selector := range a.AllowedSelectors {
+		decoded, err := hexutil.Decode(selector)
+		if err != nil || len(decoded) != selectorLength {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid function selector %s", selector)
+		}
+	}
+
+	if a.SpendLimit.IsNil() || a.SpendLimit.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidAmount, "spend limit cannot be nil or negative: %s", a.SpendLimit)
+	}
+
+	return nil
+}
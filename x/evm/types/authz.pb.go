@@ -0,0 +1,439 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ethermint/evm/v1/authz.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EVMCallAuthorization allows the grantee to call contracts on behalf of the granter through
+// MsgEVMCall, the expiration of the authorization is the one of the grant.
+type EVMCallAuthorization struct {
+	// allowed_contracts are the hex addresses of the contracts the grantee can call.
+	AllowedContracts []string `protobuf:"bytes,1,rep,name=allowed_contracts,json=allowedContracts,proto3" json:"allowed_contracts,omitempty"`
+	// allowed_selectors are the hex encoded 4-byte function selectors the grantee can call, any
+	// function can be called if empty.
+	AllowedSelectors []string `protobuf:"bytes,2,rep,name=allowed_selectors,json=allowedSelectors,proto3" json:"allowed_selectors,omitempty"`
+	// spend_limit is the total value, in evm denom, the grantee can transfer with the calls.
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=spend_limit,json=spendLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"spend_limit"`
+}
+
+func (m *EVMCallAuthorization) Reset()         { *m = EVMCallAuthorization{} }
+func (m *EVMCallAuthorization) String() string { return proto.CompactTextString(m) }
+func (*EVMCallAuthorization) ProtoMessage()    {}
+func (*EVMCallAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a033ddac454e12c6, []int{0}
+}
+func (m *EVMCallAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EVMCallAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EVMCallAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EVMCallAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EVMCallAuthorization.Merge(m, src)
+}
+func (m *EVMCallAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *EVMCallAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_EVMCallAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EVMCallAuthorization proto.InternalMessageInfo
+
+func (m *EVMCallAuthorization) GetAllowedContracts() []string {
+	if m != nil {
+		return m.AllowedContracts
+	}
+	return nil
+}
+
+func (m *EVMCallAuthorization) GetAllowedSelectors() []string {
+	if m != nil {
+		return m.AllowedSelectors
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*EVMCallAuthorization)(nil), "ethermint.evm.v1.EVMCallAuthorization")
+}
+
+func init() { proto.RegisterFile("ethermint/evm/v1/authz.proto", fileDescriptor_a033ddac454e12c6) }
+
+var fileDescriptor_a033ddac454e12c6 = []byte{
+	// 307 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0xcf, 0x4a, 0x33, 0x31,
+	0x14, 0xc5, 0x27, 0x5f, 0xe1, 0x83, 0x8e, 0x9b, 0x5a, 0xba, 0xa8, 0x45, 0xd2, 0xd2, 0x45, 0x29,
+	0x48, 0x33, 0x0c, 0xee, 0x5c, 0x69, 0x8b, 0x0b, 0x41, 0x11, 0x2a, 0xb8, 0x70, 0x53, 0xd2, 0x69,
+	0xe8, 0x04, 0x93, 0xb9, 0x65, 0x72, 0x1b, 0xb5, 0x4f, 0xe1, 0xc3, 0xf8, 0x10, 0xc5, 0x55, 0x97,
+	0xe2, 0xa2, 0x48, 0x67, 0xe5, 0x5b, 0xc8, 0xfc, 0xa9, 0xb6, 0xab, 0x24, 0xe7, 0x1c, 0xee, 0xc9,
+	0xfd, 0xb9, 0xc7, 0x02, 0x43, 0x11, 0x6b, 0x19, 0xa1, 0x27, 0xac, 0xf6, 0xac, 0xef, 0xf1, 0x39,
+	0x86, 0x0b, 0x36, 0x8b, 0x01, 0xa1, 0x5a, 0xf9, 0x75, 0x99, 0xb0, 0x9a, 0x59, 0xbf, 0x71, 0x14,
+	0x80, 0xd1, 0x60, 0x46, 0x99, 0xef, 0xe5, 0x8f, 0x3c, 0xdc, 0xa8, 0x4d, 0x61, 0x0a, 0xb9, 0x9e,
+	0xde, 0x72, 0xb5, 0xfd, 0x4d, 0xdc, 0xda, 0xe5, 0xfd, 0xcd, 0x80, 0x2b, 0x75, 0x31, 0xc7, 0x10,
+	0x62, 0xb9, 0xe0, 0x28, 0x21, 0xaa, 0x9e, 0xb8, 0x87, 0x5c, 0x29, 0x78, 0x12, 0x93, 0x51, 0x00,
+	0x11, 0xc6, 0x3c, 0x40, 0x53, 0x27, 0xad, 0x52, 0xb7, 0x3c, 0xac, 0x14, 0xc6, 0x60, 0xab, 0xef,
+	0x86, 0x8d, 0x50, 0x22, 0x40, 0x88, 0x4d, 0xfd, 0xdf, 0x5e, 0xf8, 0x6e, 0xab, 0x57, 0x6f, 0xdd,
+	0x03, 0x33, 0x13, 0xd1, 0x64, 0xa4, 0xa4, 0x96, 0x58, 0x2f, 0xb5, 0x48, 0xb7, 0xdc, 0x67, 0xcb,
+	0x75, 0xd3, 0xf9, 0x5c, 0x37, 0x3b, 0x53, 0x89, 0xe1, 0x7c, 0xcc, 0x02, 0xd0, 0xc5, 0xf7, 0x8b,
+	0xa3, 0x67, 0x26, 0x8f, 0x1e, 0xbe, 0xcc, 0x84, 0x61, 0x57, 0x11, 0x0e, 0xdd, 0x6c, 0xc4, 0x75,
+	0x3a, 0xe1, 0xac, 0xf3, 0xfe, 0xd6, 0x6b, 0x17, 0xbb, 0xe6, 0x78, 0xac, 0x3f, 0x16, 0xc8, 0x7d,
+	0xb6, 0xb7, 0x52, 0xff, 0x7c, 0xb9, 0xa1, 0x64, 0xb5, 0xa1, 0xe4, 0x6b, 0x43, 0xc9, 0x6b, 0x42,
+	0x9d, 0x55, 0x42, 0x9d, 0x8f, 0x84, 0x3a, 0x0f, 0xbb, 0xad, 0xc2, 0xa6, 0xa5, 0x7f, 0xdc, 0x9f,
+	0x33, 0xf2, 0x59, 0xf3, 0xf8, 0x7f, 0x06, 0xed, 0xf4, 0x67, 0x00, 0x06, 0xb5, 0x26, 0x8a, 0x97,
+	0x01, 0x00, 0x00,
+}
+
+func (m *EVMCallAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EVMCallAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EVMCallAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SpendLimit.Size()
+		i -= size
+		if _, err := m.SpendLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuthz(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.AllowedSelectors) > 0 {
+		for iNdEx := len(m.AllowedSelectors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedSelectors[iNdEx])
+			copy(dAtA[i:], m.AllowedSelectors[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowedSelectors[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AllowedContracts) > 0 {
+		for iNdEx := len(m.AllowedContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedContracts[iNdEx])
+			copy(dAtA[i:], m.AllowedContracts[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowedContracts[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EVMCallAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedContracts) > 0 {
+		for _, s := range m.AllowedContracts {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.AllowedSelectors) > 0 {
+		for _, s := range m.AllowedSelectors {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	l = m.SpendLimit.Size()
+	n += 1 + l + sovAuthz(uint64(l))
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EVMCallAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EVMCallAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EVMCallAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedContracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedContracts = append(m.AllowedContracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedSelectors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedSelectors = append(m.AllowedSelectors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpendLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
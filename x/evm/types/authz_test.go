@@ -0,0 +1,146 @@
+package types
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/tests"
+)
+
+func TestEVMCallAuthorizationValidateBasic(t *testing.T) {
+	contract := tests.GenerateAddress()
+	selector := []byte{0xa9, 0x05, 0x9c, 0xbb}
+
+	testCases := []struct {
+		name          string
+		authorization *EVMCallAuthorization
+		expPass       bool
+	}{
+		{
+			"valid",
+			NewEVMCallAuthorization([]common.Address{contract}, [][]byte{selector}, sdkmath.NewInt(1)),
+			true,
+		},
+		{
+			"valid - any selector",
+			NewEVMCallAuthorization([]common.Address{contract}, nil, sdkmath.ZeroInt()),
+			true,
+		},
+		{
+			"no allowed contracts",
+			NewEVMCallAuthorization(nil, nil, sdkmath.ZeroInt()),
+			false,
+		},
+		{
+			"invalid contract address",
+			&EVMCallAuthorization{AllowedContracts: []string{"invalid"}, SpendLimit: sdkmath.ZeroInt()},
+			false,
+		},
+		{
+			"invalid selector",
+			NewEVMCallAuthorization([]common.Address{contract}, [][]byte{{0x01}}, sdkmath.ZeroInt()),
+			false,
+		},
+		{
+			"negative spend limit",
+			NewEVMCallAuthorization([]common.Address{contract}, nil, sdkmath.NewInt(-1)),
+			false,
+		},
+		{
+			"nil spend limit",
+			&EVMCallAuthorization{AllowedContracts: []string{contract.Hex()}},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.authorization.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestEVMCallAuthorizationAccept(t *testing.T) {
+	contract := tests.GenerateAddress()
+	sender := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	selector := []byte{0xa9, 0x05, 0x9c, 0xbb}
+	input := append(append([]byte{}, selector...), make([]byte, 64)...)
+	ctx := sdk.Context{}.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	authorization := NewEVMCallAuthorization([]common.Address{contract}, [][]byte{selector}, sdkmath.NewInt(100))
+
+	testCases := []struct {
+		name       string
+		msg        sdk.Msg
+		expPass    bool
+		expUpdated *EVMCallAuthorization
+	}{
+		{
+			"allowed call without value",
+			NewMsgEVMCall(sender, contract, input, sdkmath.ZeroInt(), 100000),
+			true,
+			nil,
+		},
+		{
+			"allowed call with value",
+			NewMsgEVMCall(sender, contract, input, sdkmath.NewInt(40), 100000),
+			true,
+			NewEVMCallAuthorization([]common.Address{contract}, [][]byte{selector}, sdkmath.NewInt(60)),
+		},
+		{
+			"value above spend limit",
+			NewMsgEVMCall(sender, contract, input, sdkmath.NewInt(101), 100000),
+			false,
+			nil,
+		},
+		{
+			"contract not allowed",
+			NewMsgEVMCall(sender, tests.GenerateAddress(), input, sdkmath.ZeroInt(), 100000),
+			false,
+			nil,
+		},
+		{
+			"selector not allowed",
+			NewMsgEVMCall(sender, contract, []byte{0x01, 0x02, 0x03, 0x04}, sdkmath.ZeroInt(), 100000),
+			false,
+			nil,
+		},
+		{
+			"input shorter than a selector",
+			NewMsgEVMCall(sender, contract, []byte{0xa9}, sdkmath.ZeroInt(), 100000),
+			false,
+			nil,
+		},
+		{
+			"invalid message type",
+			&banktypes.MsgSend{},
+			false,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		res, err := authorization.Accept(ctx, tc.msg)
+		if !tc.expPass {
+			require.Error(t, err, tc.name)
+			continue
+		}
+
+		require.NoError(t, err, tc.name)
+		require.True(t, res.Accept, tc.name)
+		require.False(t, res.Delete, tc.name)
+		if tc.expUpdated == nil {
+			require.Nil(t, res.Updated, tc.name)
+		} else {
+			require.Equal(t, tc.expUpdated, res.Updated, tc.name)
+		}
+	}
+}
@@ -23,6 +23,8 @@ import (
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	proto "github.com/cosmos/gogoproto/proto"
 )
 
@@ -38,7 +40,10 @@ var (
 
 const (
 	// Amino names
-	updateParamsName = "ethermint/MsgUpdateParams"
+	updateParamsName             = "ethermint/MsgUpdateParams"
+	allowedContractAllowanceName = "ethermint/AllowedContractAllowance"
+	evmCallName                  = "ethermint/MsgEVMCall"
+	evmCallAuthorizationName     = "ethermint/EVMCallAuthorization"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -61,6 +66,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgEthereumTx{},
 		&MsgUpdateParams{},
+		&MsgEVMCall{},
 	)
 	registry.RegisterInterface(
 		"ethermint.evm.v1.TxData",
@@ -69,6 +75,14 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&AccessListTx{},
 		&LegacyTx{},
 	)
+	registry.RegisterImplementations(
+		(*feegrant.FeeAllowanceI)(nil),
+		&AllowedContractAllowance{},
+	)
+	registry.RegisterImplementations(
+		(*authz.Authorization)(nil),
+		&EVMCallAuthorization{},
+	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -108,4 +122,7 @@ func UnpackTxData(any *codectypes.Any) (TxData, error) {
 // RegisterLegacyAminoCodec required for EIP-712
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&AllowedContractAllowance{}, allowedContractAllowanceName, nil)
+	cdc.RegisterConcrete(&MsgEVMCall{}, evmCallName, nil)
+	cdc.RegisterConcrete(&EVMCallAuthorization{}, evmCallAuthorizationName, nil)
 }
@@ -17,9 +17,10 @@ package types
 
 // Evm module events
 const (
-	EventTypeEthereumTx = TypeMsgEthereumTx
-	EventTypeBlockBloom = "block_bloom"
-	EventTypeTxLog      = "tx_log"
+	EventTypeEthereumTx     = TypeMsgEthereumTx
+	EventTypeBlockBloom     = "block_bloom"
+	EventTypeBlockStateRoot = "block_state_root"
+	EventTypeTxLog          = "tx_log"
 
 	AttributeKeyContractAddress = "contract"
 	AttributeKeyRecipient       = "recipient"
@@ -33,6 +34,7 @@ const (
 	AttributeKeyEthereumTxFailed = "ethereumTxFailed"
 	AttributeValueCategory       = ModuleName
 	AttributeKeyEthereumBloom    = "bloom"
+	AttributeKeyStateRoot        = "stateRoot"
 
 	MetricKeyTransitionDB = "transition_db"
 	MetricKeyStaticCall   = "static_call"
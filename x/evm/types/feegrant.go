@@ -0,0 +1,158 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// gasCostPerIteration is the gas consumed for each allowed contract checked, as for the allowed
+// messages of the feegrant module.
+const gasCostPerIteration = uint64(10)
+
+var (
+	_ feegrant.FeeAllowanceI             = (*AllowedContractAllowance)(nil)
+	_ codectypes.UnpackInterfacesMessage = (*AllowedContractAllowance)(nil)
+)
+
+// NewAllowedContractAllowance creates a fee allowance restricted to the ethereum transactions
+// calling the given contracts.
+func NewAllowedContractAllowance(allowance feegrant.FeeAllowanceI, contracts []common.Address) (*AllowedContractAllowance, error) {
+	a := &AllowedContractAllowance{
+		AllowedContracts: make([]string, len(contracts)),
+	}
+	for i, contract := range contracts {
+		a.AllowedContracts[i] = contract.Hex()
+	}
+	if err := a.SetAllowance(allowance); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a *AllowedContractAllowance) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var allowance feegrant.FeeAllowanceI
+	return unpacker.UnpackAny(a.Allowance, &allowance)
+}
+
+// GetAllowance returns the wrapped fee allowance.
+func (a *AllowedContractAllowance) GetAllowance() (feegrant.FeeAllowanceI, error) {
+	allowance, ok := a.Allowance.GetCachedValue().(feegrant.FeeAllowanceI)
+	if !ok {
+		return nil, errorsmod.Wrap(feegrant.ErrNoAllowance, "failed to get allowance")
+	}
+
+	return allowance, nil
+}
+
+// SetAllowance sets the wrapped fee allowance.
+func (a *AllowedContractAllowance) SetAllowance(allowance feegrant.FeeAllowanceI) error {
+	msg, ok := allowance.(proto.Message)
+	if !ok {
+		return errorsmod.Wrapf(errortypes.ErrPackAny, "cannot proto marshal %T", allowance)
+	}
+
+	var err error
+	a.Allowance, err = codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return errorsmod.Wrapf(errortypes.ErrPackAny, "cannot proto marshal %T", allowance)
+	}
+
+	return nil
+}
+
+// Accept checks that all the messages are ethereum transactions calling one of the allowed
+// contracts before delegating to the wrapped allowance.
+func (a *AllowedContractAllowance) Accept(ctx sdk.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	if !a.allContractsAllowed(ctx, msgs) {
+		return false, errorsmod.Wrap(feegrant.ErrMessageNotAllowed, "transaction doesn't call an allowed contract")
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return false, err
+	}
+
+	remove, err := allowance.Accept(ctx, fee, msgs)
+	if err == nil && !remove {
+		if err = a.SetAllowance(allowance); err != nil {
+			return false, err
+		}
+	}
+	return remove, err
+}
+
+func (a *AllowedContractAllowance) allContractsAllowed(ctx sdk.Context, msgs []sdk.Msg) bool {
+	contracts := make(map[common.Address]bool, len(a.AllowedContracts))
+	for _, contract := range a.AllowedContracts {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "check contract")
+		contracts[common.HexToAddress(contract)] = true
+	}
+
+	for _, msg := range msgs {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "check contract")
+		ethMsg, ok := msg.(*MsgEthereumTx)
+		if !ok {
+			return false
+		}
+		to := ethMsg.AsTransaction().To()
+		if to == nil || !contracts[*to] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ValidateBasic implements FeeAllowance and enforces basic sanity checks
+func (a *AllowedContractAllowance) ValidateBasic() error {
+	if a.Allowance == nil {
+		return errorsmod.Wrap(feegrant.ErrNoAllowance, "allowance should not be empty")
+	}
+	if len(a.AllowedContracts) == 0 {
+		return errorsmod.Wrap(feegrant.ErrNoMessages, "allowed contracts shouldn't be empty")
+	}
+	for _, contract := range a.AllowedContracts {
+		if !common.IsHexAddress(contract) {
+			return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid contract address %s", contract)
+		}
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return err
+	}
+
+	return allowance.ValidateBasic()
+}
+
+// ExpiresAt returns the expiry time of the wrapped allowance.
+func (a *AllowedContractAllowance) ExpiresAt() (*time.Time, error) {
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return nil, err
+	}
+	return allowance.ExpiresAt()
+}
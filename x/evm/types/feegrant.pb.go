@@ -0,0 +1,379 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ethermint/evm/v1/feegrant.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AllowedContractAllowance is a fee allowance restricting the ethereum transactions whose gas is paid
+// by the granter to the ones calling the given contracts.
+type AllowedContractAllowance struct {
+	// allowance can be any of basic and periodic fee allowance.
+	Allowance *types.Any `protobuf:"bytes,1,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// allowed_contracts are the hex addresses of the contracts the grantee can call.
+	AllowedContracts []string `protobuf:"bytes,2,rep,name=allowed_contracts,json=allowedContracts,proto3" json:"allowed_contracts,omitempty"`
+}
+
+func (m *AllowedContractAllowance) Reset()         { *m = AllowedContractAllowance{} }
+func (m *AllowedContractAllowance) String() string { return proto.CompactTextString(m) }
+func (*AllowedContractAllowance) ProtoMessage()    {}
+func (*AllowedContractAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c023f2958185f28, []int{0}
+}
+func (m *AllowedContractAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllowedContractAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllowedContractAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllowedContractAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllowedContractAllowance.Merge(m, src)
+}
+func (m *AllowedContractAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllowedContractAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllowedContractAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllowedContractAllowance proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*AllowedContractAllowance)(nil), "ethermint.evm.v1.AllowedContractAllowance")
+}
+
+func init() { proto.RegisterFile("ethermint/evm/v1/feegrant.proto", fileDescriptor_6c023f2958185f28) }
+
+var fileDescriptor_6c023f2958185f28 = []byte{
+	// 284 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0x2d, 0xc9, 0x48,
+	0x2d, 0xca, 0xcd, 0xcc, 0x2b, 0xd1, 0x4f, 0x2d, 0xcb, 0xd5, 0x2f, 0x33, 0xd4, 0x4f, 0x4b, 0x4d,
+	0x4d, 0x2f, 0x4a, 0xcc, 0x2b, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0x2b, 0xd0,
+	0x4b, 0x2d, 0xcb, 0xd5, 0x2b, 0x33, 0x94, 0x92, 0x4c, 0xce, 0x2f, 0xce, 0xcd, 0x2f, 0x8e, 0x07,
+	0xcb, 0xeb, 0x43, 0x38, 0x10, 0xc5, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x10, 0x71, 0x10, 0x0b,
+	0x2a, 0x2a, 0x99, 0x9e, 0x9f, 0x9f, 0x9e, 0x93, 0xaa, 0x0f, 0xe6, 0x25, 0x95, 0xa6, 0xe9, 0x27,
+	0xe6, 0x55, 0x42, 0xa4, 0x94, 0xae, 0x32, 0x72, 0x49, 0x38, 0xe6, 0xe4, 0xe4, 0x97, 0xa7, 0xa6,
+	0x38, 0xe7, 0xe7, 0x95, 0x14, 0x25, 0x26, 0x97, 0x80, 0xb9, 0x89, 0x79, 0xc9, 0xa9, 0x42, 0xb1,
+	0x5c, 0x9c, 0x89, 0x30, 0x8e, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x88, 0x1e, 0xc4, 0x2c,
+	0x3d, 0x98, 0x59, 0x7a, 0x8e, 0x79, 0x95, 0x4e, 0x9a, 0xa7, 0xb6, 0xe8, 0xaa, 0x42, 0x1d, 0x02,
+	0x77, 0x7e, 0x99, 0x61, 0x52, 0x6a, 0x49, 0xa2, 0xa1, 0x9e, 0x5b, 0x6a, 0x2a, 0xdc, 0x48, 0xcf,
+	0x20, 0x84, 0x89, 0x42, 0xda, 0x5c, 0x82, 0x89, 0x10, 0xab, 0xe3, 0x93, 0xa1, 0x76, 0x17, 0x4b,
+	0x30, 0x29, 0x30, 0x6b, 0x70, 0x06, 0x09, 0x24, 0xa2, 0xba, 0xa9, 0xd8, 0x4a, 0xb7, 0x63, 0x81,
+	0x3c, 0x03, 0xd1, 0xd6, 0x38, 0x39, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83,
+	0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43,
+	0x94, 0x5a, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0x2e, 0x28, 0xc4, 0xf3, 0x8b,
+	0xf5, 0x11, 0x31, 0x50, 0x01, 0x8e, 0x83, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x0f,
+	0x8d, 0x01, 0x03, 0x00, 0x5e, 0x23, 0x82, 0x51, 0xa1, 0x01, 0x00, 0x00,
+}
+
+func (m *AllowedContractAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowedContractAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowedContractAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedContracts) > 0 {
+		for iNdEx := len(m.AllowedContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedContracts[iNdEx])
+			copy(dAtA[i:], m.AllowedContracts[iNdEx])
+			i = encodeVarintFeegrant(dAtA, i, uint64(len(m.AllowedContracts[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFeegrant(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeegrant(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeegrant(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AllowedContractAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if len(m.AllowedContracts) > 0 {
+		for _, s := range m.AllowedContracts {
+			l = len(s)
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovFeegrant(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeegrant(x uint64) (n int) {
+	return sovFeegrant(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AllowedContractAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowedContractAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowedContractAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &types.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedContracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedContracts = append(m.AllowedContracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFeegrant(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFeegrant
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFeegrant
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFeegrant
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFeegrant        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFeegrant          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFeegrant = fmt.Errorf("proto: unexpected end of group")
+)
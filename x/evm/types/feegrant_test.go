@@ -0,0 +1,151 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/tests"
+)
+
+func TestAllowedContractAllowanceValidateBasic(t *testing.T) {
+	contract := tests.GenerateAddress()
+	basic := &feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("aphoton", 100))}
+
+	testCases := []struct {
+		name      string
+		allowance func() *AllowedContractAllowance
+		expPass   bool
+	}{
+		{
+			"valid",
+			func() *AllowedContractAllowance {
+				a, err := NewAllowedContractAllowance(basic, []common.Address{contract})
+				require.NoError(t, err)
+				return a
+			},
+			true,
+		},
+		{
+			"no allowed contracts",
+			func() *AllowedContractAllowance {
+				a, err := NewAllowedContractAllowance(basic, nil)
+				require.NoError(t, err)
+				return a
+			},
+			false,
+		},
+		{
+			"invalid contract address",
+			func() *AllowedContractAllowance {
+				a, err := NewAllowedContractAllowance(basic, []common.Address{contract})
+				require.NoError(t, err)
+				a.AllowedContracts = append(a.AllowedContracts, "invalid")
+				return a
+			},
+			false,
+		},
+		{
+			"no allowance",
+			func() *AllowedContractAllowance {
+				return &AllowedContractAllowance{AllowedContracts: []string{contract.Hex()}}
+			},
+			false,
+		},
+		{
+			"invalid wrapped allowance",
+			func() *AllowedContractAllowance {
+				invalid := &feegrant.BasicAllowance{SpendLimit: sdk.Coins{sdk.Coin{Denom: "aphoton", Amount: sdk.NewInt(-1)}}}
+				a, err := NewAllowedContractAllowance(invalid, []common.Address{contract})
+				require.NoError(t, err)
+				return a
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.allowance().ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestAllowedContractAllowanceAccept(t *testing.T) {
+	contract := tests.GenerateAddress()
+	other := tests.GenerateAddress()
+	fees := sdk.NewCoins(sdk.NewInt64Coin("aphoton", 10))
+	ctx := sdk.Context{}.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	newAllowance := func(limit int64) *AllowedContractAllowance {
+		basic := &feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("aphoton", limit))}
+		a, err := NewAllowedContractAllowance(basic, []common.Address{contract})
+		require.NoError(t, err)
+		return a
+	}
+
+	testCases := []struct {
+		name      string
+		allowance *AllowedContractAllowance
+		msgs      []sdk.Msg
+		expPass   bool
+		expLimit  sdk.Coins
+	}{
+		{
+			"allowed contract",
+			newAllowance(100),
+			[]sdk.Msg{NewTx(nil, 0, &contract, nil, 21000, big.NewInt(1), nil, nil, nil, nil)},
+			true,
+			sdk.NewCoins(sdk.NewInt64Coin("aphoton", 90)),
+		},
+		{
+			"not allowed contract",
+			newAllowance(100),
+			[]sdk.Msg{NewTx(nil, 0, &other, nil, 21000, big.NewInt(1), nil, nil, nil, nil)},
+			false,
+			nil,
+		},
+		{
+			"contract creation",
+			newAllowance(100),
+			[]sdk.Msg{NewTx(nil, 0, nil, nil, 21000, big.NewInt(1), nil, nil, nil, nil)},
+			false,
+			nil,
+		},
+		{
+			"not an ethereum tx",
+			newAllowance(100),
+			[]sdk.Msg{&banktypes.MsgSend{}},
+			false,
+			nil,
+		},
+		{
+			"spend limit exceeded",
+			newAllowance(5),
+			[]sdk.Msg{NewTx(nil, 0, &contract, nil, 21000, big.NewInt(1), nil, nil, nil, nil)},
+			false,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		_, err := tc.allowance.Accept(ctx, fees, tc.msgs)
+		if !tc.expPass {
+			require.Error(t, err, tc.name)
+			continue
+		}
+
+		require.NoError(t, err, tc.name)
+		allowance, err := tc.allowance.GetAllowance()
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.expLimit, allowance.(*feegrant.BasicAllowance).SpendLimit, tc.name)
+	}
+}
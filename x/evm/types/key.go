@@ -41,6 +41,7 @@ const (
 	prefixCode = iota + 1
 	prefixStorage
 	prefixParams
+	prefixStateRoot
 )
 
 // prefix bytes for the EVM transient store
@@ -49,13 +50,16 @@ const (
 	prefixTransientTxIndex
 	prefixTransientLogSize
 	prefixTransientGasUsed
+	prefixTransientStateRoot
+	prefixTransientFeePayer
 )
 
 // KVStore key prefixes
 var (
-	KeyPrefixCode    = []byte{prefixCode}
-	KeyPrefixStorage = []byte{prefixStorage}
-	KeyPrefixParams  = []byte{prefixParams}
+	KeyPrefixCode      = []byte{prefixCode}
+	KeyPrefixStorage   = []byte{prefixStorage}
+	KeyPrefixParams    = []byte{prefixParams}
+	KeyPrefixStateRoot = []byte{prefixStateRoot}
 )
 
 // Transient Store key prefixes
@@ -64,6 +68,10 @@ var (
 	KeyPrefixTransientTxIndex = []byte{prefixTransientTxIndex}
 	KeyPrefixTransientLogSize = []byte{prefixTransientLogSize}
 	KeyPrefixTransientGasUsed = []byte{prefixTransientGasUsed}
+	// KeyPrefixTransientStateRoot is the intermediate EVM state root after the last executed tx
+	KeyPrefixTransientStateRoot = []byte{prefixTransientStateRoot}
+	// KeyPrefixTransientFeePayer is the granter paying the fees of a sponsored tx, by tx hash
+	KeyPrefixTransientFeePayer = []byte{prefixTransientFeePayer}
 )
 
 // AddressStoragePrefix returns a prefix to iterate over a given account storage.
@@ -37,6 +37,8 @@ import (
 	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	ethermint "github.com/evmos/ethermint/types"
 )
 
 var (
@@ -44,6 +46,7 @@ var (
 	_ sdk.Tx     = &MsgEthereumTx{}
 	_ ante.GasTx = &MsgEthereumTx{}
 	_ sdk.Msg    = &MsgUpdateParams{}
+	_ sdk.Msg    = &MsgEVMCall{}
 
 	_ codectypes.UnpackInterfacesMessage = MsgEthereumTx{}
 )
@@ -54,6 +57,10 @@ const (
 	TypeMsgEthereumTx = "ethereum_tx"
 )
 
+// MaxEVMCallGasLimit is the max gas limit of a MsgEVMCall, the limit being also capped by the gas
+// remaining in the transaction and the block gas limit when executed.
+const MaxEVMCallGasLimit = 30_000_000
+
 // NewTx returns a reference to a new Ethereum transaction message.
 func NewTx(
 	chainID *big.Int, nonce uint64, to *common.Address, amount *big.Int,
@@ -457,3 +464,51 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 func (m MsgUpdateParams) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
 }
+
+// NewMsgEVMCall returns a new MsgEVMCall calling the contract with the sender as caller.
+func NewMsgEVMCall(sender sdk.AccAddress, to common.Address, data []byte, value sdkmath.Int, gasLimit uint64) *MsgEVMCall {
+	return &MsgEVMCall{
+		Sender:   sender.String(),
+		To:       to.Hex(),
+		Data:     data,
+		Value:    value,
+		GasLimit: gasLimit,
+	}
+}
+
+// GetSigners returns the expected signers for a MsgEVMCall message.
+func (m MsgEVMCall) GetSigners() []sdk.AccAddress {
+	//#nosec G703 -- gosec raises a warning about a non-handled error which we deliberately ignore here
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgEVMCall) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	if err := ethermint.ValidateAddress(m.To); err != nil {
+		return errorsmod.Wrap(err, "invalid contract address")
+	}
+
+	if m.Value.IsNil() || m.Value.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidAmount, "value cannot be nil or negative: %s", m.Value)
+	}
+
+	if m.GasLimit == 0 {
+		return errorsmod.Wrap(ErrInvalidGasLimit, "gas limit must not be zero")
+	}
+
+	if m.GasLimit > MaxEVMCallGasLimit {
+		return errorsmod.Wrapf(ErrInvalidGasLimit, "gas limit %d exceeds the max %d", m.GasLimit, MaxEVMCallGasLimit)
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgEVMCall) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
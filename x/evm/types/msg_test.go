@@ -790,6 +790,34 @@ func (suite *MsgsTestSuite) TestTransactionCoding() {
 	}
 }
 
+func (suite *MsgsTestSuite) TestMsgEVMCall_ValidateBasic() {
+	sender := sdk.AccAddress(suite.from.Bytes())
+
+	testCases := []struct {
+		msg     string
+		evmCall *types.MsgEVMCall
+		expPass bool
+	}{
+		{"valid", types.NewMsgEVMCall(sender, suite.to, []byte("test"), sdkmath.NewInt(1), 100000), true},
+		{"invalid sender", &types.MsgEVMCall{Sender: "invalid", To: suite.to.Hex(), Value: sdkmath.ZeroInt(), GasLimit: 100000}, false},
+		{"invalid contract", &types.MsgEVMCall{Sender: sender.String(), To: invalidFromAddress, Value: sdkmath.ZeroInt(), GasLimit: 100000}, false},
+		{"nil value", &types.MsgEVMCall{Sender: sender.String(), To: suite.to.Hex(), GasLimit: 100000}, false},
+		{"negative value", types.NewMsgEVMCall(sender, suite.to, nil, sdkmath.NewInt(-1), 100000), false},
+		{"zero gas limit", types.NewMsgEVMCall(sender, suite.to, nil, sdkmath.ZeroInt(), 0), false},
+		{"gas limit above max", types.NewMsgEVMCall(sender, suite.to, nil, sdkmath.ZeroInt(), types.MaxEVMCallGasLimit+1), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.evmCall.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.msg)
+			suite.Require().Equal([]sdk.AccAddress{sender}, tc.evmCall.GetSigners(), tc.msg)
+		} else {
+			suite.Require().Error(err, tc.msg)
+		}
+	}
+}
+
 func encodeDecodeBinary(tx *ethtypes.Transaction, chainID *big.Int) (*types.MsgEthereumTx, error) {
 	data, err := tx.MarshalBinary()
 	if err != nil {
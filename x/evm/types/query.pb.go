@@ -798,6 +798,8 @@ type EthCallRequest struct {
 	ChainId int64 `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 	// state overrides encoded as json
 	Overrides []byte `protobuf:"bytes,5,opt,name=overrides,proto3" json:"overrides,omitempty"`
+	// block overrides encoded as json
+	BlockOverrides []byte `protobuf:"bytes,6,opt,name=block_overrides,json=blockOverrides,proto3" json:"block_overrides,omitempty"`
 }
 
 func (m *EthCallRequest) Reset()         { *m = EthCallRequest{} }
@@ -868,6 +870,141 @@ func (m *EthCallRequest) GetOverrides() []byte {
 	return nil
 }
 
+func (m *EthCallRequest) GetBlockOverrides() []byte {
+	if m != nil {
+		return m.BlockOverrides
+	}
+	return nil
+}
+
+// EthCallBundleRequest defines EthCallBundle request
+type EthCallBundleRequest struct {
+	// args is the ordered list of calls, each one uses the same json format as the json rpc api.
+	Args [][]byte `protobuf:"bytes,1,rep,name=args,proto3" json:"args,omitempty"`
+	// gas_cap defines the gas cap of the whole bundle
+	GasCap uint64 `protobuf:"varint,2,opt,name=gas_cap,json=gasCap,proto3" json:"gas_cap,omitempty"`
+	// proposer_address of the requested block in hex format
+	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,3,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
+	// chain_id is the eip155 chain id parsed from the requested block header
+	ChainId int64 `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// state overrides encoded as json, applied before the first call
+	Overrides []byte `protobuf:"bytes,5,opt,name=overrides,proto3" json:"overrides,omitempty"`
+}
+
+func (m *EthCallBundleRequest) Reset()         { *m = EthCallBundleRequest{} }
+func (m *EthCallBundleRequest) String() string { return proto.CompactTextString(m) }
+func (*EthCallBundleRequest) ProtoMessage()    {}
+func (*EthCallBundleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{17}
+}
+func (m *EthCallBundleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EthCallBundleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EthCallBundleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EthCallBundleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EthCallBundleRequest.Merge(m, src)
+}
+func (m *EthCallBundleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EthCallBundleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EthCallBundleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EthCallBundleRequest proto.InternalMessageInfo
+
+func (m *EthCallBundleRequest) GetArgs() [][]byte {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+func (m *EthCallBundleRequest) GetGasCap() uint64 {
+	if m != nil {
+		return m.GasCap
+	}
+	return 0
+}
+
+func (m *EthCallBundleRequest) GetProposerAddress() github_com_cosmos_cosmos_sdk_types.ConsAddress {
+	if m != nil {
+		return m.ProposerAddress
+	}
+	return nil
+}
+
+func (m *EthCallBundleRequest) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *EthCallBundleRequest) GetOverrides() []byte {
+	if m != nil {
+		return m.Overrides
+	}
+	return nil
+}
+
+// EthCallBundleResponse defines EthCallBundle response
+type EthCallBundleResponse struct {
+	// results of the calls, in the order of the request
+	Results []*MsgEthereumTxResponse `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (m *EthCallBundleResponse) Reset()         { *m = EthCallBundleResponse{} }
+func (m *EthCallBundleResponse) String() string { return proto.CompactTextString(m) }
+func (*EthCallBundleResponse) ProtoMessage()    {}
+func (*EthCallBundleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{18}
+}
+func (m *EthCallBundleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EthCallBundleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EthCallBundleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EthCallBundleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EthCallBundleResponse.Merge(m, src)
+}
+func (m *EthCallBundleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EthCallBundleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EthCallBundleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EthCallBundleResponse proto.InternalMessageInfo
+
+func (m *EthCallBundleResponse) GetResults() []*MsgEthereumTxResponse {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
 // EstimateGasResponse defines EstimateGas response
 type EstimateGasResponse struct {
 	// gas returns the estimated gas
@@ -883,7 +1020,7 @@ func (m *EstimateGasResponse) Reset()         { *m = EstimateGasResponse{} }
 func (m *EstimateGasResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimateGasResponse) ProtoMessage()    {}
 func (*EstimateGasResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{17}
+	return fileDescriptor_e15a877459347994, []int{19}
 }
 func (m *EstimateGasResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -933,6 +1070,70 @@ func (m *EstimateGasResponse) GetVmError() string {
 	return ""
 }
 
+// CreateAccessListResponse defines CreateAccessList response
+type CreateAccessListResponse struct {
+	// access_list is the access list of the accounts and storage slots used by the message
+	AccessList AccessList `protobuf:"bytes,1,rep,name=access_list,json=accessList,proto3,castrepeated=AccessList" json:"accessList"`
+	// gas_used is the gas used by the message with the access list
+	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// vm_error is the error returned by vm execution
+	VmError string `protobuf:"bytes,3,opt,name=vm_error,json=vmError,proto3" json:"vm_error,omitempty"`
+}
+
+func (m *CreateAccessListResponse) Reset()         { *m = CreateAccessListResponse{} }
+func (m *CreateAccessListResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateAccessListResponse) ProtoMessage()    {}
+func (*CreateAccessListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{20}
+}
+func (m *CreateAccessListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateAccessListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateAccessListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateAccessListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAccessListResponse.Merge(m, src)
+}
+func (m *CreateAccessListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateAccessListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAccessListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateAccessListResponse proto.InternalMessageInfo
+
+func (m *CreateAccessListResponse) GetAccessList() AccessList {
+	if m != nil {
+		return m.AccessList
+	}
+	return nil
+}
+
+func (m *CreateAccessListResponse) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *CreateAccessListResponse) GetVmError() string {
+	if m != nil {
+		return m.VmError
+	}
+	return ""
+}
+
 // QueryTraceTxRequest defines TraceTx request
 type QueryTraceTxRequest struct {
 	// msg is the MsgEthereumTx for the requested transaction
@@ -958,7 +1159,7 @@ func (m *QueryTraceTxRequest) Reset()         { *m = QueryTraceTxRequest{} }
 func (m *QueryTraceTxRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceTxRequest) ProtoMessage()    {}
 func (*QueryTraceTxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{18}
+	return fileDescriptor_e15a877459347994, []int{21}
 }
 func (m *QueryTraceTxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1053,7 +1254,7 @@ func (m *QueryTraceTxResponse) Reset()         { *m = QueryTraceTxResponse{} }
 func (m *QueryTraceTxResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceTxResponse) ProtoMessage()    {}
 func (*QueryTraceTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{19}
+	return fileDescriptor_e15a877459347994, []int{22}
 }
 func (m *QueryTraceTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1113,7 +1314,7 @@ func (m *QueryTraceCallRequest) Reset()         { *m = QueryTraceCallRequest{} }
 func (m *QueryTraceCallRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceCallRequest) ProtoMessage()    {}
 func (*QueryTraceCallRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{20}
+	return fileDescriptor_e15a877459347994, []int{23}
 }
 func (m *QueryTraceCallRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1208,7 +1409,7 @@ func (m *QueryTraceCallResponse) Reset()         { *m = QueryTraceCallResponse{}
 func (m *QueryTraceCallResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceCallResponse) ProtoMessage()    {}
 func (*QueryTraceCallResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{21}
+	return fileDescriptor_e15a877459347994, []int{24}
 }
 func (m *QueryTraceCallResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1266,7 +1467,7 @@ func (m *QueryTraceBlockRequest) Reset()         { *m = QueryTraceBlockRequest{}
 func (m *QueryTraceBlockRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceBlockRequest) ProtoMessage()    {}
 func (*QueryTraceBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{22}
+	return fileDescriptor_e15a877459347994, []int{25}
 }
 func (m *QueryTraceBlockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1354,7 +1555,7 @@ func (m *QueryTraceBlockResponse) Reset()         { *m = QueryTraceBlockResponse
 func (m *QueryTraceBlockResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceBlockResponse) ProtoMessage()    {}
 func (*QueryTraceBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{23}
+	return fileDescriptor_e15a877459347994, []int{26}
 }
 func (m *QueryTraceBlockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1399,7 +1600,7 @@ func (m *QueryBaseFeeRequest) Reset()         { *m = QueryBaseFeeRequest{} }
 func (m *QueryBaseFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeRequest) ProtoMessage()    {}
 func (*QueryBaseFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{24}
+	return fileDescriptor_e15a877459347994, []int{27}
 }
 func (m *QueryBaseFeeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1438,7 +1639,7 @@ func (m *QueryBaseFeeResponse) Reset()         { *m = QueryBaseFeeResponse{} }
 func (m *QueryBaseFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeResponse) ProtoMessage()    {}
 func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{25}
+	return fileDescriptor_e15a877459347994, []int{28}
 }
 func (m *QueryBaseFeeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1485,7 +1686,10 @@ func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "ethermint.evm.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "ethermint.evm.v1.QueryParamsResponse")
 	proto.RegisterType((*EthCallRequest)(nil), "ethermint.evm.v1.EthCallRequest")
+	proto.RegisterType((*EthCallBundleRequest)(nil), "ethermint.evm.v1.EthCallBundleRequest")
+	proto.RegisterType((*EthCallBundleResponse)(nil), "ethermint.evm.v1.EthCallBundleResponse")
 	proto.RegisterType((*EstimateGasResponse)(nil), "ethermint.evm.v1.EstimateGasResponse")
+	proto.RegisterType((*CreateAccessListResponse)(nil), "ethermint.evm.v1.CreateAccessListResponse")
 	proto.RegisterType((*QueryTraceTxRequest)(nil), "ethermint.evm.v1.QueryTraceTxRequest")
 	proto.RegisterType((*QueryTraceTxResponse)(nil), "ethermint.evm.v1.QueryTraceTxResponse")
 	proto.RegisterType((*QueryTraceCallRequest)(nil), "ethermint.evm.v1.QueryTraceCallRequest")
@@ -1499,105 +1703,116 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/query.proto", fileDescriptor_e15a877459347994) }
 
 var fileDescriptor_e15a877459347994 = []byte{
-	// 1559 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x5f, 0x6f, 0x13, 0xc7,
-	0x16, 0xcf, 0xc6, 0x4e, 0x6c, 0x8f, 0x13, 0xf0, 0x1d, 0xc2, 0xc5, 0xec, 0x4d, 0xec, 0xb0, 0x21,
-	0x7f, 0x09, 0xbb, 0x37, 0xbe, 0x57, 0x48, 0xe5, 0xa5, 0x60, 0x2b, 0x50, 0x0a, 0x54, 0x74, 0x1b,
-	0xf5, 0xa1, 0x12, 0xb2, 0xc6, 0xeb, 0x61, 0x6d, 0xc5, 0xbb, 0x63, 0x76, 0xd6, 0xae, 0xc3, 0x9f,
-	0x3e, 0x54, 0x2d, 0xa5, 0x42, 0xaa, 0x90, 0xfa, 0x5e, 0xf1, 0x0d, 0xfa, 0x35, 0x78, 0x44, 0xaa,
-	0x2a, 0x55, 0x7d, 0xa0, 0x08, 0xfa, 0xd0, 0x4f, 0xd0, 0x87, 0x3e, 0x55, 0x33, 0x3b, 0x6b, 0xef,
-	0x7a, 0xbd, 0x76, 0xa8, 0xa8, 0x84, 0xd4, 0x27, 0xcf, 0x9f, 0x33, 0xe7, 0xfc, 0xce, 0x9c, 0x33,
-	0xe7, 0xfc, 0xd6, 0x60, 0x11, 0xbb, 0x0d, 0xec, 0x58, 0x4d, 0xdb, 0xd5, 0x70, 0xd7, 0xd2, 0xba,
-	0x3b, 0xda, 0xed, 0x0e, 0x76, 0x0e, 0xd4, 0xb6, 0x43, 0x5c, 0x02, 0x73, 0xfd, 0x5d, 0x15, 0x77,
-	0x2d, 0xb5, 0xbb, 0x23, 0x6f, 0x19, 0x84, 0x5a, 0x84, 0x6a, 0x35, 0x44, 0xb1, 0x27, 0xaa, 0x75,
-	0x77, 0x6a, 0xd8, 0x45, 0x3b, 0x5a, 0x1b, 0x99, 0x4d, 0x1b, 0xb9, 0x4d, 0x62, 0x7b, 0xa7, 0xe5,
-	0x93, 0x11, 0xdd, 0x6e, 0x4f, 0x6c, 0xc9, 0x91, 0xad, 0x16, 0x31, 0xc5, 0xde, 0x52, 0x64, 0xaf,
-	0x8d, 0x1c, 0x64, 0x51, 0xb1, 0xbd, 0x12, 0xd5, 0xea, 0x20, 0x03, 0x57, 0x0d, 0x62, 0xdf, 0x6a,
-	0xfa, 0x3a, 0x16, 0x4c, 0x62, 0x12, 0x3e, 0xd4, 0xd8, 0x48, 0xac, 0x2e, 0x9a, 0x84, 0x98, 0x2d,
-	0xac, 0xa1, 0x76, 0x53, 0x43, 0xb6, 0x4d, 0x5c, 0x8e, 0xd6, 0x57, 0x5c, 0x14, 0xbb, 0x7c, 0x56,
-	0xeb, 0xdc, 0xd2, 0xdc, 0xa6, 0x85, 0xa9, 0x8b, 0xac, 0xb6, 0x27, 0xa0, 0xbc, 0x03, 0x8e, 0x7d,
-	0xc8, 0x3c, 0xbe, 0x68, 0x18, 0xa4, 0x63, 0xbb, 0x3a, 0xbe, 0xdd, 0xc1, 0xd4, 0x85, 0x79, 0x90,
-	0x42, 0xf5, 0xba, 0x83, 0x29, 0xcd, 0x4b, 0xcb, 0xd2, 0x46, 0x46, 0xf7, 0xa7, 0xe7, 0xd3, 0x0f,
-	0x9f, 0x14, 0xa7, 0x7e, 0x7b, 0x52, 0x9c, 0x52, 0x0c, 0xb0, 0x10, 0x3e, 0x4a, 0xdb, 0xc4, 0xa6,
-	0x98, 0x9d, 0xad, 0xa1, 0x16, 0xb2, 0x0d, 0xec, 0x9f, 0x15, 0x53, 0xf8, 0x1f, 0x90, 0x31, 0x48,
-	0x1d, 0x57, 0x1b, 0x88, 0x36, 0xf2, 0xd3, 0x7c, 0x2f, 0xcd, 0x16, 0xde, 0x43, 0xb4, 0x01, 0x17,
-	0xc0, 0x8c, 0x4d, 0xd8, 0xa1, 0xc4, 0xb2, 0xb4, 0x91, 0xd4, 0xbd, 0x89, 0xf2, 0x2e, 0x38, 0xc9,
-	0x8d, 0x54, 0x78, 0x88, 0xfe, 0x02, 0xca, 0x07, 0x12, 0x90, 0x47, 0x69, 0x10, 0x60, 0x57, 0xc1,
-	0x11, 0x2f, 0xfa, 0xd5, 0xb0, 0xa6, 0x79, 0x6f, 0xf5, 0xa2, 0xb7, 0x08, 0x65, 0x90, 0xa6, 0xcc,
-	0x28, 0xc3, 0x37, 0xcd, 0xf1, 0xf5, 0xe7, 0x4c, 0x05, 0xf2, 0xb4, 0x56, 0xed, 0x8e, 0x55, 0xc3,
-	0x8e, 0xf0, 0x60, 0x5e, 0xac, 0x7e, 0xc0, 0x17, 0x95, 0xab, 0x60, 0x91, 0xe3, 0xf8, 0x18, 0xb5,
-	0x9a, 0x75, 0xe4, 0x12, 0x67, 0xc8, 0x99, 0x53, 0x60, 0xce, 0x20, 0xf6, 0x30, 0x8e, 0x2c, 0x5b,
-	0xbb, 0x18, 0xf1, 0xea, 0x91, 0x04, 0x96, 0x62, 0xb4, 0x09, 0xc7, 0xd6, 0xc1, 0x51, 0x1f, 0x55,
-	0x58, 0xa3, 0x0f, 0xf6, 0x0d, 0xba, 0xe6, 0x27, 0x51, 0xd9, 0x8b, 0xf3, 0xeb, 0x84, 0xe7, 0xbf,
-	0x22, 0x89, 0xfa, 0x47, 0x27, 0x25, 0x91, 0x72, 0x55, 0x18, 0xfb, 0xc8, 0x25, 0x0e, 0x32, 0x27,
-	0x1b, 0x83, 0x39, 0x90, 0xd8, 0xc7, 0x07, 0x22, 0xdf, 0xd8, 0x30, 0x60, 0x7e, 0x5b, 0x98, 0xef,
-	0x2b, 0x13, 0xe6, 0x17, 0xc0, 0x4c, 0x17, 0xb5, 0x3a, 0xbe, 0x71, 0x6f, 0xa2, 0x9c, 0x03, 0x39,
-	0x91, 0x4a, 0xf5, 0xd7, 0x72, 0x72, 0x1d, 0xfc, 0x2b, 0x70, 0x4e, 0x98, 0x80, 0x20, 0xc9, 0x72,
-	0x9f, 0x9f, 0x9a, 0xd3, 0xf9, 0x58, 0xb9, 0x03, 0x20, 0x17, 0xdc, 0xeb, 0x5d, 0x23, 0x26, 0xf5,
-	0x4d, 0x40, 0x90, 0xe4, 0x2f, 0xc6, 0xd3, 0xcf, 0xc7, 0xf0, 0x12, 0x00, 0x83, 0xda, 0xc4, 0x7d,
-	0xcb, 0x96, 0xd6, 0x54, 0x2f, 0x69, 0x55, 0x56, 0xc8, 0x54, 0xaf, 0xe6, 0x89, 0x42, 0xa6, 0xde,
-	0x18, 0x5c, 0x95, 0x1e, 0x38, 0x19, 0x00, 0xf9, 0xb5, 0x24, 0x2e, 0xd6, 0x37, 0x2e, 0x70, 0x6e,
-	0x82, 0x64, 0x8b, 0x98, 0xcc, 0xbb, 0xc4, 0x46, 0xb6, 0x74, 0x5c, 0x1d, 0x2e, 0x9f, 0xea, 0x35,
-	0x62, 0xea, 0x5c, 0x04, 0x5e, 0x1e, 0x01, 0x6a, 0x7d, 0x22, 0x28, 0xcf, 0x4e, 0x10, 0x95, 0xb2,
-	0x20, 0xee, 0xe1, 0x06, 0x2f, 0x92, 0x02, 0xb7, 0x72, 0x5d, 0x00, 0xf4, 0x57, 0x05, 0xc0, 0x73,
-	0x60, 0xd6, 0x2b, 0xa6, 0xfc, 0x82, 0xb2, 0xa5, 0x7c, 0x14, 0xa2, 0x77, 0xa2, 0x9c, 0x7c, 0xfa,
-	0xbc, 0x38, 0xa5, 0x0b, 0x69, 0xe5, 0x47, 0x09, 0x1c, 0xd9, 0x75, 0x1b, 0x15, 0xd4, 0x6a, 0x05,
-	0x6e, 0x1a, 0x39, 0x26, 0xf5, 0x63, 0xc2, 0xc6, 0xf0, 0x04, 0x48, 0x99, 0x88, 0x56, 0x0d, 0xd4,
-	0x16, 0xcf, 0x63, 0xd6, 0x44, 0xb4, 0x82, 0xda, 0xf0, 0x26, 0xc8, 0xb5, 0x1d, 0xd2, 0x26, 0x14,
-	0x3b, 0xfd, 0x27, 0xc6, 0x9e, 0xc7, 0x5c, 0xb9, 0xf4, 0xc7, 0xf3, 0xa2, 0x6a, 0x36, 0xdd, 0x46,
-	0xa7, 0xa6, 0x1a, 0xc4, 0xd2, 0x44, 0x7f, 0xf1, 0x7e, 0xce, 0xd2, 0xfa, 0xbe, 0xe6, 0x1e, 0xb4,
-	0x31, 0x55, 0x2b, 0x83, 0xb7, 0xad, 0x1f, 0xf5, 0x75, 0xf9, 0xef, 0xf2, 0x24, 0x48, 0x1b, 0x0d,
-	0xd4, 0xb4, 0xab, 0xcd, 0x7a, 0x3e, 0xb9, 0x2c, 0x6d, 0x24, 0xf4, 0x14, 0x9f, 0x5f, 0xa9, 0xc3,
-	0x45, 0x90, 0x21, 0x5d, 0xec, 0x38, 0xcd, 0x3a, 0xa6, 0xf9, 0x19, 0x8e, 0x75, 0xb0, 0xa0, 0xec,
-	0x81, 0x63, 0xbb, 0xd4, 0x6d, 0x5a, 0xc8, 0xc5, 0x97, 0xd1, 0xe0, 0x9a, 0x72, 0x20, 0x61, 0x22,
-	0xcf, 0xb5, 0xa4, 0xce, 0x86, 0x6c, 0xc5, 0xc1, 0x2e, 0xf7, 0x6a, 0x4e, 0x67, 0x43, 0x66, 0xb3,
-	0x6b, 0x55, 0xb1, 0xe3, 0x10, 0xef, 0xa5, 0x67, 0xf4, 0x54, 0xd7, 0xda, 0x65, 0x53, 0xe5, 0x45,
-	0xc2, 0x4f, 0x0f, 0xd6, 0x99, 0xf6, 0x7a, 0xfe, 0x95, 0xed, 0x80, 0x84, 0x45, 0x4d, 0x71, 0xf5,
-	0xc5, 0xe8, 0xd5, 0x5f, 0xa7, 0xe6, 0x2e, 0x5b, 0xc3, 0x1d, 0x6b, 0xaf, 0xa7, 0x33, 0x59, 0x78,
-	0x01, 0xcc, 0x05, 0xdb, 0x1b, 0xb7, 0x94, 0x2d, 0x2d, 0x45, 0xcf, 0x72, 0x53, 0x15, 0x2e, 0xa4,
-	0x67, 0xdd, 0xc1, 0x04, 0x56, 0xc0, 0x5c, 0xdb, 0xc1, 0x75, 0x6c, 0x60, 0x4a, 0x89, 0x43, 0xf3,
-	0x49, 0x9e, 0x9b, 0x13, 0xad, 0x87, 0x0e, 0xb1, 0x82, 0x5b, 0x6b, 0x11, 0x63, 0xdf, 0x2f, 0x6d,
-	0x33, 0xfc, 0x92, 0xb3, 0x7c, 0xcd, 0x2b, 0x6c, 0x70, 0x09, 0x00, 0x4f, 0x84, 0xbf, 0xbf, 0x59,
-	0x7e, 0x23, 0x19, 0xbe, 0xc2, 0x5b, 0x56, 0xc5, 0xdf, 0x66, 0x5d, 0x35, 0x9f, 0xe2, 0x6e, 0xc8,
-	0xaa, 0xd7, 0x72, 0x55, 0xbf, 0xe5, 0xaa, 0x7b, 0x7e, 0xcb, 0x2d, 0xa7, 0x59, 0xfe, 0x3d, 0xfe,
-	0xa5, 0x28, 0x09, 0x25, 0x6c, 0x67, 0x64, 0x1a, 0xa5, 0xff, 0x9e, 0x34, 0xca, 0x84, 0xd2, 0xe8,
-	0xfd, 0x64, 0x7a, 0x3a, 0x97, 0xd0, 0xd3, 0x6e, 0xaf, 0xda, 0xb4, 0xeb, 0xb8, 0xa7, 0x6c, 0x89,
-	0x62, 0xd8, 0x8f, 0xf0, 0xa0, 0x52, 0xd5, 0x91, 0x8b, 0xfc, 0x57, 0xc1, 0xc6, 0xca, 0x57, 0x09,
-	0x70, 0x7c, 0x20, 0xfc, 0xb6, 0xbe, 0xa1, 0xe1, 0x4c, 0x4b, 0xbe, 0x76, 0xa6, 0xbd, 0x25, 0x49,
-	0x12, 0x8c, 0x62, 0x3a, 0x14, 0x45, 0x65, 0x1b, 0xfc, 0x7b, 0x38, 0x10, 0x63, 0xe2, 0xf6, 0x4d,
-	0x22, 0x28, 0x5e, 0x66, 0x06, 0x02, 0x2f, 0xd9, 0xed, 0xf9, 0x75, 0x7e, 0xf2, 0x4b, 0x76, 0x7b,
-	0xf4, 0x0d, 0xbc, 0xe4, 0x7f, 0xfa, 0x23, 0x54, 0xce, 0x82, 0x13, 0x91, 0x78, 0x8c, 0x89, 0xdf,
-	0xf1, 0x3e, 0xd5, 0xa2, 0xf8, 0x12, 0xf6, 0x5b, 0xba, 0x72, 0xb3, 0x4f, 0xa3, 0xc4, 0xb2, 0x50,
-	0xb1, 0x0b, 0xd2, 0xac, 0xef, 0x56, 0x6f, 0x61, 0x41, 0x65, 0xca, 0x5b, 0x3f, 0x3f, 0x2f, 0xae,
-	0x1d, 0xc2, 0x9f, 0x2b, 0xb6, 0xcb, 0x38, 0x17, 0x57, 0x57, 0xfa, 0x7d, 0x1e, 0xcc, 0x70, 0xfd,
-	0xf0, 0x4b, 0x09, 0xa4, 0x04, 0xd5, 0x84, 0xab, 0xd1, 0x38, 0x8f, 0xf8, 0x96, 0x90, 0xd7, 0x26,
-	0x89, 0x79, 0x58, 0x95, 0x33, 0x9f, 0xff, 0xf0, 0xeb, 0xb7, 0xd3, 0xab, 0x70, 0x45, 0x8b, 0x7c,
-	0x0d, 0x09, 0xba, 0xa9, 0xdd, 0x15, 0xb1, 0xb9, 0x0f, 0xbf, 0x93, 0xc0, 0x7c, 0x88, 0xd1, 0xc3,
-	0x33, 0x31, 0x66, 0x46, 0x7d, 0x39, 0xc8, 0xdb, 0x87, 0x13, 0x16, 0xc8, 0x4a, 0x1c, 0xd9, 0x36,
-	0xdc, 0x8a, 0x22, 0xf3, 0x3f, 0x1e, 0x22, 0x00, 0xbf, 0x97, 0x40, 0x6e, 0x98, 0x9c, 0x43, 0x35,
-	0xc6, 0x6c, 0xcc, 0x37, 0x81, 0xac, 0x1d, 0x5a, 0x5e, 0x20, 0x3d, 0xcf, 0x91, 0xfe, 0x1f, 0x96,
-	0xa2, 0x48, 0xbb, 0xfe, 0x99, 0x01, 0xd8, 0xe0, 0xf7, 0xc6, 0x7d, 0xf8, 0x40, 0x02, 0x29, 0x41,
-	0xc3, 0x63, 0x43, 0x1b, 0x66, 0xf8, 0xb1, 0xa1, 0x1d, 0x62, 0xf3, 0xca, 0x36, 0x87, 0xb5, 0x06,
-	0x4f, 0x47, 0x61, 0x09, 0x5a, 0x4f, 0x03, 0x57, 0xf7, 0x48, 0x02, 0x29, 0x41, 0xc8, 0x63, 0x81,
-	0x84, 0xd9, 0x7f, 0x2c, 0x90, 0x21, 0x5e, 0xaf, 0xec, 0x70, 0x20, 0x67, 0xe0, 0x66, 0x14, 0x08,
-	0xf5, 0x44, 0x07, 0x38, 0xb4, 0xbb, 0xfb, 0xf8, 0xe0, 0x3e, 0xbc, 0x03, 0x92, 0x8c, 0xb7, 0x43,
-	0x25, 0x36, 0x65, 0xfa, 0x1f, 0x03, 0xf2, 0xca, 0x58, 0x19, 0x81, 0x61, 0x93, 0x63, 0x58, 0x81,
-	0xa7, 0x46, 0x65, 0x53, 0x3d, 0x74, 0x13, 0x9f, 0x82, 0x59, 0x8f, 0xba, 0xc2, 0xd3, 0x31, 0x9a,
-	0x43, 0x0c, 0x59, 0x5e, 0x9d, 0x20, 0x25, 0x10, 0x2c, 0x73, 0x04, 0x32, 0xcc, 0x6b, 0x31, 0x7f,
-	0x4b, 0xc0, 0x1e, 0x48, 0x09, 0x6a, 0x0c, 0x97, 0xa3, 0x3a, 0xc3, 0xac, 0x59, 0x5e, 0x9f, 0xd4,
-	0x2b, 0x7c, 0xbb, 0x0a, 0xb7, 0xbb, 0x08, 0xe5, 0xa8, 0x5d, 0xec, 0x36, 0xaa, 0x06, 0x33, 0xf7,
-	0x19, 0xc8, 0x06, 0xd8, 0xeb, 0x21, 0xac, 0x8f, 0xf0, 0x79, 0x04, 0xfd, 0x55, 0xd6, 0xb8, 0xed,
-	0x65, 0x58, 0x18, 0x61, 0x5b, 0x88, 0x57, 0x19, 0x29, 0xbe, 0x07, 0x52, 0x82, 0xff, 0xc4, 0xe6,
-	0x5e, 0x98, 0x01, 0xc7, 0xe6, 0xde, 0x10, 0x8d, 0x1a, 0xe7, 0xbd, 0xd7, 0x44, 0xdd, 0x1e, 0x7c,
-	0x28, 0x01, 0x30, 0xe8, 0x04, 0x70, 0x63, 0x9c, 0xea, 0x60, 0xf3, 0x96, 0x37, 0x0f, 0x21, 0x29,
-	0x70, 0xac, 0x72, 0x1c, 0x45, 0xb8, 0x14, 0x87, 0x83, 0xb7, 0x45, 0xf8, 0x85, 0x04, 0x32, 0x7d,
-	0x4e, 0x01, 0xd7, 0xc7, 0xe9, 0x0f, 0x86, 0x63, 0x63, 0xb2, 0xa0, 0xc0, 0x71, 0x9a, 0xe3, 0x28,
-	0xc0, 0xc5, 0x38, 0x1c, 0x3c, 0x1f, 0xee, 0xb1, 0xa2, 0xc4, 0xbb, 0xd0, 0x98, 0xa2, 0x14, 0xec,
-	0x85, 0x63, 0x8a, 0x52, 0xa8, 0x37, 0x8e, 0x8b, 0x87, 0xdf, 0x33, 0xcb, 0x17, 0x9e, 0xbe, 0x2c,
-	0x48, 0xcf, 0x5e, 0x16, 0xa4, 0x17, 0x2f, 0x0b, 0xd2, 0xe3, 0x57, 0x85, 0xa9, 0x67, 0xaf, 0x0a,
-	0x53, 0x3f, 0xbd, 0x2a, 0x4c, 0x7d, 0x12, 0xec, 0xa1, 0xb8, 0xcb, 0x5a, 0xe8, 0x40, 0x4b, 0x8f,
-	0xeb, 0xe1, 0x7d, 0xb4, 0x36, 0xcb, 0x29, 0xc8, 0xff, 0xfe, 0x0c, 0x00, 0x00, 0xff, 0xff, 0x01,
-	0x7d, 0x10, 0x8e, 0x95, 0x14, 0x00, 0x00,
+	// 1741 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x4f, 0x8f, 0x23, 0x47,
+	0x15, 0x9f, 0x5e, 0x7b, 0xc6, 0x9e, 0xe7, 0x99, 0x8d, 0xa9, 0xf1, 0x10, 0x6f, 0x33, 0x63, 0x3b,
+	0x3d, 0x3b, 0x9e, 0x3f, 0x3b, 0xe9, 0x66, 0x06, 0x14, 0x89, 0x5c, 0xc8, 0xd8, 0x9a, 0x84, 0x90,
+	0x0d, 0x04, 0x33, 0x70, 0x88, 0x14, 0x99, 0x72, 0x77, 0x6d, 0xdb, 0x1a, 0xbb, 0xdb, 0xe9, 0x2a,
+	0x1b, 0x4f, 0x92, 0xe5, 0x80, 0x20, 0x04, 0xad, 0x14, 0x45, 0x42, 0x5c, 0x51, 0xce, 0x5c, 0x90,
+	0xf8, 0x14, 0x39, 0x46, 0xe2, 0x82, 0x40, 0x9a, 0x44, 0xbb, 0x1c, 0x10, 0x1f, 0x81, 0x03, 0x42,
+	0x55, 0x5d, 0x6d, 0x77, 0xbb, 0x6d, 0xb7, 0x17, 0x05, 0x29, 0x88, 0x93, 0xbb, 0x5e, 0xbd, 0x7a,
+	0xef, 0xf7, 0xea, 0xfd, 0xa9, 0xf7, 0x0c, 0x3b, 0x84, 0xb5, 0x89, 0xd7, 0xeb, 0x38, 0xcc, 0x20,
+	0xc3, 0x9e, 0x31, 0x3c, 0x35, 0xde, 0x1e, 0x10, 0xef, 0x5a, 0xef, 0x7b, 0x2e, 0x73, 0x51, 0x7e,
+	0xbc, 0xab, 0x93, 0x61, 0x4f, 0x1f, 0x9e, 0xaa, 0xc7, 0xa6, 0x4b, 0x7b, 0x2e, 0x35, 0x5a, 0x98,
+	0x12, 0x9f, 0xd5, 0x18, 0x9e, 0xb6, 0x08, 0xc3, 0xa7, 0x46, 0x1f, 0xdb, 0x1d, 0x07, 0xb3, 0x8e,
+	0xeb, 0xf8, 0xa7, 0xd5, 0xbd, 0x98, 0x6c, 0x6c, 0x9a, 0x84, 0xd2, 0x26, 0x1b, 0xf4, 0xbb, 0x44,
+	0x32, 0xdd, 0x89, 0x31, 0xb1, 0x91, 0xdc, 0x52, 0x63, 0x5b, 0x5d, 0xd7, 0x96, 0x7b, 0xbb, 0xb1,
+	0xbd, 0x3e, 0xf6, 0x70, 0x8f, 0xce, 0x55, 0xcd, 0x3c, 0x6c, 0x92, 0xa6, 0xe9, 0x3a, 0x0f, 0x3a,
+	0x81, 0x8c, 0x82, 0xed, 0xda, 0xae, 0xf8, 0x34, 0xf8, 0x97, 0xa4, 0xee, 0xd8, 0xae, 0x6b, 0x77,
+	0x89, 0x81, 0xfb, 0x1d, 0x03, 0x3b, 0x8e, 0xcb, 0x84, 0x49, 0x81, 0xe0, 0xb2, 0xdc, 0x15, 0xab,
+	0xd6, 0xe0, 0x81, 0xc1, 0x3a, 0x3d, 0x42, 0x19, 0xee, 0xf5, 0x7d, 0x06, 0xed, 0x5b, 0xb0, 0xf5,
+	0x03, 0x7e, 0x2d, 0xe7, 0xa6, 0xe9, 0x0e, 0x1c, 0xd6, 0x20, 0x6f, 0x0f, 0x08, 0x65, 0xa8, 0x08,
+	0x19, 0x6c, 0x59, 0x1e, 0xa1, 0xb4, 0xa8, 0x54, 0x94, 0xc3, 0xf5, 0x46, 0xb0, 0x7c, 0x31, 0xfb,
+	0xc1, 0xc7, 0xe5, 0x95, 0xbf, 0x7f, 0x5c, 0x5e, 0xd1, 0x4c, 0x28, 0x44, 0x8f, 0xd2, 0xbe, 0xeb,
+	0x50, 0xc2, 0xcf, 0xb6, 0x70, 0x17, 0x3b, 0x26, 0x09, 0xce, 0xca, 0x25, 0xfa, 0x1a, 0xac, 0x9b,
+	0xae, 0x45, 0x9a, 0x6d, 0x4c, 0xdb, 0xc5, 0x5b, 0x62, 0x2f, 0xcb, 0x09, 0xdf, 0xc1, 0xb4, 0x8d,
+	0x0a, 0xb0, 0xea, 0xb8, 0xfc, 0x50, 0xaa, 0xa2, 0x1c, 0xa6, 0x1b, 0xfe, 0x42, 0xfb, 0x36, 0xdc,
+	0x11, 0x4a, 0xea, 0xc2, 0x8f, 0xff, 0x01, 0xca, 0xf7, 0x15, 0x50, 0x67, 0x49, 0x90, 0x60, 0xf7,
+	0xe1, 0xb6, 0x1f, 0x22, 0xcd, 0xa8, 0xa4, 0x4d, 0x9f, 0x7a, 0xee, 0x13, 0x91, 0x0a, 0x59, 0xca,
+	0x95, 0x72, 0x7c, 0xb7, 0x04, 0xbe, 0xf1, 0x9a, 0x8b, 0xc0, 0xbe, 0xd4, 0xa6, 0x33, 0xe8, 0xb5,
+	0x88, 0x27, 0x2d, 0xd8, 0x94, 0xd4, 0xef, 0x09, 0xa2, 0xf6, 0x1a, 0xec, 0x08, 0x1c, 0x3f, 0xc6,
+	0xdd, 0x8e, 0x85, 0x99, 0xeb, 0x4d, 0x19, 0xf3, 0x1c, 0x6c, 0x98, 0xae, 0x33, 0x8d, 0x23, 0xc7,
+	0x69, 0xe7, 0x31, 0xab, 0x1e, 0x29, 0xb0, 0x3b, 0x47, 0x9a, 0x34, 0xec, 0x00, 0x9e, 0x09, 0x50,
+	0x45, 0x25, 0x06, 0x60, 0xbf, 0x40, 0xd3, 0x82, 0x20, 0xaa, 0xf9, 0x7e, 0x7e, 0x1a, 0xf7, 0x7c,
+	0x1d, 0x0a, 0xd1, 0xa3, 0x49, 0x41, 0xa4, 0xbd, 0x26, 0x95, 0xfd, 0x90, 0xb9, 0x1e, 0xb6, 0x93,
+	0x95, 0xa1, 0x3c, 0xa4, 0xae, 0xc8, 0xb5, 0x8c, 0x37, 0xfe, 0x19, 0x52, 0x7f, 0x02, 0x85, 0xa8,
+	0x30, 0xa9, 0xbe, 0x00, 0xab, 0x43, 0xdc, 0x1d, 0x04, 0xca, 0xfd, 0x85, 0xf6, 0x02, 0xe4, 0x65,
+	0x28, 0x59, 0x4f, 0x65, 0xe4, 0x01, 0x7c, 0x25, 0x74, 0x4e, 0xaa, 0x40, 0x90, 0xe6, 0xb1, 0x2f,
+	0x4e, 0x6d, 0x34, 0xc4, 0xb7, 0xf6, 0x0e, 0x20, 0xc1, 0x78, 0x39, 0xba, 0xef, 0xda, 0x34, 0x50,
+	0x81, 0x20, 0x2d, 0x32, 0xc6, 0x97, 0x2f, 0xbe, 0xd1, 0xcb, 0x00, 0x93, 0x02, 0x26, 0x6c, 0xcb,
+	0x9d, 0x55, 0x75, 0x3f, 0x68, 0x75, 0x5e, 0xed, 0x74, 0xbf, 0x30, 0xca, 0x6a, 0xa7, 0xbf, 0x31,
+	0xb9, 0xaa, 0x46, 0xe8, 0x64, 0x08, 0xe4, 0xaf, 0x15, 0xd8, 0x8a, 0x28, 0x97, 0x38, 0x8f, 0x20,
+	0xdd, 0x75, 0x6d, 0x6e, 0x5d, 0xea, 0x30, 0x77, 0xb6, 0xad, 0x4f, 0xd7, 0x58, 0xfd, 0xbe, 0x6b,
+	0x37, 0x04, 0x0b, 0x7a, 0x65, 0x06, 0xa8, 0x83, 0x44, 0x50, 0xbe, 0x9e, 0x30, 0x2a, 0xad, 0x20,
+	0xef, 0xe1, 0x0d, 0x51, 0x24, 0x25, 0x6e, 0xed, 0x75, 0xd8, 0x8a, 0x50, 0x25, 0xc0, 0x17, 0x60,
+	0xcd, 0x2f, 0xa6, 0xe2, 0x82, 0x72, 0x67, 0xc5, 0x38, 0x44, 0xff, 0x44, 0x2d, 0xfd, 0xc9, 0x4d,
+	0x79, 0xa5, 0x21, 0xb9, 0xb5, 0x7f, 0x29, 0x70, 0xfb, 0x82, 0xb5, 0xeb, 0xb8, 0xdb, 0x0d, 0xdd,
+	0x34, 0xf6, 0x6c, 0x1a, 0xf8, 0x84, 0x7f, 0xa3, 0x67, 0x21, 0x63, 0x63, 0xda, 0x34, 0x71, 0x5f,
+	0xa6, 0xc7, 0x9a, 0x8d, 0x69, 0x1d, 0xf7, 0xd1, 0x5b, 0x90, 0xef, 0x7b, 0x6e, 0xdf, 0xa5, 0xc4,
+	0x1b, 0xa7, 0x18, 0x4f, 0x8f, 0x8d, 0xda, 0xd9, 0x3f, 0x6f, 0xca, 0xba, 0xdd, 0x61, 0xed, 0x41,
+	0x4b, 0x37, 0xdd, 0x9e, 0x21, 0x1f, 0x21, 0xff, 0xe7, 0x79, 0x6a, 0x5d, 0x19, 0xec, 0xba, 0x4f,
+	0xa8, 0x5e, 0x9f, 0xe4, 0x76, 0xe3, 0x99, 0x40, 0x56, 0x90, 0x97, 0x77, 0x20, 0x6b, 0xb6, 0x71,
+	0xc7, 0x69, 0x76, 0xac, 0x62, 0xba, 0xa2, 0x1c, 0xa6, 0x1a, 0x19, 0xb1, 0x7e, 0xd5, 0x42, 0x3b,
+	0xb0, 0xee, 0x0e, 0x89, 0xe7, 0x75, 0x2c, 0x42, 0x8b, 0xab, 0x02, 0xeb, 0x84, 0xc0, 0x33, 0xbf,
+	0xd5, 0x75, 0xcd, 0xab, 0xe6, 0x84, 0x67, 0x4d, 0xf0, 0xdc, 0x16, 0xe4, 0xef, 0x07, 0x54, 0xed,
+	0xaf, 0x0a, 0x14, 0xe4, 0x05, 0xd4, 0x06, 0x8e, 0xd5, 0x25, 0xf1, 0x6b, 0x48, 0xfd, 0xcf, 0x5e,
+	0x83, 0xf6, 0x26, 0x6c, 0x4f, 0x19, 0x27, 0xe3, 0xe5, 0x1c, 0x32, 0x1e, 0xa1, 0x83, 0x2e, 0x0b,
+	0x62, 0xfa, 0x20, 0x1e, 0x30, 0xaf, 0x53, 0xfb, 0x82, 0xd3, 0xc8, 0xa0, 0x77, 0x39, 0x1a, 0x87,
+	0x68, 0x70, 0x4e, 0xbb, 0x84, 0xad, 0x0b, 0xca, 0x3a, 0x3d, 0xcc, 0xc8, 0x2b, 0x78, 0x12, 0x89,
+	0x79, 0x48, 0xd9, 0xd8, 0x8f, 0x9e, 0x74, 0x83, 0x7f, 0x72, 0x8a, 0x47, 0x98, 0xb8, 0xb1, 0x8d,
+	0x06, 0xff, 0xe4, 0xf6, 0x0c, 0x7b, 0x4d, 0xe2, 0x79, 0xae, 0x5f, 0x4c, 0xd7, 0x1b, 0x99, 0x61,
+	0xef, 0x82, 0x2f, 0xb5, 0x3f, 0x2a, 0x50, 0xac, 0x7b, 0x04, 0x33, 0x72, 0x2e, 0x1a, 0x8f, 0xfb,
+	0x1d, 0x3a, 0xa9, 0xe7, 0x3f, 0x81, 0x9c, 0x6c, 0x47, 0xba, 0x1d, 0xca, 0x24, 0xf2, 0xdd, 0x38,
+	0x72, 0xff, 0xe8, 0x25, 0x6f, 0x59, 0x6a, 0x15, 0x1e, 0xef, 0xff, 0xb8, 0x29, 0x03, 0x1e, 0xcb,
+	0xfb, 0xfd, 0x67, 0x65, 0x08, 0x49, 0x0f, 0xed, 0x70, 0x64, 0xdc, 0xc3, 0x03, 0x4a, 0x2c, 0xe9,
+	0x62, 0xee, 0xf1, 0x1f, 0x51, 0x62, 0x2d, 0x02, 0xfd, 0x79, 0x2a, 0x28, 0x1b, 0x1e, 0x36, 0xc9,
+	0xe5, 0x28, 0x88, 0xa1, 0x53, 0x48, 0xf5, 0xa8, 0x2d, 0x53, 0xb2, 0x9c, 0x74, 0xc3, 0x9c, 0x17,
+	0xbd, 0x04, 0x1b, 0xe1, 0xb6, 0x47, 0x68, 0x9a, 0x69, 0xa3, 0x50, 0x55, 0x17, 0x4c, 0x8d, 0x1c,
+	0x9b, 0x2c, 0x50, 0x1d, 0x36, 0xfa, 0x1e, 0xb1, 0x08, 0xb7, 0xc9, 0xf5, 0x68, 0x31, 0x5d, 0x49,
+	0x2d, 0xa3, 0x3d, 0x72, 0x88, 0x3f, 0xc4, 0x7e, 0xfe, 0xc8, 0x27, 0x6f, 0x55, 0x44, 0x5d, 0x4e,
+	0xd0, 0xfc, 0x07, 0x0f, 0xed, 0x02, 0xf8, 0x2c, 0xa2, 0x2e, 0xaf, 0x89, 0x1b, 0x59, 0x17, 0x14,
+	0xd1, 0xca, 0xd4, 0x83, 0x6d, 0xde, 0x6d, 0x15, 0x33, 0xc2, 0x0c, 0x55, 0xf7, 0x5b, 0x31, 0x3d,
+	0x68, 0xc5, 0xf4, 0xcb, 0xa0, 0x15, 0xab, 0x65, 0xb9, 0x9f, 0x3e, 0xfa, 0xac, 0xac, 0x48, 0x21,
+	0x7c, 0x67, 0x66, 0x5e, 0x65, 0xff, 0x3b, 0x79, 0xb5, 0x1e, 0xc9, 0xab, 0xef, 0xa6, 0xb3, 0xb7,
+	0xf2, 0xa9, 0x46, 0x96, 0x8d, 0x9a, 0x1d, 0xc7, 0x22, 0x23, 0xed, 0x58, 0x3e, 0x92, 0x63, 0x0f,
+	0x4f, 0x5e, 0x30, 0x0b, 0x33, 0x1c, 0x54, 0x4b, 0xfe, 0xad, 0xfd, 0x2a, 0x05, 0xdb, 0x13, 0xe6,
+	0x2f, 0x6b, 0x6d, 0x9d, 0x8e, 0xb4, 0xf4, 0x53, 0x47, 0xda, 0x97, 0x24, 0x48, 0xc2, 0x5e, 0xcc,
+	0x46, 0xbc, 0xa8, 0x9d, 0xc0, 0x57, 0xa7, 0x1d, 0xb1, 0xc0, 0x6f, 0x1f, 0xa6, 0xc2, 0xec, 0x35,
+	0xae, 0x20, 0x94, 0xc9, 0x6c, 0x14, 0xd4, 0xca, 0xe4, 0x4c, 0x66, 0x23, 0xfa, 0x05, 0x64, 0xf2,
+	0xff, 0x7b, 0x12, 0x6a, 0xcf, 0xc3, 0xb3, 0x31, 0x7f, 0x2c, 0xf0, 0xdf, 0xf6, 0xb8, 0x05, 0xa7,
+	0xe4, 0x65, 0x12, 0xbc, 0xe4, 0xda, 0x5b, 0x50, 0x88, 0x92, 0xa5, 0x88, 0x0b, 0xc8, 0xf2, 0x7e,
+	0xac, 0xf9, 0x80, 0xc8, 0x16, 0xb7, 0x76, 0xfc, 0x97, 0x9b, 0x72, 0x75, 0x09, 0x7b, 0x5e, 0x75,
+	0x18, 0xef, 0xc5, 0x85, 0xb8, 0xb3, 0xdf, 0xe6, 0x61, 0x55, 0xc8, 0x47, 0xbf, 0x54, 0x20, 0x23,
+	0x47, 0x10, 0xb4, 0x1f, 0xf7, 0xf3, 0x8c, 0x19, 0x53, 0xad, 0x26, 0xb1, 0xf9, 0x58, 0xb5, 0x7b,
+	0x3f, 0xff, 0xd3, 0xdf, 0x7e, 0x73, 0x6b, 0x1f, 0xed, 0x19, 0xb3, 0x06, 0x74, 0xce, 0x6a, 0xbc,
+	0x2b, 0x7d, 0xf3, 0x10, 0xfd, 0x4e, 0x81, 0xcd, 0xc8, 0xa4, 0x87, 0xee, 0xcd, 0x51, 0x33, 0x6b,
+	0xa2, 0x54, 0x4f, 0x96, 0x63, 0x96, 0xc8, 0xce, 0x04, 0xb2, 0x13, 0x74, 0x1c, 0x47, 0x16, 0x0c,
+	0x95, 0x31, 0x80, 0x7f, 0x50, 0x20, 0x3f, 0x3d, 0xb4, 0x21, 0x7d, 0x8e, 0xda, 0x39, 0xb3, 0xa2,
+	0x6a, 0x2c, 0xcd, 0x2f, 0x91, 0xbe, 0x28, 0x90, 0x7e, 0x13, 0x9d, 0xc5, 0x91, 0x0e, 0x83, 0x33,
+	0x13, 0xb0, 0xe1, 0x39, 0xf4, 0x21, 0x7a, 0x5f, 0x81, 0x8c, 0x1c, 0xcf, 0xe6, 0xba, 0x36, 0x3a,
+	0xf9, 0xa9, 0xd5, 0x24, 0x36, 0x09, 0xeb, 0x44, 0xc0, 0xaa, 0xa2, 0xbb, 0x71, 0x58, 0x72, 0xdc,
+	0xa3, 0xa1, 0xab, 0x7b, 0xa4, 0x40, 0x46, 0x0e, 0x6a, 0x73, 0x81, 0x44, 0xa7, 0x42, 0xb5, 0x9a,
+	0xc4, 0x26, 0x81, 0x9c, 0x0a, 0x20, 0xf7, 0xd0, 0x51, 0x1c, 0x08, 0xf5, 0x59, 0x27, 0x38, 0x8c,
+	0x77, 0xaf, 0xc8, 0xf5, 0x43, 0xf4, 0x0e, 0xa4, 0xf9, 0x3c, 0x87, 0xb4, 0xb9, 0x21, 0x33, 0x1e,
+	0x12, 0xd5, 0xbd, 0x85, 0x3c, 0x12, 0xc3, 0x91, 0xc0, 0xb0, 0x87, 0x9e, 0x9b, 0x15, 0x4d, 0x56,
+	0xe4, 0x26, 0x7e, 0x0a, 0x6b, 0xfe, 0x48, 0x83, 0xee, 0xce, 0x91, 0x1c, 0x99, 0x9c, 0xd4, 0xfd,
+	0x04, 0x2e, 0x89, 0xa0, 0x22, 0x10, 0xa8, 0xa8, 0x68, 0xcc, 0xf9, 0xbb, 0x0a, 0x8d, 0x20, 0x23,
+	0x9b, 0x6a, 0x54, 0x89, 0xcb, 0x8c, 0x4e, 0x53, 0xea, 0xb2, 0x7d, 0xb5, 0xa6, 0x09, 0xbd, 0x3b,
+	0x48, 0x8d, 0xeb, 0x25, 0xac, 0xdd, 0x34, 0xb9, 0xba, 0x47, 0x0a, 0x6c, 0x46, 0xfa, 0x79, 0x54,
+	0x9d, 0x0b, 0x20, 0x32, 0xcd, 0xa8, 0x07, 0x89, 0x7c, 0xc9, 0x0e, 0x08, 0x60, 0x34, 0x5b, 0xbe,
+	0xee, 0x9f, 0x41, 0x2e, 0x34, 0x00, 0x2c, 0x71, 0x17, 0x33, 0x3c, 0x30, 0x63, 0x82, 0xd0, 0xaa,
+	0x02, 0x42, 0x05, 0x95, 0x66, 0x40, 0x90, 0xec, 0x4d, 0x3e, 0x57, 0x7c, 0xa8, 0x40, 0x7e, 0x7a,
+	0x54, 0x58, 0x02, 0xc5, 0x71, 0x9c, 0x63, 0xde, 0xc0, 0xb1, 0x28, 0x37, 0x4d, 0x71, 0xa6, 0x19,
+	0x9a, 0x47, 0xd0, 0x7b, 0x90, 0x91, 0xed, 0xe1, 0xdc, 0xd4, 0x8c, 0x0e, 0x08, 0x6a, 0x35, 0x89,
+	0x2d, 0x39, 0x38, 0xfc, 0x1e, 0x83, 0x8d, 0xd0, 0x07, 0x0a, 0xc0, 0xe4, 0xa1, 0x44, 0x87, 0x8b,
+	0x44, 0x87, 0x7b, 0x1b, 0xf5, 0x68, 0x09, 0x4e, 0x89, 0x63, 0x5f, 0xe0, 0x28, 0xa3, 0xdd, 0x79,
+	0x38, 0x44, 0xd7, 0x80, 0x7e, 0xa1, 0xc0, 0xfa, 0xb8, 0xe5, 0x42, 0x07, 0x8b, 0xe4, 0x87, 0x3d,
+	0x73, 0x98, 0xcc, 0x28, 0x71, 0xdc, 0x15, 0x38, 0x4a, 0x68, 0x67, 0x1e, 0x0e, 0x91, 0x2e, 0xef,
+	0xf1, 0x9a, 0x2d, 0x1e, 0xe9, 0x05, 0x35, 0x3b, 0xdc, 0x2a, 0xa8, 0xd5, 0x24, 0xb6, 0x64, 0x7f,
+	0x04, 0x2d, 0x45, 0xed, 0xa5, 0x4f, 0x1e, 0x97, 0x94, 0x4f, 0x1f, 0x97, 0x94, 0xcf, 0x1f, 0x97,
+	0x94, 0x8f, 0x9e, 0x94, 0x56, 0x3e, 0x7d, 0x52, 0x5a, 0xf9, 0xf3, 0x93, 0xd2, 0xca, 0x9b, 0xe1,
+	0x16, 0x83, 0x0c, 0x79, 0x87, 0x31, 0x91, 0x32, 0x12, 0x72, 0x44, 0x9b, 0xd1, 0x5a, 0x13, 0x1d,
+	0xda, 0x37, 0xfe, 0x3d, 0x00, 0xf2, 0x92, 0x9c, 0x9b, 0xf1, 0x17, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1630,8 +1845,13 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// EthCall implements the `eth_call` rpc api
 	EthCall(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*MsgEthereumTxResponse, error)
+	// EthCallBundle implements the `eth_callBundle` rpc api, the calls are applied in order on top of the
+	// same state
+	EthCallBundle(ctx context.Context, in *EthCallBundleRequest, opts ...grpc.CallOption) (*EthCallBundleResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
 	EstimateGas(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error)
+	// CreateAccessList implements the `eth_createAccessList` rpc api
+	CreateAccessList(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*CreateAccessListResponse, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
 	TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and `debug_traceBlockByHash` rpc api
@@ -1723,6 +1943,15 @@ func (c *queryClient) EthCall(ctx context.Context, in *EthCallRequest, opts ...g
 	return out, nil
 }
 
+func (c *queryClient) EthCallBundle(ctx context.Context, in *EthCallBundleRequest, opts ...grpc.CallOption) (*EthCallBundleResponse, error) {
+	out := new(EthCallBundleResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/EthCallBundle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) EstimateGas(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error) {
 	out := new(EstimateGasResponse)
 	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/EstimateGas", in, out, opts...)
@@ -1732,6 +1961,15 @@ func (c *queryClient) EstimateGas(ctx context.Context, in *EthCallRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) CreateAccessList(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*CreateAccessListResponse, error) {
+	out := new(CreateAccessListResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/CreateAccessList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error) {
 	out := new(QueryTraceTxResponse)
 	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/TraceTx", in, out, opts...)
@@ -1788,8 +2026,13 @@ type QueryServer interface {
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// EthCall implements the `eth_call` rpc api
 	EthCall(context.Context, *EthCallRequest) (*MsgEthereumTxResponse, error)
+	// EthCallBundle implements the `eth_callBundle` rpc api, the calls are applied in order on top of the
+	// same state
+	EthCallBundle(context.Context, *EthCallBundleRequest) (*EthCallBundleResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
 	EstimateGas(context.Context, *EthCallRequest) (*EstimateGasResponse, error)
+	// CreateAccessList implements the `eth_createAccessList` rpc api
+	CreateAccessList(context.Context, *EthCallRequest) (*CreateAccessListResponse, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
 	TraceTx(context.Context, *QueryTraceTxRequest) (*QueryTraceTxResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and `debug_traceBlockByHash` rpc api
@@ -1829,9 +2072,15 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) EthCall(ctx context.Context, req *EthCallRequest) (*MsgEthereumTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EthCall not implemented")
 }
+func (*UnimplementedQueryServer) EthCallBundle(ctx context.Context, req *EthCallBundleRequest) (*EthCallBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EthCallBundle not implemented")
+}
 func (*UnimplementedQueryServer) EstimateGas(ctx context.Context, req *EthCallRequest) (*EstimateGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EstimateGas not implemented")
 }
+func (*UnimplementedQueryServer) CreateAccessList(ctx context.Context, req *EthCallRequest) (*CreateAccessListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccessList not implemented")
+}
 func (*UnimplementedQueryServer) TraceTx(ctx context.Context, req *QueryTraceTxRequest) (*QueryTraceTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TraceTx not implemented")
 }
@@ -1993,6 +2242,24 @@ func _Query_EthCall_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EthCallBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EthCallBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EthCallBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/EthCallBundle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EthCallBundle(ctx, req.(*EthCallBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_EstimateGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EthCallRequest)
 	if err := dec(in); err != nil {
@@ -2011,6 +2278,24 @@ func _Query_EstimateGas_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CreateAccessList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EthCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CreateAccessList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/CreateAccessList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CreateAccessList(ctx, req.(*EthCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TraceTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTraceTxRequest)
 	if err := dec(in); err != nil {
@@ -2119,10 +2404,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EthCall",
 			Handler:    _Query_EthCall_Handler,
 		},
+		{
+			MethodName: "EthCallBundle",
+			Handler:    _Query_EthCallBundle_Handler,
+		},
 		{
 			MethodName: "EstimateGas",
 			Handler:    _Query_EstimateGas_Handler,
 		},
+		{
+			MethodName: "CreateAccessList",
+			Handler:    _Query_CreateAccessList_Handler,
+		},
 		{
 			MethodName: "TraceTx",
 			Handler:    _Query_TraceTx_Handler,
@@ -2710,6 +3003,13 @@ func (m *EthCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BlockOverrides) > 0 {
+		i -= len(m.BlockOverrides)
+		copy(dAtA[i:], m.BlockOverrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockOverrides)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Overrides) > 0 {
 		i -= len(m.Overrides)
 		copy(dAtA[i:], m.Overrides)
@@ -2744,7 +3044,7 @@ func (m *EthCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EstimateGasResponse) Marshal() (dAtA []byte, err error) {
+func (m *EthCallBundleRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2754,39 +3054,53 @@ func (m *EstimateGasResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EstimateGasResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *EthCallBundleRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EstimateGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EthCallBundleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.VmError) > 0 {
-		i -= len(m.VmError)
-		copy(dAtA[i:], m.VmError)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.VmError)))
+	if len(m.Overrides) > 0 {
+		i -= len(m.Overrides)
+		copy(dAtA[i:], m.Overrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Overrides)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x2a
 	}
-	if len(m.Ret) > 0 {
-		i -= len(m.Ret)
-		copy(dAtA[i:], m.Ret)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Ret)))
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x20
 	}
-	if m.Gas != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Gas))
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x1a
+	}
+	if m.GasCap != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasCap))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Args[iNdEx])
+			copy(dAtA[i:], m.Args[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Args[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTraceTxRequest) Marshal() (dAtA []byte, err error) {
+func (m *EthCallBundleResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2796,27 +3110,155 @@ func (m *QueryTraceTxRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTraceTxRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *EthCallBundleResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTraceTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EthCallBundleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x48
-	}
-	if len(m.ProposerAddress) > 0 {
-		i -= len(m.ProposerAddress)
-		copy(dAtA[i:], m.ProposerAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
-		i--
-		dAtA[i] = 0x42
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateGasResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateGasResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VmError) > 0 {
+		i -= len(m.VmError)
+		copy(dAtA[i:], m.VmError)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VmError)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Ret) > 0 {
+		i -= len(m.Ret)
+		copy(dAtA[i:], m.Ret)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Ret)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Gas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateAccessListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateAccessListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateAccessListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VmError) > 0 {
+		i -= len(m.VmError)
+		copy(dAtA[i:], m.VmError)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VmError)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AccessList) > 0 {
+		for iNdEx := len(m.AccessList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccessList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTraceTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTraceTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTraceTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
+		i--
+		dAtA[i] = 0x42
 	}
 	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
 	if err4 != nil {
@@ -3457,6 +3899,54 @@ func (m *EthCallRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.BlockOverrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *EthCallBundleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Args) > 0 {
+		for _, b := range m.Args {
+			l = len(b)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.GasCap != 0 {
+		n += 1 + sovQuery(uint64(m.GasCap))
+	}
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	l = len(m.Overrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *EthCallBundleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -3480,6 +3970,28 @@ func (m *EstimateGasResponse) Size() (n int) {
 	return n
 }
 
+func (m *CreateAccessListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AccessList) > 0 {
+		for _, e := range m.AccessList {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovQuery(uint64(m.GasUsed))
+	}
+	l = len(m.VmError)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryTraceTxRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5342,6 +5854,40 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 				m.Overrides = []byte{}
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockOverrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockOverrides = append(m.BlockOverrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockOverrides == nil {
+				m.BlockOverrides = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5363,7 +5909,7 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
+func (m *EthCallBundleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5386,17 +5932,17 @@ func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateGasResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EthCallBundleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EthCallBundleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
-			m.Gas = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5406,14 +5952,46 @@ func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Gas |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, make([]byte, postIndex-iNdEx))
+			copy(m.Args[len(m.Args)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
+			}
+			m.GasCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasCap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5440,11 +6018,386 @@ func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ret = append(m.Ret[:0], dAtA[iNdEx:postIndex]...)
-			if m.Ret == nil {
-				m.Ret = []byte{}
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Overrides = append(m.Overrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.Overrides == nil {
+				m.Overrides = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EthCallBundleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EthCallBundleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EthCallBundleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, &MsgEthereumTxResponse{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateGasResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ret", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ret = append(m.Ret[:0], dAtA[iNdEx:postIndex]...)
+			if m.Ret == nil {
+				m.Ret = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VmError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateAccessListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateAccessListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateAccessListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccessList = append(m.AccessList, AccessTuple{})
+			if err := m.AccessList[len(m.AccessList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
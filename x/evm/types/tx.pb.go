@@ -314,6 +314,9 @@ type MsgEthereumTxResponse struct {
 	GasUsed uint64 `protobuf:"varint,5,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
 	// include the block hash for json-rpc to use
 	BlockHash []byte `protobuf:"bytes,6,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// state_root is the intermediate EVM state root after the transaction, it commits to
+	// the EVM state changes of the block up to this transaction.
+	StateRoot []byte `protobuf:"bytes,7,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
 }
 
 func (m *MsgEthereumTxResponse) Reset()         { *m = MsgEthereumTxResponse{} }
@@ -443,6 +446,145 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgEVMCall defines a Msg calling a contract with the sender as caller.
+type MsgEVMCall struct {
+	// sender is the bech32 address of the account calling the contract.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// to is the hex address of the contract called.
+	To string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// data is the input data of the call.
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// value defines the amount transferred to the contract, in evm denom.
+	Value github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=value,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"value"`
+	// gas_limit is the maximum gas the call can consume.
+	GasLimit uint64 `protobuf:"varint,5,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+}
+
+func (m *MsgEVMCall) Reset()         { *m = MsgEVMCall{} }
+func (m *MsgEVMCall) String() string { return proto.CompactTextString(m) }
+func (*MsgEVMCall) ProtoMessage()    {}
+func (*MsgEVMCall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{8}
+}
+func (m *MsgEVMCall) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEVMCall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEVMCall.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEVMCall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEVMCall.Merge(m, src)
+}
+func (m *MsgEVMCall) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEVMCall) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEVMCall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEVMCall proto.InternalMessageInfo
+
+func (m *MsgEVMCall) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgEVMCall) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *MsgEVMCall) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *MsgEVMCall) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+// MsgEVMCallResponse defines the response structure for executing a MsgEVMCall message.
+type MsgEVMCallResponse struct {
+	// ret is the returned data from the call.
+	Ret []byte `protobuf:"bytes,1,opt,name=ret,proto3" json:"ret,omitempty"`
+	// logs are the ethereum logs emitted by the call.
+	Logs []*Log `protobuf:"bytes,2,rep,name=logs,proto3" json:"logs,omitempty"`
+	// gas_used specifies how much gas was consumed by the call.
+	GasUsed uint64 `protobuf:"varint,3,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+}
+
+func (m *MsgEVMCallResponse) Reset()         { *m = MsgEVMCallResponse{} }
+func (m *MsgEVMCallResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgEVMCallResponse) ProtoMessage()    {}
+func (*MsgEVMCallResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{9}
+}
+func (m *MsgEVMCallResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEVMCallResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEVMCallResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEVMCallResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEVMCallResponse.Merge(m, src)
+}
+func (m *MsgEVMCallResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEVMCallResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEVMCallResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEVMCallResponse proto.InternalMessageInfo
+
+func (m *MsgEVMCallResponse) GetRet() []byte {
+	if m != nil {
+		return m.Ret
+	}
+	return nil
+}
+
+func (m *MsgEVMCallResponse) GetLogs() []*Log {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
+func (m *MsgEVMCallResponse) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MsgEthereumTx)(nil), "ethermint.evm.v1.MsgEthereumTx")
 	proto.RegisterType((*LegacyTx)(nil), "ethermint.evm.v1.LegacyTx")
@@ -452,77 +594,86 @@ func init() {
 	proto.RegisterType((*MsgEthereumTxResponse)(nil), "ethermint.evm.v1.MsgEthereumTxResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "ethermint.evm.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ethermint.evm.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgEVMCall)(nil), "ethermint.evm.v1.MsgEVMCall")
+	proto.RegisterType((*MsgEVMCallResponse)(nil), "ethermint.evm.v1.MsgEVMCallResponse")
 }
 
 func init() { proto.RegisterFile("ethermint/evm/v1/tx.proto", fileDescriptor_f75ac0a12d075f21) }
 
 var fileDescriptor_f75ac0a12d075f21 = []byte{
-	// 1035 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x8f, 0xdb, 0x44,
-	0x14, 0x8e, 0x13, 0xe7, 0x87, 0x27, 0x61, 0x5b, 0x8d, 0xb6, 0xaa, 0x13, 0xb1, 0x71, 0x08, 0x02,
-	0xd2, 0xa2, 0xd8, 0xea, 0x82, 0x7a, 0xd8, 0x53, 0x37, 0xed, 0x6e, 0x69, 0xb5, 0x2b, 0x2a, 0x93,
-	0x5e, 0x28, 0x52, 0x34, 0x6b, 0xcf, 0x3a, 0x56, 0x63, 0x8f, 0xe5, 0x99, 0x58, 0x09, 0x12, 0x97,
-	0x9e, 0x38, 0x82, 0xf8, 0x07, 0x38, 0x73, 0x42, 0xa2, 0x47, 0x0e, 0x48, 0x5c, 0x2a, 0x4e, 0x15,
-	0x5c, 0x10, 0x87, 0x80, 0xb2, 0x48, 0x48, 0x7b, 0x83, 0xbf, 0x00, 0xcd, 0x8c, 0xb3, 0xd9, 0xd4,
-	0x6c, 0x0b, 0xa5, 0x88, 0x53, 0xe6, 0xcd, 0xfb, 0xfc, 0xe6, 0xcd, 0xfb, 0xbe, 0x37, 0x2f, 0xa0,
-	0x8e, 0xd9, 0x10, 0xc7, 0x81, 0x1f, 0x32, 0x0b, 0x27, 0x81, 0x95, 0x5c, 0xb1, 0xd8, 0xc4, 0x8c,
-	0x62, 0xc2, 0x08, 0x3c, 0x7f, 0xe2, 0x32, 0x71, 0x12, 0x98, 0xc9, 0x95, 0xc6, 0x45, 0x87, 0xd0,
-	0x80, 0x50, 0x2b, 0xa0, 0x1e, 0x47, 0x06, 0xd4, 0x93, 0xd0, 0x46, 0x5d, 0x3a, 0x06, 0xc2, 0xb2,
-	0xa4, 0x91, 0xba, 0xd6, 0x3d, 0xe2, 0x11, 0xb9, 0xcf, 0x57, 0xe9, 0xee, 0xcb, 0x1e, 0x21, 0xde,
-	0x08, 0x5b, 0x28, 0xf2, 0x2d, 0x14, 0x86, 0x84, 0x21, 0xe6, 0x93, 0x70, 0xf1, 0x4d, 0x3d, 0xf5,
-	0x0a, 0xeb, 0x60, 0x7c, 0x68, 0xa1, 0x70, 0x9a, 0xba, 0x5e, 0xcd, 0xe4, 0x8b, 0x1c, 0x07, 0x53,
-	0x3a, 0x60, 0xe3, 0x68, 0x84, 0x53, 0x50, 0x23, 0x03, 0x1a, 0x91, 0x45, 0xaa, 0x1b, 0x19, 0x5f,
-	0x84, 0x62, 0x14, 0xa4, 0x47, 0xb7, 0xbf, 0x56, 0xc0, 0x4b, 0xfb, 0xd4, 0xdb, 0xe1, 0x20, 0x3c,
-	0x0e, 0xfa, 0x13, 0xd8, 0x01, 0xaa, 0x8b, 0x18, 0xd2, 0x95, 0x96, 0xd2, 0xa9, 0x6e, 0xae, 0x9b,
-	0x32, 0x37, 0x73, 0x91, 0x9b, 0xb9, 0x1d, 0x4e, 0x6d, 0x81, 0x80, 0x75, 0xa0, 0x52, 0xff, 0x43,
-	0xac, 0xe7, 0x5b, 0x4a, 0x47, 0xe9, 0x15, 0x8f, 0x67, 0x86, 0xd2, 0xb5, 0xc5, 0x16, 0x34, 0x80,
-	0x3a, 0x44, 0x74, 0xa8, 0x17, 0x5a, 0x4a, 0x47, 0xeb, 0x55, 0xff, 0x98, 0x19, 0xe5, 0x78, 0x14,
-	0x6d, 0xb5, 0xbb, 0x6d, 0x5b, 0x38, 0xe0, 0x9b, 0xe0, 0x9c, 0x8b, 0xa3, 0x18, 0x3b, 0x88, 0x61,
-	0x77, 0x70, 0x18, 0x93, 0x40, 0x57, 0x05, 0x36, 0xaf, 0x2b, 0xf6, 0xda, 0xd2, 0xb5, 0x1b, 0x93,
-	0x00, 0x42, 0xa0, 0x0a, 0x44, 0xb1, 0xa5, 0x74, 0x6a, 0xb6, 0x58, 0x6f, 0xa9, 0x1f, 0x7f, 0x6e,
-	0xe4, 0xda, 0x5f, 0xe5, 0x41, 0x65, 0x0f, 0x7b, 0xc8, 0x99, 0xf6, 0x27, 0x70, 0x1d, 0x14, 0x43,
-	0x12, 0x3a, 0x58, 0xa4, 0xae, 0xda, 0xd2, 0x80, 0x37, 0x81, 0xe6, 0x21, 0x4e, 0x95, 0xef, 0xc8,
-	0x54, 0xb5, 0xde, 0xe5, 0x9f, 0x66, 0xc6, 0xeb, 0x9e, 0xcf, 0x86, 0xe3, 0x03, 0xd3, 0x21, 0x41,
-	0x4a, 0x60, 0xfa, 0xd3, 0xa5, 0xee, 0x7d, 0x8b, 0x4d, 0x23, 0x4c, 0xcd, 0x5b, 0x21, 0xb3, 0x2b,
-	0x1e, 0xa2, 0x77, 0xf8, 0xb7, 0xb0, 0x09, 0x0a, 0x1e, 0xa2, 0xe2, 0x4a, 0x6a, 0xaf, 0x36, 0x9f,
-	0x19, 0x95, 0x9b, 0x88, 0xee, 0xf9, 0x81, 0xcf, 0x6c, 0xee, 0x80, 0x6b, 0x20, 0xcf, 0x88, 0xbc,
-	0x85, 0x9d, 0x67, 0x04, 0xde, 0x06, 0xc5, 0x04, 0x8d, 0xc6, 0x58, 0xa4, 0xad, 0xf5, 0xde, 0xfe,
-	0xfb, 0x87, 0xce, 0x67, 0x46, 0x69, 0x3b, 0x20, 0xe3, 0x90, 0xd9, 0x32, 0x04, 0xaf, 0x80, 0x20,
-	0xa5, 0x24, 0x2b, 0x20, 0xca, 0x5f, 0x03, 0x4a, 0xa2, 0x97, 0xc5, 0x86, 0x92, 0x70, 0x2b, 0xd6,
-	0x2b, 0xd2, 0x8a, 0xb9, 0x45, 0x75, 0x4d, 0x5a, 0x74, 0x6b, 0x8d, 0xd7, 0xea, 0xbb, 0x87, 0xdd,
-	0x52, 0x7f, 0x72, 0x03, 0x31, 0xd4, 0xfe, 0xbd, 0x00, 0x6a, 0xdb, 0x42, 0x46, 0x7b, 0x3e, 0x65,
-	0xfd, 0x09, 0xbc, 0x07, 0x2a, 0xce, 0x10, 0xf9, 0xe1, 0xc0, 0x77, 0x45, 0xf1, 0xb4, 0xde, 0xb5,
-	0x7f, 0x94, 0x6d, 0xf9, 0x3a, 0xff, 0xfa, 0xd6, 0x8d, 0xe3, 0x99, 0x51, 0x76, 0xe4, 0xd2, 0x4e,
-	0x17, 0xee, 0x92, 0x96, 0xfc, 0x99, 0xb4, 0x14, 0xfe, 0x3d, 0x2d, 0xea, 0xd3, 0x69, 0x29, 0x66,
-	0x69, 0x29, 0xbd, 0x38, 0x5a, 0xca, 0xa7, 0x68, 0xb9, 0x07, 0x2a, 0xb2, 0x45, 0x31, 0xd5, 0x2b,
-	0xad, 0x42, 0xa7, 0xba, 0xb9, 0x61, 0x3e, 0xf9, 0xb2, 0x98, 0xb2, 0xfa, 0x7d, 0xde, 0xc3, 0xbd,
-	0xd6, 0xa3, 0x99, 0x91, 0x3b, 0x9e, 0x19, 0x00, 0x9d, 0x50, 0xf2, 0xc5, 0xcf, 0x06, 0x58, 0x12,
-	0x64, 0x9f, 0x04, 0x94, 0x9c, 0x6b, 0x2b, 0x9c, 0x83, 0x15, 0xce, 0xab, 0x67, 0x71, 0xfe, 0x8d,
-	0x0a, 0x6a, 0x37, 0xa6, 0x21, 0x0a, 0x7c, 0x67, 0x17, 0xe3, 0xff, 0x87, 0xf3, 0xdb, 0xa0, 0xca,
-	0x39, 0x67, 0x7e, 0x34, 0x70, 0x50, 0xf4, 0x1c, 0xac, 0x73, 0xc9, 0xf4, 0xfd, 0xe8, 0x3a, 0x8a,
-	0x16, 0xb1, 0x0e, 0x31, 0x16, 0xb1, 0xd4, 0xe7, 0x8a, 0xb5, 0x8b, 0x31, 0x8f, 0x95, 0x4a, 0xa8,
-	0xf8, 0x74, 0x09, 0x95, 0xb2, 0x12, 0x2a, 0xbf, 0x38, 0x09, 0x55, 0xce, 0x90, 0x90, 0xf6, 0x9f,
-	0x48, 0x08, 0xac, 0x48, 0xa8, 0xba, 0x22, 0xa1, 0xda, 0x59, 0x12, 0x6a, 0x83, 0xc6, 0xce, 0x84,
-	0xe1, 0x90, 0xfa, 0x24, 0x7c, 0x37, 0x12, 0x03, 0x6c, 0x39, 0x37, 0xd2, 0x07, 0xf9, 0x5b, 0x05,
-	0x5c, 0x58, 0x99, 0x27, 0x36, 0xa6, 0x11, 0x09, 0xa9, 0xb8, 0xa8, 0x18, 0x09, 0x42, 0x6b, 0xe9,
-	0x14, 0xb8, 0x04, 0xd4, 0x11, 0xf1, 0xa8, 0x9e, 0x17, 0x97, 0xbc, 0x90, 0xbd, 0xe4, 0x1e, 0xf1,
-	0x6c, 0x01, 0x81, 0xe7, 0x41, 0x21, 0xc6, 0x4c, 0x68, 0xa6, 0x66, 0xf3, 0x25, 0xac, 0x83, 0x4a,
-	0x12, 0x0c, 0x70, 0x1c, 0x93, 0x38, 0x7d, 0x75, 0xcb, 0x49, 0xb0, 0xc3, 0x4d, 0xee, 0xe2, 0xe2,
-	0x18, 0x53, 0xec, 0x4a, 0x56, 0xed, 0xb2, 0x87, 0xe8, 0x5d, 0x8a, 0x5d, 0xb8, 0x01, 0xc0, 0xc1,
-	0x88, 0x38, 0xf7, 0x07, 0x22, 0x19, 0xf9, 0x9e, 0x6a, 0x62, 0xe7, 0x1d, 0x44, 0x87, 0xe9, 0x2d,
-	0x3e, 0x55, 0xc0, 0xb9, 0x7d, 0xea, 0xdd, 0x8d, 0x5c, 0xc4, 0xf0, 0x1d, 0x31, 0x2f, 0xe1, 0x55,
-	0xa0, 0xa1, 0x31, 0x1b, 0x92, 0xd8, 0x67, 0xd3, 0xb4, 0x61, 0xf4, 0xef, 0x1f, 0x76, 0xd7, 0xd3,
-	0xe9, 0xbf, 0xed, 0xba, 0x31, 0xa6, 0xf4, 0x3d, 0x16, 0xfb, 0xa1, 0x67, 0x2f, 0xa1, 0xf0, 0x2a,
-	0x28, 0xc9, 0x89, 0x2b, 0x7a, 0xa1, 0xba, 0xa9, 0x67, 0x6f, 0x29, 0x4f, 0xe8, 0xa9, 0x9c, 0x45,
-	0x3b, 0x45, 0x6f, 0xad, 0x3d, 0xf8, 0xed, 0xcb, 0xcb, 0xcb, 0x38, 0xed, 0x3a, 0xb8, 0xf8, 0x44,
-	0x4a, 0x8b, 0xd2, 0x6e, 0xce, 0x15, 0x50, 0xd8, 0xa7, 0x1e, 0xfc, 0x08, 0x80, 0x53, 0x83, 0xdc,
-	0xc8, 0x1e, 0xb4, 0xc2, 0x4c, 0xe3, 0x8d, 0x67, 0x00, 0x16, 0xf1, 0xdb, 0xaf, 0x3d, 0xf8, 0xe1,
-	0xd7, 0xcf, 0xf2, 0x46, 0x7b, 0xc3, 0xca, 0xfc, 0x99, 0xc0, 0x29, 0x7a, 0xc0, 0x26, 0xf0, 0x03,
-	0x50, 0x5b, 0xa9, 0xd8, 0x2b, 0x7f, 0x19, 0xff, 0x34, 0xa4, 0x71, 0xe9, 0x99, 0x90, 0x45, 0x12,
-	0xbd, 0x6b, 0x8f, 0xe6, 0x4d, 0xe5, 0xf1, 0xbc, 0xa9, 0xfc, 0x32, 0x6f, 0x2a, 0x9f, 0x1c, 0x35,
-	0x73, 0x8f, 0x8f, 0x9a, 0xb9, 0x1f, 0x8f, 0x9a, 0xb9, 0xf7, 0x4f, 0xf7, 0x1e, 0x4e, 0x78, 0xeb,
-	0x2d, 0xd3, 0x9c, 0x88, 0x44, 0x45, 0xff, 0x1d, 0x94, 0xc4, 0x7f, 0x98, 0xb7, 0xfe, 0x0c, 0x00,
-	0x00, 0xff, 0xff, 0x96, 0x99, 0xa8, 0xe1, 0x04, 0x0a, 0x00, 0x00,
+	// 1149 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcd, 0x6f, 0x1b, 0x55,
+	0x10, 0xcf, 0xda, 0xeb, 0xaf, 0xb1, 0x49, 0xab, 0x55, 0xaa, 0xae, 0x4d, 0xe3, 0x35, 0xe6, 0xcb,
+	0x2d, 0x8a, 0x4d, 0x03, 0xea, 0x21, 0xa7, 0xc6, 0x4d, 0x5b, 0x5a, 0x25, 0xa2, 0x5a, 0x5c, 0x0e,
+	0x14, 0xc9, 0x7a, 0xd9, 0x7d, 0x5d, 0xaf, 0xea, 0xdd, 0xb7, 0xda, 0xf7, 0x6c, 0xd9, 0x48, 0x5c,
+	0x7a, 0xe2, 0x08, 0xe2, 0x1f, 0xe0, 0xcc, 0x09, 0x44, 0x8f, 0x1c, 0x38, 0x56, 0x9c, 0xaa, 0x72,
+	0x41, 0x1c, 0x0c, 0x72, 0x91, 0x90, 0x72, 0x83, 0xbf, 0x00, 0xbd, 0x0f, 0x7f, 0xd5, 0x4d, 0x42,
+	0x4a, 0x11, 0x27, 0xbf, 0x79, 0xf3, 0xf3, 0xec, 0xcc, 0xfc, 0x7e, 0xb3, 0xb3, 0x50, 0xc4, 0xac,
+	0x83, 0xe3, 0xc0, 0x0f, 0x59, 0x03, 0xf7, 0x83, 0x46, 0xff, 0x62, 0x83, 0x0d, 0xea, 0x51, 0x4c,
+	0x18, 0x31, 0x4e, 0x4f, 0x5d, 0x75, 0xdc, 0x0f, 0xea, 0xfd, 0x8b, 0xa5, 0xb3, 0x0e, 0xa1, 0x01,
+	0xa1, 0x8d, 0x80, 0x7a, 0x1c, 0x19, 0x50, 0x4f, 0x42, 0x4b, 0x45, 0xe9, 0x68, 0x0b, 0xab, 0x21,
+	0x0d, 0xe5, 0x5a, 0xf3, 0x88, 0x47, 0xe4, 0x3d, 0x3f, 0xa9, 0xdb, 0x73, 0x1e, 0x21, 0x5e, 0x17,
+	0x37, 0x50, 0xe4, 0x37, 0x50, 0x18, 0x12, 0x86, 0x98, 0x4f, 0xc2, 0xc9, 0x7f, 0x8a, 0xca, 0x2b,
+	0xac, 0xfd, 0xde, 0xdd, 0x06, 0x0a, 0x87, 0xca, 0xf5, 0xea, 0x52, 0xbe, 0xc8, 0x71, 0x30, 0xa5,
+	0x6d, 0xd6, 0x8b, 0xba, 0x58, 0x81, 0x4a, 0x4b, 0xa0, 0x2e, 0x99, 0xa4, 0xba, 0xbe, 0xe4, 0x8b,
+	0x50, 0x8c, 0x02, 0xf5, 0xe8, 0xea, 0xf7, 0x1a, 0xbc, 0xb4, 0x47, 0xbd, 0xab, 0x1c, 0x84, 0x7b,
+	0x41, 0x6b, 0x60, 0xd4, 0x40, 0x77, 0x11, 0x43, 0xa6, 0x56, 0xd1, 0x6a, 0xf9, 0xcd, 0xb5, 0xba,
+	0xcc, 0xad, 0x3e, 0xc9, 0xad, 0xbe, 0x1d, 0x0e, 0x6d, 0x81, 0x30, 0x8a, 0xa0, 0x53, 0xff, 0x13,
+	0x6c, 0x26, 0x2a, 0x5a, 0x4d, 0x6b, 0xa6, 0x0e, 0x46, 0x96, 0xb6, 0x61, 0x8b, 0x2b, 0xc3, 0x02,
+	0xbd, 0x83, 0x68, 0xc7, 0x4c, 0x56, 0xb4, 0x5a, 0xae, 0x99, 0xff, 0x6b, 0x64, 0x65, 0xe2, 0x6e,
+	0xb4, 0x55, 0xdd, 0xa8, 0xda, 0xc2, 0x61, 0xbc, 0x05, 0xa7, 0x5c, 0x1c, 0xc5, 0xd8, 0x41, 0x0c,
+	0xbb, 0xed, 0xbb, 0x31, 0x09, 0x4c, 0x5d, 0x60, 0x13, 0xa6, 0x66, 0xaf, 0xce, 0x5c, 0xd7, 0x62,
+	0x12, 0x18, 0x06, 0xe8, 0x02, 0x91, 0xaa, 0x68, 0xb5, 0x82, 0x2d, 0xce, 0x5b, 0xfa, 0x67, 0x5f,
+	0x59, 0x2b, 0xd5, 0xef, 0x12, 0x90, 0xdd, 0xc5, 0x1e, 0x72, 0x86, 0xad, 0x81, 0xb1, 0x06, 0xa9,
+	0x90, 0x84, 0x0e, 0x16, 0xa9, 0xeb, 0xb6, 0x34, 0x8c, 0xeb, 0x90, 0xf3, 0x10, 0xa7, 0xca, 0x77,
+	0x64, 0xaa, 0xb9, 0xe6, 0x85, 0x5f, 0x46, 0xd6, 0x1b, 0x9e, 0xcf, 0x3a, 0xbd, 0xfd, 0xba, 0x43,
+	0x02, 0x45, 0xa0, 0xfa, 0xd9, 0xa0, 0xee, 0xbd, 0x06, 0x1b, 0x46, 0x98, 0xd6, 0x6f, 0x84, 0xcc,
+	0xce, 0x7a, 0x88, 0xde, 0xe2, 0xff, 0x35, 0xca, 0x90, 0xf4, 0x10, 0x15, 0x25, 0xe9, 0xcd, 0xc2,
+	0x78, 0x64, 0x65, 0xaf, 0x23, 0xba, 0xeb, 0x07, 0x3e, 0xb3, 0xb9, 0xc3, 0x58, 0x85, 0x04, 0x23,
+	0xb2, 0x0a, 0x3b, 0xc1, 0x88, 0x71, 0x13, 0x52, 0x7d, 0xd4, 0xed, 0x61, 0x91, 0x76, 0xae, 0xf9,
+	0xee, 0x3f, 0x7f, 0xe8, 0x78, 0x64, 0xa5, 0xb7, 0x03, 0xd2, 0x0b, 0x99, 0x2d, 0x43, 0xf0, 0x0e,
+	0x08, 0x52, 0xd2, 0xb2, 0x03, 0xa2, 0xfd, 0x05, 0xd0, 0xfa, 0x66, 0x46, 0x5c, 0x68, 0x7d, 0x6e,
+	0xc5, 0x66, 0x56, 0x5a, 0x31, 0xb7, 0xa8, 0x99, 0x93, 0x16, 0xdd, 0x5a, 0xe5, 0xbd, 0xfa, 0xf1,
+	0xc1, 0x46, 0xba, 0x35, 0xd8, 0x41, 0x0c, 0x55, 0xff, 0x4c, 0x42, 0x61, 0x5b, 0xc8, 0x68, 0xd7,
+	0xa7, 0xac, 0x35, 0x30, 0xee, 0x40, 0xd6, 0xe9, 0x20, 0x3f, 0x6c, 0xfb, 0xae, 0x68, 0x5e, 0xae,
+	0x79, 0xf9, 0x44, 0xd9, 0x66, 0xae, 0xf0, 0x7f, 0xdf, 0xd8, 0x39, 0x18, 0x59, 0x19, 0x47, 0x1e,
+	0x6d, 0x75, 0x70, 0x67, 0xb4, 0x24, 0x0e, 0xa5, 0x25, 0xf9, 0xef, 0x69, 0xd1, 0x8f, 0xa6, 0x25,
+	0xb5, 0x4c, 0x4b, 0xfa, 0xc5, 0xd1, 0x92, 0x99, 0xa3, 0xe5, 0x0e, 0x64, 0xe5, 0x88, 0x62, 0x6a,
+	0x66, 0x2b, 0xc9, 0x5a, 0x7e, 0x73, 0xbd, 0xfe, 0xf4, 0x9b, 0xa5, 0x2e, 0xbb, 0xdf, 0xe2, 0x33,
+	0xdc, 0xac, 0x3c, 0x1c, 0x59, 0x2b, 0x07, 0x23, 0x0b, 0xd0, 0x94, 0x92, 0xaf, 0x7f, 0xb5, 0x60,
+	0x46, 0x90, 0x3d, 0x0d, 0x28, 0x39, 0xcf, 0x2d, 0x70, 0x0e, 0x0b, 0x9c, 0xe7, 0x0f, 0xe3, 0xfc,
+	0x07, 0x1d, 0x0a, 0x3b, 0xc3, 0x10, 0x05, 0xbe, 0x73, 0x0d, 0xe3, 0xff, 0x87, 0xf3, 0x9b, 0x90,
+	0xe7, 0x9c, 0x33, 0x3f, 0x6a, 0x3b, 0x28, 0x7a, 0x0e, 0xd6, 0xb9, 0x64, 0x5a, 0x7e, 0x74, 0x05,
+	0x45, 0x93, 0x58, 0x77, 0x31, 0x16, 0xb1, 0xf4, 0xe7, 0x8a, 0x75, 0x0d, 0x63, 0x1e, 0x4b, 0x49,
+	0x28, 0x75, 0xb4, 0x84, 0xd2, 0xcb, 0x12, 0xca, 0xbc, 0x38, 0x09, 0x65, 0x0f, 0x91, 0x50, 0xee,
+	0x3f, 0x91, 0x10, 0x2c, 0x48, 0x28, 0xbf, 0x20, 0xa1, 0xc2, 0x61, 0x12, 0xaa, 0x42, 0xe9, 0xea,
+	0x80, 0xe1, 0x90, 0xfa, 0x24, 0x7c, 0x3f, 0x12, 0x0b, 0x6c, 0xb6, 0x37, 0xd4, 0x0b, 0x79, 0xac,
+	0xc1, 0x99, 0x85, 0x7d, 0x62, 0x63, 0x1a, 0x91, 0x90, 0x8a, 0x42, 0xc5, 0x4a, 0x10, 0x5a, 0x53,
+	0x5b, 0xe0, 0x3c, 0xe8, 0x5d, 0xe2, 0x51, 0x33, 0x21, 0x8a, 0x3c, 0xb3, 0x5c, 0xe4, 0x2e, 0xf1,
+	0x6c, 0x01, 0x31, 0x4e, 0x43, 0x32, 0xc6, 0x4c, 0x68, 0xa6, 0x60, 0xf3, 0xa3, 0x51, 0x84, 0x6c,
+	0x3f, 0x68, 0xe3, 0x38, 0x26, 0xb1, 0x7a, 0xeb, 0x66, 0xfa, 0xc1, 0x55, 0x6e, 0x72, 0x17, 0x17,
+	0x47, 0x8f, 0x62, 0x57, 0xb2, 0x6a, 0x67, 0x3c, 0x44, 0x6f, 0x53, 0xec, 0x1a, 0xeb, 0x00, 0xfb,
+	0x5d, 0xe2, 0xdc, 0x6b, 0x8b, 0x64, 0xe4, 0xfb, 0x34, 0x27, 0x6e, 0xde, 0xe3, 0x19, 0xad, 0x03,
+	0x50, 0x86, 0x18, 0x6e, 0xc7, 0x84, 0x30, 0x35, 0xd7, 0x39, 0x71, 0x63, 0x13, 0xc2, 0x54, 0x91,
+	0x5f, 0x68, 0x70, 0x6a, 0x8f, 0x7a, 0xb7, 0x23, 0x17, 0x31, 0x7c, 0x4b, 0xac, 0x53, 0xe3, 0x12,
+	0xe4, 0x50, 0x8f, 0x75, 0x48, 0xec, 0xb3, 0xa1, 0x9a, 0x27, 0xf3, 0xf1, 0x83, 0x8d, 0x35, 0xf5,
+	0x71, 0xb0, 0xed, 0xba, 0x31, 0xa6, 0xf4, 0x03, 0x16, 0xfb, 0xa1, 0x67, 0xcf, 0xa0, 0xc6, 0x25,
+	0x48, 0xcb, 0x85, 0x2c, 0x46, 0x25, 0xbf, 0x69, 0x2e, 0x37, 0x41, 0x3e, 0xa1, 0xa9, 0x73, 0x92,
+	0x6d, 0x85, 0xde, 0x5a, 0xbd, 0xff, 0xc7, 0x37, 0x17, 0x66, 0x71, 0xaa, 0x45, 0x38, 0xfb, 0x54,
+	0x4a, 0x93, 0xce, 0x57, 0x1f, 0x6b, 0x00, 0x9c, 0x93, 0x0f, 0xf7, 0xae, 0xa0, 0x6e, 0xd7, 0x78,
+	0x1b, 0xd2, 0x14, 0x87, 0x2e, 0x8e, 0x8f, 0x4d, 0x53, 0xe1, 0x94, 0xfe, 0x13, 0x53, 0xfd, 0x4f,
+	0x34, 0x9b, 0x9c, 0xd3, 0xec, 0xce, 0x64, 0x26, 0xe4, 0x24, 0xd6, 0x79, 0xb2, 0x27, 0x98, 0x46,
+	0x35, 0x0d, 0x2f, 0xcb, 0xad, 0xd0, 0xe5, 0xb3, 0xa7, 0x98, 0xcb, 0x7a, 0x6a, 0x16, 0xb7, 0xf2,
+	0xbc, 0x64, 0x95, 0x53, 0xb5, 0x0b, 0xc6, 0xac, 0xa6, 0xa9, 0xc8, 0x94, 0x4a, 0xb4, 0x99, 0x4a,
+	0x4e, 0x20, 0xb1, 0x79, 0xd5, 0x24, 0x17, 0x54, 0xb3, 0xf9, 0x6d, 0x02, 0x92, 0x7b, 0xd4, 0x33,
+	0x3e, 0x05, 0x98, 0xfb, 0x54, 0xb2, 0x96, 0xa3, 0x2d, 0x68, 0xbf, 0xf4, 0xe6, 0x31, 0x80, 0x29,
+	0x45, 0xaf, 0xdf, 0xff, 0xe9, 0xf7, 0x2f, 0x13, 0x56, 0x75, 0xbd, 0xb1, 0xf4, 0xb9, 0x86, 0x15,
+	0xba, 0xcd, 0x06, 0xc6, 0xc7, 0x50, 0x58, 0x10, 0xdd, 0x2b, 0xcf, 0x8c, 0x3f, 0x0f, 0x29, 0x9d,
+	0x3f, 0x16, 0x32, 0x6d, 0xde, 0x1e, 0x64, 0x26, 0x1a, 0x39, 0xf7, 0xec, 0xc4, 0xa5, 0xb7, 0xf4,
+	0xda, 0x51, 0xde, 0x49, 0xb8, 0xe6, 0xe5, 0x87, 0xe3, 0xb2, 0xf6, 0x68, 0x5c, 0xd6, 0x7e, 0x1b,
+	0x97, 0xb5, 0xcf, 0x9f, 0x94, 0x57, 0x1e, 0x3d, 0x29, 0xaf, 0xfc, 0xfc, 0xa4, 0xbc, 0xf2, 0xd1,
+	0xbc, 0x28, 0x70, 0x9f, 0x6b, 0x62, 0x56, 0xf5, 0x40, 0xd4, 0x2d, 0x84, 0xb1, 0x9f, 0x16, 0x1f,
+	0x9d, 0xef, 0xfc, 0x3d, 0x00, 0xdb, 0xc8, 0x25, 0x08, 0xb5, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -542,6 +693,9 @@ type MsgClient interface {
 	// UpdateParams defined a governance operation for updating the x/evm module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// EVMCall defines a method calling a contract from a cosmos account, it allows to call contracts
+	// on behalf of another account through an EVMCallAuthorization.
+	EVMCall(ctx context.Context, in *MsgEVMCall, opts ...grpc.CallOption) (*MsgEVMCallResponse, error)
 }
 
 type msgClient struct {
@@ -570,6 +724,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) EVMCall(ctx context.Context, in *MsgEVMCall, opts ...grpc.CallOption) (*MsgEVMCallResponse, error) {
+	out := new(MsgEVMCallResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Msg/EVMCall", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// EthereumTx defines a method submitting Ethereum transactions.
@@ -577,6 +740,9 @@ type MsgServer interface {
 	// UpdateParams defined a governance operation for updating the x/evm module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// EVMCall defines a method calling a contract from a cosmos account, it allows to call contracts
+	// on behalf of another account through an EVMCallAuthorization.
+	EVMCall(context.Context, *MsgEVMCall) (*MsgEVMCallResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -589,6 +755,9 @@ func (*UnimplementedMsgServer) EthereumTx(ctx context.Context, req *MsgEthereumT
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) EVMCall(ctx context.Context, req *MsgEVMCall) (*MsgEVMCallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EVMCall not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -630,6 +799,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_EVMCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEVMCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EVMCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Msg/EVMCall",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EVMCall(ctx, req.(*MsgEVMCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethermint.evm.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -642,6 +829,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "EVMCall",
+			Handler:    _Msg_EVMCall_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/evm/v1/tx.proto",
@@ -1092,6 +1283,13 @@ func (m *MsgEthereumTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.StateRoot) > 0 {
+		i -= len(m.StateRoot)
+		copy(dAtA[i:], m.StateRoot)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StateRoot)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.BlockHash) > 0 {
 		i -= len(m.BlockHash)
 		copy(dAtA[i:], m.BlockHash)
@@ -1205,6 +1403,114 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgEVMCall) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEVMCall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEVMCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Value.Size()
+		i -= size
+		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEVMCallResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEVMCallResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEVMCallResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Logs) > 0 {
+		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Ret) > 0 {
+		i -= len(m.Ret)
+		copy(dAtA[i:], m.Ret)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Ret)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1437,6 +1743,10 @@ func (m *MsgEthereumTxResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.StateRoot)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1464,6 +1774,54 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgEVMCall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Value.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.GasLimit != 0 {
+		n += 1 + sovTx(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *MsgEVMCallResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Ret)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Logs) > 0 {
+		for _, e := range m.Logs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovTx(uint64(m.GasUsed))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3089,21 +3447,55 @@ func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
 				m.BlockHash = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateRoot = append(m.StateRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.StateRoot == nil {
+				m.StateRoot = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
@@ -3275,6 +3667,344 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgEVMCall) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEVMCall: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEVMCall: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEVMCallResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEVMCallResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEVMCallResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ret", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ret = append(m.Ret[:0], dAtA[iNdEx:postIndex]...)
+			if m.Ret == nil {
+				m.Ret = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logs = append(m.Logs, &Log{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -209,6 +209,12 @@ func UnwrapEthereumMsg(tx *sdk.Tx, ethHash common.Hash) (*MsgEthereumTx, error)
 	return nil, fmt.Errorf("eth tx not found: %s", ethHash)
 }
 
+// IntermediateStateRoot chains the commitment of the state changes of a transaction to the state root
+// left by the previous one, the resulting root only depends on the ordered list of state changes.
+func IntermediateStateRoot(parent, commitment common.Hash) common.Hash {
+	return crypto.Keccak256Hash(parent.Bytes(), commitment.Bytes())
+}
+
 // BinSearch execute the binary search and hone in on an executable gas limit
 func BinSearch(lo, hi uint64, executable func(uint64) (bool, *MsgEthereumTxResponse, error)) (uint64, error) {
 	for lo+1 < hi {
@@ -38,6 +38,8 @@ func GetQueryCmd() *cobra.Command {
 		GetBlockGasCmd(),
 		GetBaseFeeCmd(),
 		GetParamsCmd(),
+		GetBurnedFeesCmd(),
+		GetBaseFeeHistoryCmd(),
 	)
 	return cmd
 }
@@ -129,3 +131,61 @@ If the height is not provided, it will use the latest height from context.`,
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetBurnedFeesCmd queries the cumulative amount of base fees burned
+func GetBurnedFeesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burned-fees",
+		Short: "Get the cumulative amount of base fees burned",
+		Long: `Get the cumulative amount of base fees burned since the base fee burning was enabled.
+If the height is not provided, it will use the latest height from context.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BurnedFees(cmd.Context(), &types.QueryBurnedFeesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetBaseFeeHistoryCmd queries the base fee history of the last blocks
+func GetBaseFeeHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "base-fee-history",
+		Short: "Get the base fee history of the last blocks in the base fee window",
+		Long: `Get the base fee history of the last blocks in the base fee window.
+If the height is not provided, it will use the latest height from context.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BaseFeeHistory(cmd.Context(), &types.QueryBaseFeeHistoryRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -69,6 +69,7 @@ func (k *Keeper) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) {
 	limitedGasWanted := sdk.NewDec(int64(gasWanted)).Mul(minGasMultiplier)
 	gasWanted = sdk.MaxDec(limitedGasWanted, sdk.NewDec(int64(gasUsed))).TruncateInt().Uint64()
 	k.SetBlockGasWanted(ctx, gasWanted)
+	k.recordBaseFee(ctx, gasWanted)
 
 	defer func() {
 		telemetry.SetGauge(float32(gasWanted), "feemarket", "block_gas")
@@ -80,3 +81,24 @@ func (k *Keeper) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) {
 		sdk.NewAttribute("amount", fmt.Sprintf("%d", gasWanted)),
 	))
 }
+
+// recordBaseFee adds the block to the base fee history when the base fee is enabled.
+func (k *Keeper) recordBaseFee(ctx sdk.Context, gasWanted uint64) {
+	params := k.GetParams(ctx)
+	if params.BaseFeeWindow == 0 || !params.IsBaseFeeEnabled(ctx.BlockHeight()) {
+		return
+	}
+
+	var gasLimit uint64
+	if consParams := ctx.ConsensusParams(); consParams != nil && consParams.Block != nil && consParams.Block.MaxGas > 0 {
+		gasLimit = uint64(consParams.Block.MaxGas)
+	}
+
+	k.AddBaseFeeRecord(ctx, types.BaseFeeRecord{
+		Height:    ctx.BlockHeight(),
+		Time:      ctx.BlockTime(),
+		GasWanted: gasWanted,
+		GasLimit:  gasLimit,
+		BaseFee:   params.BaseFee,
+	}, params.BaseFeeWindow)
+}
@@ -18,15 +18,24 @@ package keeper
 import (
 	"fmt"
 	"math/big"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/math"
+
+	"github.com/evmos/ethermint/x/feemarket/types"
 )
 
+// maxTimeWeight bounds the weight of the time elapsed since the parent block, in target block times,
+// in the time weighted base fee calculation.
+const maxTimeWeight = 4
+
 // CalculateBaseFee calculates the base fee for the current block. This is only calculated once per
 // block during BeginBlock. If the NoBaseFee parameter is enabled or below activation height, this function returns nil.
+// With the time weighted algorithm, the base fee history is used instead of the gas wanted of the parent block,
+// it falls back to the EIP-1559 algorithm when the history is empty.
 // NOTE: This code is inspired from the go-ethereum EIP1559 implementation and adapted to Cosmos SDK-based
 // chains. For the canonical code refer to: https://github.com/ethereum/go-ethereum/blob/master/consensus/misc/eip1559.go
 func (k Keeper) CalculateBaseFee(ctx sdk.Context) *big.Int {
@@ -61,6 +70,12 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) *big.Int {
 		panic(fmt.Sprintf("get invalid consensus params: %s", consParams))
 	}
 	gasLimit := big.NewInt(consParams.Block.MaxGas)
+	if params.BaseFeeAlgorithm == types.BaseFeeAlgorithmTimeWeighted {
+		if history := k.GetBaseFeeHistory(ctx); len(history) > 0 {
+			return calculateTimeWeightedBaseFee(params, parentBaseFee, gasLimit, history, ctx.BlockTime())
+		}
+	}
+
 	// CONTRACT: ElasticityMultiplier cannot be 0 as it's checked in the params
 	// validation
 	parentGasTargetBig := new(big.Int).Div(gasLimit, new(big.Int).SetUint64(uint64(params.ElasticityMultiplier)))
@@ -103,3 +118,79 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) *big.Int {
 	minGasPrice := params.MinGasPrice.TruncateInt().BigInt()
 	return math.BigMax(x.Sub(parentBaseFee, baseFeeDelta), minGasPrice)
 }
+
+// EstimateNextBaseFee estimates the base fee of the block following the last record of the base fee history,
+// assuming it's produced after the target block time. It returns nil if the time weighted algorithm is not
+// enabled or the history is empty.
+func (k Keeper) EstimateNextBaseFee(ctx sdk.Context) *big.Int {
+	params := k.GetParams(ctx)
+	if params.BaseFeeAlgorithm != types.BaseFeeAlgorithmTimeWeighted || params.NoBaseFee {
+		return nil
+	}
+	history := k.GetBaseFeeHistory(ctx)
+	if len(history) == 0 {
+		return nil
+	}
+	parent := history[len(history)-1]
+	if parent.GasLimit == 0 {
+		return nil
+	}
+	blockTime := parent.Time.Add(params.TargetBlockTime)
+	return calculateTimeWeightedBaseFee(params, parent.BaseFee.BigInt(), new(big.Int).SetUint64(parent.GasLimit), history, blockTime)
+}
+
+// calculateTimeWeightedBaseFee adjusts the parent base fee with the gas wanted over the history window,
+// normalized to the target block time, against the gas target. The adjustment is weighted by the time
+// elapsed since the parent block, so slow blocks move the base fee more than fast ones.
+func calculateTimeWeightedBaseFee(
+	params types.Params,
+	parentBaseFee, gasLimit *big.Int,
+	history []types.BaseFeeRecord,
+	blockTime time.Time,
+) *big.Int {
+	// CONTRACT: TargetBlockTime and ElasticityMultiplier cannot be 0 as it's checked in the params validation
+	targetTime := big.NewInt(int64(params.TargetBlockTime))
+	gasTarget := new(big.Int).Div(gasLimit, new(big.Int).SetUint64(uint64(params.ElasticityMultiplier)))
+	if gasTarget.Sign() <= 0 {
+		return new(big.Int).Set(parentBaseFee)
+	}
+
+	oldest, parent := history[0], history[len(history)-1]
+	// the blocks of the window span from the oldest one to the current one
+	elapsed := blockTime.Sub(oldest.Time)
+	if elapsed <= 0 {
+		elapsed = params.TargetBlockTime * time.Duration(len(history))
+	}
+	totalGas := new(big.Int)
+	for _, record := range history {
+		totalGas.Add(totalGas, new(big.Int).SetUint64(record.GasWanted))
+	}
+	// gas wanted per target block time over the window
+	gasUsed := new(big.Int).Mul(totalGas, targetTime)
+	gasUsed.Div(gasUsed, big.NewInt(int64(elapsed)))
+
+	weight := blockTime.Sub(parent.Time)
+	if weight <= 0 || gasUsed.Cmp(gasTarget) == 0 {
+		return new(big.Int).Set(parentBaseFee)
+	}
+	if weight > maxTimeWeight*params.TargetBlockTime {
+		weight = maxTimeWeight * params.TargetBlockTime
+	}
+
+	// baseFeeDelta = parentBaseFee * |gasUsed - gasTarget| / gasTarget / denominator * weight / targetTime
+	gasUsedDelta := new(big.Int).Sub(gasUsed, gasTarget)
+	x := new(big.Int).Mul(parentBaseFee, new(big.Int).Abs(gasUsedDelta))
+	x.Mul(x, big.NewInt(int64(weight)))
+	y := new(big.Int).Mul(gasTarget, new(big.Int).SetUint64(uint64(params.BaseFeeChangeDenominator)))
+	y.Mul(y, targetTime)
+	baseFeeDelta := x.Div(x, y)
+
+	if gasUsedDelta.Sign() > 0 {
+		return baseFeeDelta.Add(parentBaseFee, math.BigMax(baseFeeDelta, common.Big1))
+	}
+
+	// Set global min gas price as lower bound of the base fee, transactions below
+	// the min gas price don't even reach the mempool.
+	minGasPrice := params.MinGasPrice.TruncateInt().BigInt()
+	return math.BigMax(baseFeeDelta.Sub(parentBaseFee, baseFeeDelta), minGasPrice)
+}
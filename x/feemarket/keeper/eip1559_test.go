@@ -4,10 +4,12 @@ import (
 	"fmt"
 	"math/big"
 	"testing"
+	"time"
 
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/evmos/ethermint/testutil"
+	"github.com/evmos/ethermint/x/feemarket/types"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -125,3 +127,87 @@ func (suite *EIP1559TestSuite) TestCalculateBaseFee() {
 		})
 	}
 }
+
+func (suite *EIP1559TestSuite) TestCalculateTimeWeightedBaseFee() {
+	now := time.Unix(1700000000, 0).UTC()
+	type record struct {
+		ago       time.Duration
+		gasWanted uint64
+	}
+	testCases := []struct {
+		name                 string
+		parentBlockGasWanted uint64
+		history              []record
+		expFee               *big.Int
+	}{
+		{
+			"empty history - fallback to EIP-1559",
+			100,
+			nil,
+			big.NewInt(1125000000),
+		},
+		{
+			"gas rate equal to the target",
+			0,
+			[]record{{10 * time.Second, 50}, {5 * time.Second, 50}},
+			big.NewInt(1000000000),
+		},
+		{
+			"gas rate above the target, blocks at the target time",
+			0,
+			[]record{{10 * time.Second, 100}, {5 * time.Second, 100}},
+			big.NewInt(1125000000),
+		},
+		{
+			"slow blocks lower the gas rate",
+			0,
+			[]record{{20 * time.Second, 100}, {10 * time.Second, 100}},
+			big.NewInt(1000000000),
+		},
+		{
+			"fast block moves the base fee less",
+			0,
+			[]record{{2500 * time.Millisecond, 100}},
+			big.NewInt(1187500000),
+		},
+		{
+			"time weight is capped",
+			0,
+			[]record{{time.Minute, 0}},
+			big.NewInt(500000000),
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			params := suite.App.FeeMarketKeeper.GetParams(suite.Ctx)
+			params.NoBaseFee = false
+			params.MinGasPrice = sdk.ZeroDec()
+			params.BaseFeeAlgorithm = types.BaseFeeAlgorithmTimeWeighted
+			params.TargetBlockTime = 5 * time.Second
+			suite.Require().NoError(suite.App.FeeMarketKeeper.SetParams(suite.Ctx, params))
+
+			for i, r := range tc.history {
+				suite.App.FeeMarketKeeper.AddBaseFeeRecord(suite.Ctx, types.BaseFeeRecord{
+					Height:    int64(i + 1),
+					Time:      now.Add(-r.ago),
+					GasWanted: r.gasWanted,
+					GasLimit:  100,
+					BaseFee:   params.BaseFee,
+				}, params.BaseFeeWindow)
+			}
+
+			suite.Ctx = suite.Ctx.WithBlockHeight(int64(len(tc.history) + 1)).WithBlockTime(now)
+			suite.App.FeeMarketKeeper.SetBlockGasWanted(suite.Ctx, tc.parentBlockGasWanted)
+			blockParams := tmproto.BlockParams{
+				MaxGas:   100,
+				MaxBytes: 10,
+			}
+			suite.Ctx = suite.Ctx.WithConsensusParams(&tmproto.ConsensusParams{Block: &blockParams})
+
+			fee := suite.App.FeeMarketKeeper.CalculateBaseFee(suite.Ctx)
+			suite.Require().Equal(tc.expFee, fee, tc.name)
+		})
+	}
+}
@@ -60,3 +60,27 @@ func (k Keeper) BlockGas(c context.Context, _ *types.QueryBlockGasRequest) (*typ
 		Gas: int64(gas),
 	}, nil
 }
+
+// BurnedFees implements the Query/BurnedFees gRPC method
+func (k Keeper) BurnedFees(c context.Context, _ *types.QueryBurnedFeesRequest) (*types.QueryBurnedFeesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryBurnedFeesResponse{
+		Burned: k.GetBurnedFees(ctx),
+	}, nil
+}
+
+// BaseFeeHistory implements the Query/BaseFeeHistory gRPC method
+func (k Keeper) BaseFeeHistory(c context.Context, _ *types.QueryBaseFeeHistoryRequest) (*types.QueryBaseFeeHistoryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res := &types.QueryBaseFeeHistoryResponse{
+		Records: k.GetBaseFeeHistory(ctx),
+	}
+	if nextBaseFee := k.EstimateNextBaseFee(ctx); nextBaseFee != nil {
+		aux := sdkmath.NewIntFromBigInt(nextBaseFee)
+		res.NextBaseFee = &aux
+	}
+
+	return res, nil
+}
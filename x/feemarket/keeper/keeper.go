@@ -18,8 +18,10 @@ package keeper
 import (
 	"math/big"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -125,3 +127,89 @@ func (k Keeper) GetBaseFeeV1(ctx sdk.Context) *big.Int {
 	}
 	return new(big.Int).SetBytes(bz)
 }
+
+// ----------------------------------------------------------------------------
+// Burned Fees
+// Cumulative amount of base fees burned when `BurnBaseFee` is enabled.
+// ----------------------------------------------------------------------------
+
+// GetBurnedFees returns the cumulative amount of base fees burned.
+func (k Keeper) GetBurnedFees(ctx sdk.Context) sdk.Coins {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBurnedFees)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	burned := sdk.Coins{}
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdkmath.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		burned = append(burned, sdk.NewCoin(string(iterator.Key()), amount))
+	}
+	return burned
+}
+
+// AddBurnedFees adds the amount to the cumulative burned fees.
+func (k Keeper) AddBurnedFees(ctx sdk.Context, amount sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	for _, coin := range amount {
+		total := coin.Amount
+		key := types.BurnedFeesKey(coin.Denom)
+		if bz := store.Get(key); len(bz) > 0 {
+			var prev sdkmath.Int
+			if err := prev.Unmarshal(bz); err != nil {
+				panic(err)
+			}
+			total = total.Add(prev)
+		}
+		bz, err := total.Marshal()
+		if err != nil {
+			panic(err)
+		}
+		store.Set(key, bz)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Base Fee History
+// Records of the last blocks in the base fee window.
+// Required by the time weighted base fee calculation.
+// ----------------------------------------------------------------------------
+
+// GetBaseFeeHistory returns the base fee records of the last blocks, ordered by height.
+func (k Keeper) GetBaseFeeHistory(ctx sdk.Context) []types.BaseFeeRecord {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBaseFeeHistory)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var records []types.BaseFeeRecord
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.BaseFeeRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+// AddBaseFeeRecord appends the record to the base fee history and prunes the records
+// which are out of the base fee window.
+func (k Keeper) AddBaseFeeRecord(ctx sdk.Context, record types.BaseFeeRecord, window uint32) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBaseFeeHistory)
+	store.Set(sdk.Uint64ToBigEndian(uint64(record.Height)), k.cdc.MustMarshal(&record))
+
+	// prune with an iterator as the window could have been reduced
+	oldest := record.Height - int64(window) + 1
+	if oldest <= 0 {
+		return
+	}
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(uint64(oldest)))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
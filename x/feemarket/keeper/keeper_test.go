@@ -114,3 +114,54 @@ func (suite *KeeperTestSuite) TestSetGetGasFee() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestAddGetBurnedFees() {
+	suite.SetupTest()
+	suite.Require().Empty(suite.App.FeeMarketKeeper.GetBurnedFees(suite.Ctx))
+
+	suite.App.FeeMarketKeeper.AddBurnedFees(suite.Ctx, sdk.NewCoins(sdk.NewInt64Coin(suite.denom, 100)))
+	suite.App.FeeMarketKeeper.AddBurnedFees(suite.Ctx, sdk.NewCoins(sdk.NewInt64Coin(suite.denom, 50), sdk.NewInt64Coin("atom", 1)))
+	suite.Require().Equal(
+		sdk.NewCoins(sdk.NewInt64Coin(suite.denom, 150), sdk.NewInt64Coin("atom", 1)),
+		suite.App.FeeMarketKeeper.GetBurnedFees(suite.Ctx),
+	)
+
+	res, err := suite.FeeMarketQueryClient.BurnedFees(suite.Ctx.Context(), &types.QueryBurnedFeesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.App.FeeMarketKeeper.GetBurnedFees(suite.Ctx), res.Burned)
+}
+
+func (suite *KeeperTestSuite) TestBaseFeeHistory() {
+	suite.SetupTest()
+	params := suite.App.FeeMarketKeeper.GetParams(suite.Ctx)
+	params.BaseFeeAlgorithm = types.BaseFeeAlgorithmTimeWeighted
+	params.BaseFeeWindow = 3
+	suite.Require().NoError(suite.App.FeeMarketKeeper.SetParams(suite.Ctx, params))
+
+	start := suite.Ctx.BlockHeight()
+	for i := 0; i < 5; i++ {
+		suite.Commit()
+	}
+
+	history := suite.App.FeeMarketKeeper.GetBaseFeeHistory(suite.Ctx)
+	suite.Require().Len(history, 3)
+	for i, record := range history {
+		// the records of the last committed blocks are kept
+		suite.Require().Equal(start+int64(i)+2, record.Height)
+		suite.Require().NotZero(record.GasLimit)
+	}
+
+	res, err := suite.FeeMarketQueryClient.BaseFeeHistory(suite.Ctx.Context(), &types.QueryBaseFeeHistoryRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(history, res.Records)
+	suite.Require().NotNil(res.NextBaseFee)
+	// no gas wanted in the window, the base fee decreases
+	suite.Require().True(res.NextBaseFee.LT(history[len(history)-1].BaseFee))
+
+	// the next base fee is only estimated with the time weighted algorithm
+	params.BaseFeeAlgorithm = types.BaseFeeAlgorithmEIP1559
+	suite.Require().NoError(suite.App.FeeMarketKeeper.SetParams(suite.Ctx, params))
+	res, err = suite.FeeMarketQueryClient.BaseFeeHistory(suite.Ctx.Context(), &types.QueryBaseFeeHistoryRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Nil(res.NextBaseFee)
+}
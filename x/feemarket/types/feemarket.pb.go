@@ -8,15 +8,19 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	_ "github.com/cosmos/gogoproto/types"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -24,6 +28,36 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// BaseFeeAlgorithm defines the algorithms adjusting the base fee between blocks.
+type BaseFeeAlgorithm int32
+
+const (
+	// BASE_FEE_ALGORITHM_EIP1559 adjusts the base fee with the gas wanted of the parent block,
+	// assuming a fixed block cadence.
+	BaseFeeAlgorithmEIP1559 BaseFeeAlgorithm = 0
+	// BASE_FEE_ALGORITHM_TIME_WEIGHTED adjusts the base fee with the rate of gas wanted over the
+	// base fee window, weighted by the time elapsed since the parent block.
+	BaseFeeAlgorithmTimeWeighted BaseFeeAlgorithm = 1
+)
+
+var BaseFeeAlgorithm_name = map[int32]string{
+	0: "BASE_FEE_ALGORITHM_EIP1559",
+	1: "BASE_FEE_ALGORITHM_TIME_WEIGHTED",
+}
+
+var BaseFeeAlgorithm_value = map[string]int32{
+	"BASE_FEE_ALGORITHM_EIP1559":       0,
+	"BASE_FEE_ALGORITHM_TIME_WEIGHTED": 1,
+}
+
+func (x BaseFeeAlgorithm) String() string {
+	return proto.EnumName(BaseFeeAlgorithm_name, int32(x))
+}
+
+func (BaseFeeAlgorithm) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{0}
+}
+
 // Params defines the EVM module parameters
 type Params struct {
 	// no_base_fee forces the EIP-1559 base fee to 0 (needed for 0 price calls)
@@ -43,6 +77,19 @@ type Params struct {
 	// min_gas_multiplier bounds the minimum gas used to be charged
 	// to senders based on gas limit
 	MinGasMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=min_gas_multiplier,json=minGasMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_gas_multiplier"`
+	// burn_base_fee enables the burning of the base fee portion of the EVM tx fees,
+	// only the priority tip is left to the fee collector.
+	BurnBaseFee bool `protobuf:"varint,9,opt,name=burn_base_fee,json=burnBaseFee,proto3" json:"burn_base_fee,omitempty"`
+	// burn_ratio defines the portion of the base fee which is burned when burn_base_fee is enabled,
+	// it must be between 0 and 1.
+	BurnRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=burn_ratio,json=burnRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"burn_ratio"`
+	// base_fee_algorithm selects the algorithm adjusting the base fee between blocks.
+	BaseFeeAlgorithm BaseFeeAlgorithm `protobuf:"varint,11,opt,name=base_fee_algorithm,json=baseFeeAlgorithm,proto3,enum=ethermint.feemarket.v1.BaseFeeAlgorithm" json:"base_fee_algorithm,omitempty"`
+	// base_fee_window is the number of blocks kept in the base fee history, which is
+	// the moving window of gas usage of the time weighted algorithm.
+	BaseFeeWindow uint32 `protobuf:"varint,12,opt,name=base_fee_window,json=baseFeeWindow,proto3" json:"base_fee_window,omitempty"`
+	// target_block_time is the block time the time weighted algorithm normalizes the gas usage to.
+	TargetBlockTime time.Duration `protobuf:"bytes,13,opt,name=target_block_time,json=targetBlockTime,proto3,stdduration" json:"target_block_time"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -106,8 +153,113 @@ func (m *Params) GetEnableHeight() int64 {
 	return 0
 }
 
+func (m *Params) GetBurnBaseFee() bool {
+	if m != nil {
+		return m.BurnBaseFee
+	}
+	return false
+}
+
+func (m *Params) GetBaseFeeAlgorithm() BaseFeeAlgorithm {
+	if m != nil {
+		return m.BaseFeeAlgorithm
+	}
+	return BaseFeeAlgorithmEIP1559
+}
+
+func (m *Params) GetBaseFeeWindow() uint32 {
+	if m != nil {
+		return m.BaseFeeWindow
+	}
+	return 0
+}
+
+func (m *Params) GetTargetBlockTime() time.Duration {
+	if m != nil {
+		return m.TargetBlockTime
+	}
+	return 0
+}
+
+// BaseFeeRecord is an entry of the base fee history.
+type BaseFeeRecord struct {
+	// height of the block
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// time of the block
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// gas_wanted is the block gas wanted used by the base fee calculation
+	GasWanted uint64 `protobuf:"varint,3,opt,name=gas_wanted,json=gasWanted,proto3" json:"gas_wanted,omitempty"`
+	// gas_limit is the block gas limit
+	GasLimit uint64 `protobuf:"varint,4,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// base_fee is the base fee of the block
+	BaseFee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=base_fee,json=baseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"base_fee"`
+}
+
+func (m *BaseFeeRecord) Reset()         { *m = BaseFeeRecord{} }
+func (m *BaseFeeRecord) String() string { return proto.CompactTextString(m) }
+func (*BaseFeeRecord) ProtoMessage()    {}
+func (*BaseFeeRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{1}
+}
+func (m *BaseFeeRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BaseFeeRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BaseFeeRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BaseFeeRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BaseFeeRecord.Merge(m, src)
+}
+func (m *BaseFeeRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *BaseFeeRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_BaseFeeRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BaseFeeRecord proto.InternalMessageInfo
+
+func (m *BaseFeeRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BaseFeeRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *BaseFeeRecord) GetGasWanted() uint64 {
+	if m != nil {
+		return m.GasWanted
+	}
+	return 0
+}
+
+func (m *BaseFeeRecord) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("ethermint.feemarket.v1.BaseFeeAlgorithm", BaseFeeAlgorithm_name, BaseFeeAlgorithm_value)
 	proto.RegisterType((*Params)(nil), "ethermint.feemarket.v1.Params")
+	proto.RegisterType((*BaseFeeRecord)(nil), "ethermint.feemarket.v1.BaseFeeRecord")
 }
 
 func init() {
@@ -115,32 +267,53 @@ func init() {
 }
 
 var fileDescriptor_4feb8b20cf98e6e1 = []byte{
-	// 388 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0xc1, 0x8a, 0xdb, 0x30,
-	0x14, 0xb4, 0x9a, 0xdd, 0xac, 0x57, 0xdb, 0x40, 0x10, 0xdb, 0x62, 0x5a, 0xf0, 0x9a, 0x16, 0x16,
-	0x1f, 0x5a, 0x9b, 0x65, 0xcf, 0xbd, 0xa4, 0x21, 0x6d, 0x0a, 0x85, 0xe0, 0x63, 0x29, 0x08, 0xd9,
-	0x79, 0xb1, 0x45, 0x2c, 0xc9, 0x58, 0x4a, 0x68, 0xfe, 0xa2, 0x9f, 0x95, 0x63, 0x4e, 0xa5, 0xf4,
-	0x10, 0x4a, 0xf2, 0x23, 0x25, 0x76, 0x62, 0xe7, 0xda, 0x3d, 0x49, 0x7a, 0x33, 0x9a, 0x79, 0xd2,
-	0x1b, 0x7c, 0x0f, 0x26, 0x83, 0x52, 0x70, 0x69, 0xc2, 0x19, 0x80, 0x60, 0xe5, 0x1c, 0x4c, 0xb8,
-	0x7c, 0x68, 0x0f, 0x41, 0x51, 0x2a, 0xa3, 0xc8, 0xcb, 0x86, 0x17, 0xb4, 0xd0, 0xf2, 0xe1, 0xd5,
-	0x6d, 0xaa, 0x52, 0x55, 0x51, 0xc2, 0xc3, 0xae, 0x66, 0xbf, 0xf9, 0xd5, 0xc1, 0xdd, 0x09, 0x2b,
-	0x99, 0xd0, 0xc4, 0xc5, 0x37, 0x52, 0xd1, 0x98, 0x69, 0xa0, 0x33, 0x00, 0x07, 0x79, 0xc8, 0xb7,
-	0xa3, 0x6b, 0xa9, 0x06, 0x4c, 0xc3, 0x08, 0x80, 0x7c, 0xc0, 0xaf, 0x4f, 0x20, 0x4d, 0x32, 0x26,
-	0x53, 0xa0, 0x53, 0x90, 0x4a, 0x70, 0xc9, 0x8c, 0x2a, 0x9d, 0x67, 0x1e, 0xf2, 0x7b, 0x91, 0x13,
-	0xd7, 0xec, 0x8f, 0x15, 0x61, 0xd8, 0xe2, 0xe4, 0x11, 0xbf, 0x80, 0x9c, 0x69, 0xc3, 0x13, 0x6e,
-	0x56, 0x54, 0x2c, 0x72, 0xc3, 0x8b, 0x9c, 0x43, 0xe9, 0x74, 0xaa, 0x8b, 0xb7, 0x2d, 0xf8, 0xb5,
-	0xc1, 0xc8, 0x5b, 0xdc, 0x03, 0xc9, 0xe2, 0x1c, 0x68, 0x06, 0x3c, 0xcd, 0x8c, 0x73, 0xe9, 0x21,
-	0xbf, 0x13, 0x3d, 0xaf, 0x8b, 0x9f, 0xab, 0x1a, 0x19, 0x63, 0xbb, 0xe9, 0xba, 0xeb, 0x21, 0xff,
-	0x7a, 0x10, 0xac, 0xb7, 0x77, 0xd6, 0x9f, 0xed, 0xdd, 0x7d, 0xca, 0x4d, 0xb6, 0x88, 0x83, 0x44,
-	0x89, 0x30, 0x51, 0x5a, 0x28, 0x7d, 0x5c, 0xde, 0xeb, 0xe9, 0x3c, 0x34, 0xab, 0x02, 0x74, 0x30,
-	0x96, 0x26, 0xba, 0x3a, 0x76, 0x4d, 0x22, 0xdc, 0x13, 0x5c, 0xd2, 0x94, 0x69, 0x5a, 0x94, 0x3c,
-	0x01, 0xe7, 0xea, 0xbf, 0xf5, 0x86, 0x90, 0x44, 0x37, 0x82, 0xcb, 0x4f, 0x4c, 0x4f, 0x0e, 0x12,
-	0xe4, 0x3b, 0x26, 0x27, 0xcd, 0xb3, 0x57, 0xdb, 0x4f, 0x12, 0xee, 0xd7, 0xc2, 0xed, 0x0f, 0x7d,
-	0xb9, 0xb0, 0x2f, 0xfa, 0x97, 0x51, 0x9f, 0x4b, 0x6e, 0x38, 0xcb, 0x9b, 0xf1, 0x0d, 0x46, 0xeb,
-	0x9d, 0x8b, 0x36, 0x3b, 0x17, 0xfd, 0xdd, 0xb9, 0xe8, 0xe7, 0xde, 0xb5, 0x36, 0x7b, 0xd7, 0xfa,
-	0xbd, 0x77, 0xad, 0x6f, 0xef, 0xce, 0xbc, 0x60, 0x79, 0xb0, 0x6a, 0x93, 0xf5, 0xe3, 0x2c, 0x5b,
-	0x95, 0x6b, 0xdc, 0xad, 0x72, 0xf2, 0xf8, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xa5, 0xce, 0xeb, 0x97,
-	0x7f, 0x02, 0x00, 0x00,
+	// 734 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcf, 0x4f, 0x13, 0x41,
+	0x14, 0xee, 0x42, 0x29, 0xed, 0x94, 0x4a, 0x9d, 0x20, 0xae, 0x45, 0xb7, 0x1b, 0x4c, 0x48, 0x63,
+	0x74, 0x1b, 0x20, 0x24, 0x1a, 0xe3, 0x81, 0xda, 0x16, 0x6a, 0x68, 0x20, 0x6b, 0x63, 0x13, 0x63,
+	0x32, 0x99, 0xdd, 0x0e, 0xdb, 0x09, 0xbb, 0x33, 0xcd, 0xee, 0x14, 0xe4, 0x3f, 0x30, 0x9c, 0x38,
+	0x1a, 0x13, 0x4e, 0xfe, 0x33, 0x1c, 0x39, 0x1a, 0x0f, 0x68, 0xe0, 0xe2, 0x9f, 0x61, 0x76, 0x76,
+	0xfb, 0xc3, 0xca, 0x45, 0x3c, 0xb5, 0xef, 0xbd, 0xef, 0x7d, 0xfb, 0xbd, 0x79, 0xdf, 0x0c, 0x58,
+	0x21, 0xa2, 0x4b, 0x7c, 0x8f, 0x32, 0x51, 0xde, 0x27, 0xc4, 0xc3, 0xfe, 0x01, 0x11, 0xe5, 0xc3,
+	0xd5, 0x51, 0x60, 0xf4, 0x7c, 0x2e, 0x38, 0x5c, 0x1c, 0xe2, 0x8c, 0x51, 0xe9, 0x70, 0xb5, 0xb0,
+	0xe0, 0x70, 0x87, 0x4b, 0x48, 0x39, 0xfc, 0x17, 0xa1, 0x0b, 0x9a, 0xc3, 0xb9, 0xe3, 0x92, 0xb2,
+	0x8c, 0xac, 0xfe, 0x7e, 0xb9, 0xd3, 0xf7, 0xb1, 0xa0, 0x9c, 0xc5, 0xf5, 0xe2, 0x64, 0x5d, 0x50,
+	0x8f, 0x04, 0x02, 0x7b, 0xbd, 0x08, 0xb0, 0xfc, 0x25, 0x05, 0x52, 0x7b, 0xd8, 0xc7, 0x5e, 0x00,
+	0x35, 0x90, 0x65, 0x1c, 0x59, 0x38, 0x20, 0x68, 0x9f, 0x10, 0x55, 0xd1, 0x95, 0x52, 0xda, 0xcc,
+	0x30, 0x5e, 0xc1, 0x01, 0xa9, 0x13, 0x02, 0x5f, 0x81, 0xa5, 0x41, 0x11, 0xd9, 0x5d, 0xcc, 0x1c,
+	0x82, 0x3a, 0x84, 0x71, 0x8f, 0x32, 0x2c, 0xb8, 0xaf, 0x4e, 0xe9, 0x4a, 0x29, 0x67, 0xaa, 0x56,
+	0x84, 0x7e, 0x2d, 0x01, 0xd5, 0x51, 0x1d, 0xae, 0x83, 0x7b, 0xc4, 0xc5, 0x81, 0xa0, 0x36, 0x15,
+	0xc7, 0xc8, 0xeb, 0xbb, 0x82, 0xf6, 0x5c, 0x4a, 0x7c, 0x75, 0x5a, 0x36, 0x2e, 0x8c, 0x8a, 0xcd,
+	0x61, 0x0d, 0x3e, 0x06, 0x39, 0xc2, 0xb0, 0xe5, 0x12, 0xd4, 0x25, 0xd4, 0xe9, 0x0a, 0x75, 0x46,
+	0x57, 0x4a, 0xd3, 0xe6, 0x5c, 0x94, 0xdc, 0x96, 0x39, 0xd8, 0x00, 0xe9, 0xa1, 0xea, 0x94, 0xae,
+	0x94, 0x32, 0x15, 0xe3, 0xfc, 0xb2, 0x98, 0xf8, 0x7e, 0x59, 0x5c, 0x71, 0xa8, 0xe8, 0xf6, 0x2d,
+	0xc3, 0xe6, 0x5e, 0xd9, 0xe6, 0x81, 0xc7, 0x83, 0xf8, 0xe7, 0x59, 0xd0, 0x39, 0x28, 0x8b, 0xe3,
+	0x1e, 0x09, 0x8c, 0x06, 0x13, 0xe6, 0x6c, 0xac, 0x1a, 0x9a, 0x20, 0xe7, 0x51, 0x86, 0x1c, 0x1c,
+	0xa0, 0x9e, 0x4f, 0x6d, 0xa2, 0xce, 0xfe, 0x33, 0x5f, 0x95, 0xd8, 0x66, 0xd6, 0xa3, 0x6c, 0x0b,
+	0x07, 0x7b, 0x21, 0x05, 0xfc, 0x00, 0xe0, 0x80, 0x73, 0x6c, 0xea, 0xf4, 0xad, 0x88, 0xf3, 0x11,
+	0xf1, 0xd8, 0x09, 0x2d, 0x83, 0x9c, 0xd5, 0xf7, 0xd9, 0x68, 0x6f, 0x19, 0xb9, 0xb7, 0x6c, 0x98,
+	0x1c, 0x6c, 0xae, 0x09, 0x80, 0xc4, 0x48, 0x6b, 0xa8, 0xe0, 0x56, 0x5f, 0xce, 0x84, 0x0c, 0x66,
+	0x48, 0x00, 0xdf, 0x01, 0x38, 0x34, 0x02, 0x76, 0x1d, 0xee, 0x53, 0xd1, 0xf5, 0xd4, 0xac, 0xae,
+	0x94, 0xee, 0xac, 0x95, 0x8c, 0x9b, 0xfd, 0x6b, 0xc4, 0x5a, 0x36, 0x07, 0x78, 0x33, 0x6f, 0x4d,
+	0x64, 0xe0, 0x0a, 0x98, 0x1f, 0xf2, 0x1e, 0x51, 0xd6, 0xe1, 0x47, 0xea, 0x9c, 0xf4, 0x46, 0x2e,
+	0x86, 0xb6, 0x65, 0x12, 0xee, 0x82, 0xbb, 0x02, 0xfb, 0x0e, 0x11, 0xc8, 0x72, 0xb9, 0x7d, 0x80,
+	0x42, 0x4f, 0xab, 0x39, 0x5d, 0x29, 0x65, 0xd7, 0x1e, 0x18, 0x91, 0xe1, 0x8d, 0x81, 0xe1, 0x8d,
+	0x6a, 0x7c, 0x21, 0x2a, 0xe9, 0x70, 0xe0, 0xcf, 0x3f, 0x8a, 0x8a, 0x39, 0x1f, 0x75, 0x57, 0xc2,
+	0xe6, 0x16, 0xf5, 0xc8, 0x9b, 0x64, 0x3a, 0x99, 0x9f, 0x31, 0xf3, 0x94, 0x51, 0x41, 0xb1, 0x3b,
+	0x3c, 0xca, 0xe5, 0x5f, 0x0a, 0xc8, 0xc5, 0xba, 0x4d, 0x62, 0x73, 0xbf, 0x03, 0x17, 0x41, 0x2a,
+	0x36, 0xa2, 0x22, 0x8d, 0x18, 0x47, 0xf0, 0x39, 0x48, 0x4a, 0x15, 0x53, 0x52, 0x45, 0xe1, 0x2f,
+	0x15, 0xad, 0xc1, 0xb5, 0x8b, 0x64, 0x9c, 0x86, 0x32, 0x64, 0x07, 0x7c, 0x04, 0x40, 0xe8, 0x8c,
+	0x23, 0xcc, 0x04, 0xe9, 0xc8, 0xbb, 0x90, 0x34, 0x33, 0x0e, 0x0e, 0xda, 0x32, 0x01, 0x97, 0x40,
+	0x18, 0x20, 0x97, 0x7a, 0x54, 0xa8, 0x49, 0x59, 0x4d, 0x3b, 0x38, 0xd8, 0x09, 0xe3, 0x3f, 0x8c,
+	0x3f, 0xf3, 0x5f, 0xc6, 0x7f, 0x72, 0xa6, 0x80, 0xfc, 0xe4, 0x8a, 0xe0, 0x4b, 0x50, 0xa8, 0x6c,
+	0xbe, 0xad, 0xa1, 0x7a, 0xad, 0x86, 0x36, 0x77, 0xb6, 0x76, 0xcd, 0x46, 0x6b, 0xbb, 0x89, 0x6a,
+	0x8d, 0xbd, 0xd5, 0x8d, 0x8d, 0x17, 0xf9, 0x44, 0x61, 0xe9, 0xe4, 0x4c, 0xbf, 0x3f, 0xd9, 0x15,
+	0x97, 0x61, 0x1d, 0xe8, 0x37, 0x34, 0xb7, 0x1a, 0xcd, 0x1a, 0x6a, 0xd7, 0x1a, 0x5b, 0xdb, 0xad,
+	0x5a, 0x35, 0xaf, 0x14, 0xf4, 0x93, 0x33, 0xfd, 0xe1, 0x24, 0x45, 0x78, 0x5a, 0x6d, 0x79, 0xb0,
+	0xa4, 0x53, 0x48, 0x7e, 0xfa, 0xaa, 0x25, 0x2a, 0xf5, 0xf3, 0x2b, 0x4d, 0xb9, 0xb8, 0xd2, 0x94,
+	0x9f, 0x57, 0x9a, 0x72, 0x7a, 0xad, 0x25, 0x2e, 0xae, 0xb5, 0xc4, 0xb7, 0x6b, 0x2d, 0xf1, 0xfe,
+	0xe9, 0xd8, 0xa8, 0xe4, 0x30, 0x9c, 0x74, 0xf4, 0xd2, 0x7e, 0x1c, 0x7b, 0x6b, 0xe5, 0xd0, 0x56,
+	0x4a, 0xae, 0x64, 0xfd, 0xf7, 0x00, 0x3c, 0x6f, 0x94, 0x3f, 0x8f, 0x05, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -163,6 +336,44 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TargetBlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TargetBlockTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintFeemarket(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x6a
+	if m.BaseFeeWindow != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.BaseFeeWindow))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.BaseFeeAlgorithm != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.BaseFeeAlgorithm))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.BurnRatio.Size()
+		i -= size
+		if _, err := m.BurnRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.BurnBaseFee {
+		i--
+		if m.BurnBaseFee {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
 	{
 		size := m.MinGasMultiplier.Size()
 		i -= size
@@ -221,6 +432,62 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BaseFeeRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BaseFeeRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BaseFeeRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.GasLimit != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GasWanted != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasWanted))
+		i--
+		dAtA[i] = 0x18
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintFeemarket(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintFeemarket(dAtA []byte, offset int, v uint64) int {
 	offset -= sovFeemarket(v)
 	base := offset
@@ -256,6 +523,41 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovFeemarket(uint64(l))
 	l = m.MinGasMultiplier.Size()
 	n += 1 + l + sovFeemarket(uint64(l))
+	if m.BurnBaseFee {
+		n += 2
+	}
+	l = m.BurnRatio.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if m.BaseFeeAlgorithm != 0 {
+		n += 1 + sovFeemarket(uint64(m.BaseFeeAlgorithm))
+	}
+	if m.BaseFeeWindow != 0 {
+		n += 1 + sovFeemarket(uint64(m.BaseFeeWindow))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TargetBlockTime)
+	n += 1 + l + sovFeemarket(uint64(l))
+	return n
+}
+
+func (m *BaseFeeRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovFeemarket(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovFeemarket(uint64(l))
+	if m.GasWanted != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasWanted))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasLimit))
+	}
+	l = m.BaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
 	return n
 }
 
@@ -473,6 +775,305 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnBaseFee", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnBaseFee = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeAlgorithm", wireType)
+			}
+			m.BaseFeeAlgorithm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeAlgorithm |= BaseFeeAlgorithm(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeWindow", wireType)
+			}
+			m.BaseFeeWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeWindow |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TargetBlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BaseFeeRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BaseFeeRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BaseFeeRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasWanted", wireType)
+			}
+			m.GasWanted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasWanted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeemarket(dAtA[iNdEx:])
@@ -15,6 +15,8 @@
 // along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
 package types
 
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
 const (
 	// ModuleName string name of module
 	ModuleName = "feemarket"
@@ -35,6 +37,8 @@ const (
 const (
 	prefixBlockGasWanted    = iota + 1
 	deprecatedPrefixBaseFee // unused
+	prefixBurnedFees
+	prefixBaseFeeHistory
 )
 
 const (
@@ -44,8 +48,20 @@ const (
 // KVStore key prefixes
 var (
 	KeyPrefixBlockGasWanted = []byte{prefixBlockGasWanted}
+	KeyPrefixBurnedFees     = []byte{prefixBurnedFees}
+	KeyPrefixBaseFeeHistory = []byte{prefixBaseFeeHistory}
 )
 
+// BurnedFeesKey returns the key of the cumulative burned fees of a denom
+func BurnedFeesKey(denom string) []byte {
+	return append(KeyPrefixBurnedFees, []byte(denom)...)
+}
+
+// BaseFeeHistoryKey returns the key of the base fee record of a block
+func BaseFeeHistoryKey(height int64) []byte {
+	return append(KeyPrefixBaseFeeHistory, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
 // Transient Store key prefixes
 var (
 	KeyPrefixTransientBlockGasWanted = []byte{prefixTransientBlockGasUsed}
@@ -17,6 +17,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -33,6 +34,16 @@ var (
 	DefaultEnableHeight = int64(0)
 	// DefaultNoBaseFee is false
 	DefaultNoBaseFee = false
+	// DefaultBurnBaseFee is false
+	DefaultBurnBaseFee = false
+	// DefaultBurnRatio is 1 (i.e the whole base fee is burned when enabled)
+	DefaultBurnRatio = sdk.OneDec()
+	// DefaultBaseFeeAlgorithm is the EIP-1559 algorithm
+	DefaultBaseFeeAlgorithm = BaseFeeAlgorithmEIP1559
+	// DefaultBaseFeeWindow is 10 blocks
+	DefaultBaseFeeWindow = uint32(10)
+	// DefaultTargetBlockTime is 5 seconds
+	DefaultTargetBlockTime = 5 * time.Second
 )
 
 // Parameter keys
@@ -83,6 +94,11 @@ func NewParams(
 		EnableHeight:             enableHeight,
 		MinGasPrice:              minGasPrice,
 		MinGasMultiplier:         minGasPriceMultiplier,
+		BurnBaseFee:              DefaultBurnBaseFee,
+		BurnRatio:                DefaultBurnRatio,
+		BaseFeeAlgorithm:         DefaultBaseFeeAlgorithm,
+		BaseFeeWindow:            DefaultBaseFeeWindow,
+		TargetBlockTime:          DefaultTargetBlockTime,
 	}
 }
 
@@ -96,6 +112,11 @@ func DefaultParams() Params {
 		EnableHeight:             DefaultEnableHeight,
 		MinGasPrice:              DefaultMinGasPrice,
 		MinGasMultiplier:         DefaultMinGasMultiplier,
+		BurnBaseFee:              DefaultBurnBaseFee,
+		BurnRatio:                DefaultBurnRatio,
+		BaseFeeAlgorithm:         DefaultBaseFeeAlgorithm,
+		BaseFeeWindow:            DefaultBaseFeeWindow,
+		TargetBlockTime:          DefaultTargetBlockTime,
 	}
 }
 
@@ -121,6 +142,18 @@ func (p Params) Validate() error {
 		return fmt.Errorf("elasticity multiplier cannot be 0")
 	}
 
+	if p.BurnBaseFee && p.BurnRatio.IsNil() {
+		return fmt.Errorf("burn ratio must be set when burning the base fee")
+	}
+
+	if err := validateBurnRatio(p.BurnRatio); err != nil {
+		return err
+	}
+
+	if err := p.validateBaseFeeAlgorithm(); err != nil {
+		return err
+	}
+
 	return validateMinGasPrice(p.MinGasPrice)
 }
 
@@ -136,6 +169,14 @@ func (p *Params) IsBaseFeeEnabled(height int64) bool {
 	return !p.NoBaseFee && height >= p.EnableHeight
 }
 
+// BaseFeeBurnRatio returns the portion of the base fee to burn, it's zero when the burning is disabled.
+func (p Params) BaseFeeBurnRatio() sdk.Dec {
+	if !p.BurnBaseFee || p.BurnRatio.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return p.BurnRatio
+}
+
 func validateMinGasPrice(i interface{}) error {
 	v, ok := i.(sdk.Dec)
 
@@ -224,3 +265,46 @@ func validateMinGasMultiplier(i interface{}) error {
 	}
 	return nil
 }
+
+// validateBurnRatio accepts a nil ratio for the params created before its introduction,
+// which don't burn the base fee
+func validateBurnRatio(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return nil
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("burn ratio cannot be negative: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("burn ratio cannot be greater than 1: %s", v)
+	}
+	return nil
+}
+
+// validateBaseFeeAlgorithm checks the params of the base fee algorithm
+func (p Params) validateBaseFeeAlgorithm() error {
+	if _, ok := BaseFeeAlgorithm_name[int32(p.BaseFeeAlgorithm)]; !ok {
+		return fmt.Errorf("invalid base fee algorithm: %d", p.BaseFeeAlgorithm)
+	}
+
+	if p.TargetBlockTime < 0 {
+		return fmt.Errorf("target block time cannot be negative: %s", p.TargetBlockTime)
+	}
+
+	if p.BaseFeeAlgorithm == BaseFeeAlgorithmTimeWeighted {
+		if p.BaseFeeWindow == 0 {
+			return fmt.Errorf("base fee window cannot be 0 with the time weighted algorithm")
+		}
+		if p.TargetBlockTime == 0 {
+			return fmt.Errorf("target block time cannot be 0 with the time weighted algorithm")
+		}
+	}
+	return nil
+}
@@ -59,6 +59,46 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 			NewParams(true, 7, 3, 2000000000, int64(544435345345435345), sdk.NewDecWithPrec(20, 4), sdk.NewDec(2)),
 			true,
 		},
+		{
+			"valid: burn ratio not set, burning disabled",
+			Params{BaseFeeChangeDenominator: 8, ElasticityMultiplier: 2, BaseFee: sdkmath.ZeroInt(), MinGasPrice: DefaultMinGasPrice, MinGasMultiplier: DefaultMinGasMultiplier},
+			false,
+		},
+		{
+			"invalid: burn ratio not set, burning enabled",
+			Params{BaseFeeChangeDenominator: 8, ElasticityMultiplier: 2, BaseFee: sdkmath.ZeroInt(), MinGasPrice: DefaultMinGasPrice, MinGasMultiplier: DefaultMinGasMultiplier, BurnBaseFee: true},
+			true,
+		},
+		{
+			"invalid: burn ratio is negative",
+			Params{BaseFeeChangeDenominator: 8, ElasticityMultiplier: 2, BaseFee: sdkmath.ZeroInt(), MinGasPrice: DefaultMinGasPrice, MinGasMultiplier: DefaultMinGasMultiplier, BurnBaseFee: true, BurnRatio: sdk.NewDec(-1)},
+			true,
+		},
+		{
+			"invalid: unknown base fee algorithm",
+			Params{BaseFeeChangeDenominator: 8, ElasticityMultiplier: 2, BaseFee: sdkmath.ZeroInt(), MinGasPrice: DefaultMinGasPrice, MinGasMultiplier: DefaultMinGasMultiplier, BaseFeeAlgorithm: 2},
+			true,
+		},
+		{
+			"invalid: time weighted algorithm without window",
+			Params{BaseFeeChangeDenominator: 8, ElasticityMultiplier: 2, BaseFee: sdkmath.ZeroInt(), MinGasPrice: DefaultMinGasPrice, MinGasMultiplier: DefaultMinGasMultiplier, BaseFeeAlgorithm: BaseFeeAlgorithmTimeWeighted, TargetBlockTime: DefaultTargetBlockTime},
+			true,
+		},
+		{
+			"invalid: time weighted algorithm without target block time",
+			Params{BaseFeeChangeDenominator: 8, ElasticityMultiplier: 2, BaseFee: sdkmath.ZeroInt(), MinGasPrice: DefaultMinGasPrice, MinGasMultiplier: DefaultMinGasMultiplier, BaseFeeAlgorithm: BaseFeeAlgorithmTimeWeighted, BaseFeeWindow: DefaultBaseFeeWindow},
+			true,
+		},
+		{
+			"valid: time weighted algorithm",
+			Params{BaseFeeChangeDenominator: 8, ElasticityMultiplier: 2, BaseFee: sdkmath.ZeroInt(), MinGasPrice: DefaultMinGasPrice, MinGasMultiplier: DefaultMinGasMultiplier, BaseFeeAlgorithm: BaseFeeAlgorithmTimeWeighted, BaseFeeWindow: DefaultBaseFeeWindow, TargetBlockTime: DefaultTargetBlockTime},
+			false,
+		},
+		{
+			"invalid: burn ratio bigger than 1",
+			Params{BaseFeeChangeDenominator: 8, ElasticityMultiplier: 2, BaseFee: sdkmath.ZeroInt(), MinGasPrice: DefaultMinGasPrice, MinGasMultiplier: DefaultMinGasMultiplier, BurnBaseFee: true, BurnRatio: sdk.NewDec(2)},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -274,6 +275,175 @@ func (m *QueryBlockGasResponse) GetGas() int64 {
 	return 0
 }
 
+// QueryBurnedFeesRequest defines the request type for querying the burned fees.
+type QueryBurnedFeesRequest struct {
+}
+
+func (m *QueryBurnedFeesRequest) Reset()         { *m = QueryBurnedFeesRequest{} }
+func (m *QueryBurnedFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnedFeesRequest) ProtoMessage()    {}
+func (*QueryBurnedFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{6}
+}
+func (m *QueryBurnedFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnedFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnedFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurnedFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnedFeesRequest.Merge(m, src)
+}
+func (m *QueryBurnedFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnedFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnedFeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnedFeesRequest proto.InternalMessageInfo
+
+// QueryBurnedFeesResponse returns the cumulative amount of base fees burned.
+type QueryBurnedFeesResponse struct {
+	// burned is the total amount of base fees burned
+	Burned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=burned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned"`
+}
+
+func (m *QueryBurnedFeesResponse) Reset()         { *m = QueryBurnedFeesResponse{} }
+func (m *QueryBurnedFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnedFeesResponse) ProtoMessage()    {}
+func (*QueryBurnedFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{7}
+}
+func (m *QueryBurnedFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnedFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnedFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurnedFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnedFeesResponse.Merge(m, src)
+}
+func (m *QueryBurnedFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnedFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnedFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnedFeesResponse proto.InternalMessageInfo
+
+func (m *QueryBurnedFeesResponse) GetBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Burned
+	}
+	return nil
+}
+
+// QueryBaseFeeHistoryRequest defines the request type for querying the base fee history.
+type QueryBaseFeeHistoryRequest struct {
+}
+
+func (m *QueryBaseFeeHistoryRequest) Reset()         { *m = QueryBaseFeeHistoryRequest{} }
+func (m *QueryBaseFeeHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeHistoryRequest) ProtoMessage()    {}
+func (*QueryBaseFeeHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{8}
+}
+func (m *QueryBaseFeeHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseFeeHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseFeeHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseFeeHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseFeeHistoryRequest.Merge(m, src)
+}
+func (m *QueryBaseFeeHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseFeeHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseFeeHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseFeeHistoryRequest proto.InternalMessageInfo
+
+// QueryBaseFeeHistoryResponse returns the base fee history.
+type QueryBaseFeeHistoryResponse struct {
+	// records are the base fee records of the last blocks, ordered by height
+	Records []BaseFeeRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	// next_base_fee is the estimated base fee of the next block assuming it's produced after the
+	// target block time, it's only set with the time weighted algorithm.
+	NextBaseFee *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=next_base_fee,json=nextBaseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"next_base_fee,omitempty"`
+}
+
+func (m *QueryBaseFeeHistoryResponse) Reset()         { *m = QueryBaseFeeHistoryResponse{} }
+func (m *QueryBaseFeeHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeHistoryResponse) ProtoMessage()    {}
+func (*QueryBaseFeeHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{9}
+}
+func (m *QueryBaseFeeHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseFeeHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseFeeHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseFeeHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseFeeHistoryResponse.Merge(m, src)
+}
+func (m *QueryBaseFeeHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseFeeHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseFeeHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseFeeHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryBaseFeeHistoryResponse) GetRecords() []BaseFeeRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "ethermint.feemarket.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "ethermint.feemarket.v1.QueryParamsResponse")
@@ -281,6 +451,10 @@ func init() {
 	proto.RegisterType((*QueryBaseFeeResponse)(nil), "ethermint.feemarket.v1.QueryBaseFeeResponse")
 	proto.RegisterType((*QueryBlockGasRequest)(nil), "ethermint.feemarket.v1.QueryBlockGasRequest")
 	proto.RegisterType((*QueryBlockGasResponse)(nil), "ethermint.feemarket.v1.QueryBlockGasResponse")
+	proto.RegisterType((*QueryBurnedFeesRequest)(nil), "ethermint.feemarket.v1.QueryBurnedFeesRequest")
+	proto.RegisterType((*QueryBurnedFeesResponse)(nil), "ethermint.feemarket.v1.QueryBurnedFeesResponse")
+	proto.RegisterType((*QueryBaseFeeHistoryRequest)(nil), "ethermint.feemarket.v1.QueryBaseFeeHistoryRequest")
+	proto.RegisterType((*QueryBaseFeeHistoryResponse)(nil), "ethermint.feemarket.v1.QueryBaseFeeHistoryResponse")
 }
 
 func init() {
@@ -288,35 +462,48 @@ func init() {
 }
 
 var fileDescriptor_71a07c1ffd85fde2 = []byte{
-	// 443 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0xf5, 0x12, 0x48, 0xcb, 0x72, 0x41, 0x4b, 0x5a, 0x21, 0x0b, 0x6d, 0x83, 0x91, 0xa2, 0xb6,
-	0xb4, 0x5e, 0xb5, 0x5c, 0x39, 0x59, 0xa2, 0x88, 0x1b, 0x98, 0x1b, 0x12, 0xaa, 0xd6, 0x61, 0xea,
-	0x5a, 0xa9, 0xbd, 0xae, 0x77, 0x13, 0xd1, 0x2b, 0x37, 0x2e, 0x08, 0xc1, 0xd7, 0xf0, 0x07, 0x39,
-	0x46, 0xe2, 0x82, 0x38, 0x44, 0x28, 0xe1, 0x43, 0x90, 0x77, 0xd7, 0x49, 0x0c, 0x18, 0x72, 0xca,
-	0x6a, 0xf2, 0xe6, 0xbd, 0x37, 0x6f, 0xc6, 0xd8, 0x03, 0x75, 0x0e, 0x45, 0x9a, 0x64, 0x8a, 0x9d,
-	0x01, 0xa4, 0xbc, 0x18, 0x80, 0x62, 0xa3, 0x23, 0x76, 0x39, 0x84, 0xe2, 0xca, 0xcf, 0x0b, 0xa1,
-	0x04, 0xd9, 0x5e, 0x60, 0xfc, 0x05, 0xc6, 0x1f, 0x1d, 0xb9, 0x9d, 0x58, 0xc4, 0x42, 0x43, 0x58,
-	0xf9, 0x32, 0x68, 0xb7, 0xd7, 0xc0, 0xb8, 0x6c, 0x35, 0xb8, 0x7b, 0xb1, 0x10, 0xf1, 0x05, 0x30,
-	0x9e, 0x27, 0x8c, 0x67, 0x99, 0x50, 0x5c, 0x25, 0x22, 0x93, 0xe6, 0x5f, 0xaf, 0x83, 0xc9, 0x8b,
-	0xd2, 0xc2, 0x73, 0x5e, 0xf0, 0x54, 0x86, 0x70, 0x39, 0x04, 0xa9, 0xbc, 0x97, 0xf8, 0x4e, 0xad,
-	0x2a, 0x73, 0x91, 0x49, 0x20, 0x8f, 0x71, 0x3b, 0xd7, 0x95, 0xbb, 0xa8, 0x8b, 0x76, 0x6f, 0x1d,
-	0x53, 0xff, 0xef, 0x8e, 0x7d, 0xd3, 0x17, 0x5c, 0x1f, 0x4f, 0x77, 0x9c, 0xd0, 0xf6, 0x78, 0x5b,
-	0x96, 0x34, 0xe0, 0x12, 0x4e, 0x00, 0x2a, 0xad, 0xd7, 0xb8, 0x53, 0x2f, 0x5b, 0xb1, 0x27, 0x78,
-	0x33, 0xe2, 0x12, 0x4e, 0xcf, 0x00, 0xb4, 0xdc, 0xcd, 0x60, 0xff, 0xfb, 0x74, 0xa7, 0x17, 0x27,
-	0xea, 0x7c, 0x18, 0xf9, 0x7d, 0x91, 0xb2, 0xbe, 0x90, 0xa9, 0x90, 0xf6, 0xe7, 0x50, 0xbe, 0x19,
-	0x30, 0x75, 0x95, 0x83, 0xf4, 0x9f, 0x65, 0x2a, 0xdc, 0x88, 0x0c, 0x9d, 0xb7, 0x5d, 0xd1, 0x5f,
-	0x88, 0xfe, 0xe0, 0x29, 0x5f, 0x8c, 0xb8, 0x87, 0xb7, 0x7e, 0xab, 0x5b, 0xdd, 0xdb, 0xb8, 0x15,
-	0x73, 0x33, 0x61, 0x2b, 0x2c, 0x9f, 0xc7, 0x5f, 0x5a, 0xf8, 0x86, 0xc6, 0x92, 0xf7, 0x08, 0xb7,
-	0xcd, 0x6c, 0x64, 0xbf, 0x69, 0xf6, 0x3f, 0xe3, 0x74, 0x1f, 0xae, 0x85, 0x35, 0xfa, 0x5e, 0xef,
-	0xdd, 0xd7, 0x9f, 0x9f, 0xaf, 0x75, 0x09, 0x65, 0x0d, 0x0b, 0x36, 0x71, 0x92, 0x0f, 0x08, 0x6f,
-	0xd8, 0xcc, 0xc8, 0xbf, 0x05, 0xea, 0x81, 0xbb, 0x07, 0xeb, 0x81, 0xad, 0x9d, 0x5d, 0x6d, 0xc7,
-	0x23, 0xdd, 0x26, 0x3b, 0xd5, 0x92, 0xc8, 0x27, 0x84, 0x37, 0xab, 0x34, 0xc9, 0x7f, 0x44, 0xea,
-	0xcb, 0x70, 0x0f, 0xd7, 0x44, 0x5b, 0x4f, 0x7b, 0xda, 0xd3, 0x03, 0x72, 0xbf, 0xd1, 0x53, 0xd9,
-	0x71, 0x1a, 0x73, 0x19, 0x9c, 0x8c, 0x67, 0x14, 0x4d, 0x66, 0x14, 0xfd, 0x98, 0x51, 0xf4, 0x71,
-	0x4e, 0x9d, 0xc9, 0x9c, 0x3a, 0xdf, 0xe6, 0xd4, 0x79, 0x75, 0xb0, 0x72, 0x49, 0x30, 0x2a, 0x0f,
-	0x69, 0x49, 0xf6, 0x76, 0x85, 0x4e, 0xdf, 0x54, 0xd4, 0xd6, 0x9f, 0xcb, 0xa3, 0x5f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x61, 0x3c, 0x39, 0xd8, 0xc8, 0x03, 0x00, 0x00,
+	// 642 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x4f, 0x13, 0x41,
+	0x14, 0xc7, 0x3b, 0xa0, 0x05, 0x87, 0x68, 0xcc, 0x08, 0x88, 0x2b, 0x59, 0xea, 0x12, 0x48, 0xf9,
+	0x35, 0x43, 0xcb, 0xd5, 0x53, 0x8d, 0xa8, 0x17, 0xa3, 0xf5, 0x66, 0x62, 0x9a, 0x69, 0x79, 0x2c,
+	0x1b, 0xe8, 0x4e, 0xd9, 0x99, 0x36, 0x70, 0xf1, 0x60, 0xbc, 0x78, 0x31, 0x46, 0x6f, 0xfe, 0x09,
+	0x7a, 0xf5, 0x8f, 0xe0, 0x48, 0xe2, 0xc5, 0x78, 0x40, 0x03, 0xfe, 0x21, 0x66, 0x7e, 0x74, 0xa1,
+	0xc0, 0x42, 0x39, 0xed, 0xe4, 0xcd, 0x7b, 0xdf, 0xf7, 0x99, 0xf7, 0x63, 0x71, 0x00, 0x6a, 0x03,
+	0x92, 0x66, 0x14, 0x2b, 0xb6, 0x0e, 0xd0, 0xe4, 0xc9, 0x26, 0x28, 0xd6, 0x29, 0xb1, 0xed, 0x36,
+	0x24, 0xbb, 0xb4, 0x95, 0x08, 0x25, 0xc8, 0x78, 0xea, 0x43, 0x53, 0x1f, 0xda, 0x29, 0x79, 0x7e,
+	0x43, 0xc8, 0xa6, 0x90, 0xac, 0xce, 0x25, 0xb0, 0x4e, 0xa9, 0x0e, 0x8a, 0x97, 0x58, 0x43, 0x44,
+	0xb1, 0x8d, 0xf3, 0x46, 0x43, 0x11, 0x0a, 0x73, 0x64, 0xfa, 0xe4, 0xac, 0xb3, 0x19, 0x19, 0x8f,
+	0xa5, 0xad, 0xdf, 0x64, 0x28, 0x44, 0xb8, 0x05, 0x8c, 0xb7, 0x22, 0xc6, 0xe3, 0x58, 0x28, 0xae,
+	0x22, 0x11, 0x4b, 0x7b, 0x1b, 0x8c, 0x62, 0xf2, 0x52, 0x23, 0xbe, 0xe0, 0x09, 0x6f, 0xca, 0x2a,
+	0x6c, 0xb7, 0x41, 0xaa, 0xe0, 0x15, 0xbe, 0xd3, 0x63, 0x95, 0x2d, 0x11, 0x4b, 0x20, 0x0f, 0x71,
+	0xbe, 0x65, 0x2c, 0x13, 0xa8, 0x80, 0x8a, 0x23, 0x65, 0x9f, 0x9e, 0xff, 0x22, 0x6a, 0xe3, 0x2a,
+	0xd7, 0xf6, 0x0e, 0xa6, 0x72, 0x55, 0x17, 0x13, 0x8c, 0x39, 0xd1, 0x0a, 0x97, 0xb0, 0x0a, 0xd0,
+	0xcd, 0xf5, 0x06, 0x8f, 0xf6, 0x9a, 0x5d, 0xb2, 0xc7, 0x78, 0x58, 0x17, 0xa4, 0xb6, 0x0e, 0x60,
+	0xd2, 0xdd, 0xa8, 0xcc, 0xff, 0x3e, 0x98, 0x9a, 0x0d, 0x23, 0xb5, 0xd1, 0xae, 0xd3, 0x86, 0x68,
+	0x32, 0x57, 0x36, 0xfb, 0x59, 0x92, 0x6b, 0x9b, 0x4c, 0xed, 0xb6, 0x40, 0xd2, 0x67, 0xb1, 0xaa,
+	0x0e, 0xd5, 0xad, 0x5c, 0x30, 0xde, 0x95, 0xdf, 0x12, 0x8d, 0xcd, 0x27, 0x3c, 0x7d, 0xe2, 0x1c,
+	0x1e, 0x3b, 0x65, 0x77, 0x79, 0x6f, 0xe3, 0xc1, 0x90, 0xdb, 0x17, 0x0e, 0x56, 0xf5, 0x31, 0x98,
+	0xc0, 0xe3, 0xd6, 0xb5, 0x9d, 0xc4, 0xb0, 0xb6, 0x0a, 0x90, 0x8a, 0xbc, 0xc5, 0x77, 0xcf, 0xdc,
+	0x38, 0x99, 0x06, 0xce, 0xd7, 0x8d, 0x75, 0x02, 0x15, 0x06, 0x8b, 0x23, 0xe5, 0x7b, 0xd4, 0x72,
+	0x52, 0x0d, 0x46, 0x5d, 0x97, 0xe9, 0x23, 0x11, 0xc5, 0x95, 0x65, 0x5d, 0xa6, 0x6f, 0x7f, 0xa6,
+	0x8a, 0x7d, 0xbc, 0x4d, 0x07, 0xc8, 0xaa, 0x93, 0x0e, 0x26, 0xb1, 0x77, 0xb2, 0x76, 0x4f, 0x23,
+	0xa9, 0x44, 0xb2, 0xdb, 0xa5, 0xfb, 0x81, 0xf0, 0xfd, 0x73, 0xaf, 0xd3, 0x0a, 0x0f, 0x25, 0xd0,
+	0x10, 0xc9, 0x9a, 0x74, 0x8c, 0x33, 0x59, 0xfd, 0x4c, 0x7b, 0xa3, 0xbd, 0x5d, 0x5b, 0xbb, 0xb1,
+	0xe4, 0x39, 0xbe, 0x19, 0xc3, 0x8e, 0xaa, 0xa5, 0xdd, 0x1a, 0xb8, 0x72, 0xb7, 0x46, 0xb4, 0x80,
+	0x4b, 0x52, 0x7e, 0x9f, 0xc7, 0xd7, 0x0d, 0x36, 0xf9, 0x80, 0x70, 0xde, 0x8e, 0x12, 0x99, 0xcf,
+	0x42, 0x3b, 0x3b, 0xbd, 0xde, 0x42, 0x5f, 0xbe, 0xb6, 0x08, 0xc1, 0xec, 0xbb, 0x9f, 0xff, 0xbe,
+	0x0c, 0x14, 0x88, 0xcf, 0x32, 0xf6, 0xc9, 0x4e, 0x2f, 0xf9, 0x88, 0xf0, 0x90, 0x23, 0x24, 0x17,
+	0x27, 0xe8, 0x9d, 0x6f, 0x6f, 0xb1, 0x3f, 0x67, 0x87, 0x53, 0x34, 0x38, 0x01, 0x29, 0x64, 0xe1,
+	0x74, 0xab, 0x4c, 0x3e, 0x23, 0x3c, 0xdc, 0x1d, 0x5e, 0x72, 0x49, 0x92, 0xde, 0xd9, 0xf7, 0x96,
+	0xfa, 0xf4, 0x76, 0x4c, 0x73, 0x86, 0x69, 0x9a, 0x3c, 0xc8, 0x64, 0xd2, 0x11, 0xb5, 0x90, 0x4b,
+	0xf2, 0x15, 0x61, 0x7c, 0xbc, 0x0c, 0x84, 0x5e, 0x9c, 0xe8, 0xf4, 0x3e, 0x79, 0xac, 0x6f, 0x7f,
+	0x87, 0xb6, 0x60, 0xd0, 0x66, 0xc8, 0x74, 0x26, 0x9a, 0x89, 0xd1, 0x05, 0x93, 0xe4, 0x3b, 0xc2,
+	0xb7, 0x7a, 0x57, 0x81, 0x94, 0xfb, 0x69, 0x4e, 0xef, 0x5a, 0x79, 0x2b, 0x57, 0x8a, 0x71, 0xa0,
+	0xcb, 0x06, 0x74, 0x9e, 0x14, 0x2f, 0xeb, 0x6b, 0x6d, 0xc3, 0x46, 0x56, 0x56, 0xf7, 0x0e, 0x7d,
+	0xb4, 0x7f, 0xe8, 0xa3, 0xbf, 0x87, 0x3e, 0xfa, 0x74, 0xe4, 0xe7, 0xf6, 0x8f, 0xfc, 0xdc, 0xaf,
+	0x23, 0x3f, 0xf7, 0x7a, 0xf1, 0xc4, 0x56, 0x41, 0x47, 0x2f, 0xd5, 0xb1, 0xe6, 0xce, 0x09, 0x55,
+	0xb3, 0x5f, 0xf5, 0xbc, 0xf9, 0xd1, 0xaf, 0xfc, 0x1f, 0x00, 0x5a, 0x82, 0x09, 0x26, 0xa2, 0x06,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -337,6 +524,10 @@ type QueryClient interface {
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(ctx context.Context, in *QueryBlockGasRequest, opts ...grpc.CallOption) (*QueryBlockGasResponse, error)
+	// BurnedFees queries the cumulative amount of base fees burned.
+	BurnedFees(ctx context.Context, in *QueryBurnedFeesRequest, opts ...grpc.CallOption) (*QueryBurnedFeesResponse, error)
+	// BaseFeeHistory queries the base fee history of the last blocks in the base fee window.
+	BaseFeeHistory(ctx context.Context, in *QueryBaseFeeHistoryRequest, opts ...grpc.CallOption) (*QueryBaseFeeHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -374,6 +565,24 @@ func (c *queryClient) BlockGas(ctx context.Context, in *QueryBlockGasRequest, op
 	return out, nil
 }
 
+func (c *queryClient) BurnedFees(ctx context.Context, in *QueryBurnedFeesRequest, opts ...grpc.CallOption) (*QueryBurnedFeesResponse, error) {
+	out := new(QueryBurnedFeesResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.feemarket.v1.Query/BurnedFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BaseFeeHistory(ctx context.Context, in *QueryBaseFeeHistoryRequest, opts ...grpc.CallOption) (*QueryBaseFeeHistoryResponse, error) {
+	out := new(QueryBaseFeeHistoryResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.feemarket.v1.Query/BaseFeeHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of x/feemarket module.
@@ -382,6 +591,10 @@ type QueryServer interface {
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(context.Context, *QueryBlockGasRequest) (*QueryBlockGasResponse, error)
+	// BurnedFees queries the cumulative amount of base fees burned.
+	BurnedFees(context.Context, *QueryBurnedFeesRequest) (*QueryBurnedFeesResponse, error)
+	// BaseFeeHistory queries the base fee history of the last blocks in the base fee window.
+	BaseFeeHistory(context.Context, *QueryBaseFeeHistoryRequest) (*QueryBaseFeeHistoryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -397,6 +610,12 @@ func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeR
 func (*UnimplementedQueryServer) BlockGas(ctx context.Context, req *QueryBlockGasRequest) (*QueryBlockGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BlockGas not implemented")
 }
+func (*UnimplementedQueryServer) BurnedFees(ctx context.Context, req *QueryBurnedFeesRequest) (*QueryBurnedFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnedFees not implemented")
+}
+func (*UnimplementedQueryServer) BaseFeeHistory(ctx context.Context, req *QueryBaseFeeHistoryRequest) (*QueryBaseFeeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BaseFeeHistory not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -456,6 +675,42 @@ func _Query_BlockGas_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BurnedFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurnedFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BurnedFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.feemarket.v1.Query/BurnedFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BurnedFees(ctx, req.(*QueryBurnedFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BaseFeeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBaseFeeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BaseFeeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.feemarket.v1.Query/BaseFeeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BaseFeeHistory(ctx, req.(*QueryBaseFeeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethermint.feemarket.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -472,6 +727,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BlockGas",
 			Handler:    _Query_BlockGas_Handler,
 		},
+		{
+			MethodName: "BurnedFees",
+			Handler:    _Query_BurnedFees_Handler,
+		},
+		{
+			MethodName: "BaseFeeHistory",
+			Handler:    _Query_BaseFeeHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/feemarket/v1/query.proto",
@@ -642,6 +905,138 @@ func (m *QueryBlockGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryBurnedFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnedFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnedFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnedFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnedFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnedFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Burned) > 0 {
+		for iNdEx := len(m.Burned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseFeeHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseFeeHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextBaseFee != nil {
+		{
+			size := m.NextBaseFee.Size()
+			i -= size
+			if _, err := m.NextBaseFee.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -716,15 +1111,67 @@ func (m *QueryBlockGasResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryBurnedFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryBurnedFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Burned) > 0 {
+		for _, e := range m.Burned {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
+
+func (m *QueryBaseFeeHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBaseFeeHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.NextBaseFee != nil {
+		l = m.NextBaseFee.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
@@ -1110,6 +1557,310 @@ func (m *QueryBlockGasResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryBurnedFeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnedFeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnedFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurnedFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnedFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnedFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burned = append(m.Burned, types.Coin{})
+			if err := m.Burned[len(m.Burned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseFeeHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseFeeHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, BaseFeeRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.NextBaseFee = &v
+			if err := m.NextBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,212 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package ibchooks
+
+import (
+	"math/big"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	erc20types "github.com/evmos/ethermint/x/erc20/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	"github.com/evmos/ethermint/x/ibchooks/types"
+)
+
+// executeCall calls the contract of the memo from the intermediate sender which received the
+// tokens. The coins of the EVM denom are sent as the value of the call, while the coins of a
+// registered token pair are converted to ERC20 tokens which the contract is allowed to spend. The
+// other coins are rejected, so that the transfer is reverted.
+func (im IBCMiddleware) executeCall(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data transfertypes.FungibleTokenPacketData,
+	sender common.Address,
+	call types.EVMCall,
+) ([]byte, error) {
+	amount, ok := sdkmath.NewIntFromString(data.Amount)
+	if !ok {
+		return nil, errorsmod.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount: %s", data.Amount)
+	}
+
+	coin := sdk.Coin{Denom: receivedDenom(packet, data), Amount: amount}
+	contract := call.ContractAddress()
+	value := big.NewInt(0)
+
+	if coin.Denom == im.evmKeeper.GetParams(ctx).EvmDenom {
+		value = coin.Amount.BigInt()
+	} else {
+		pair, found := im.erc20Keeper.GetTokenPair(ctx, im.erc20Keeper.GetDenomPairID(ctx, coin.Denom))
+		if !found {
+			return nil, errorsmod.Wrapf(types.ErrUnsupportedDenom, "%s is neither the evm denom nor a registered token pair", coin.Denom)
+		}
+
+		msg := erc20types.NewMsgConvertCoin(coin, sender, sdk.AccAddress(sender.Bytes()))
+		if _, err := im.erc20Keeper.ConvertCoin(sdk.WrapSDKContext(ctx), msg); err != nil {
+			return nil, err
+		}
+
+		if _, err := im.erc20Keeper.CallEVM(
+			ctx, erc20types.ERC20WrapperContract.ABI, sender, pair.GetERC20Contract(), true,
+			"approve", contract, coin.Amount.BigInt(),
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	return im.call(ctx, sender, contract, call.Data, value, call.GetGasLimit())
+}
+
+// callback calls the callback contract of a sent packet in a cached context. A failed callback is
+// only logged, so that a faulty contract can't block the acknowledgement or the refund of the
+// tokens.
+func (im IBCMiddleware) callback(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	contract, sender common.Address,
+	method string,
+	args ...interface{},
+) {
+	data, err := types.CallbackABI.Pack(method, args...)
+	if err == nil {
+		cacheCtx, writeCache := ctx.CacheContext()
+		if _, err = im.call(cacheCtx, types.ModuleAddress, contract, data, big.NewInt(0), types.CallbackGasLimit); err == nil {
+			writeCache()
+		}
+	}
+
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyChannel, packet.GetSourceChannel()),
+		sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.GetSequence(), 10)),
+		sdk.NewAttribute(types.AttributeKeySender, sender.Hex()),
+		sdk.NewAttribute(types.AttributeKeyContract, contract.Hex()),
+		sdk.NewAttribute(types.AttributeKeyMethod, method),
+		sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(err == nil)),
+	}
+
+	if err != nil {
+		ctx.Logger().With("module", "x/"+types.ModuleName).Error(
+			"ibc callback failed",
+			"contract", contract.Hex(), "method", method, "error", err.Error(),
+		)
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyError, err.Error()))
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeCallback, attrs...))
+}
+
+// call executes the EVM call, consuming the gas used on the gas meter of the context.
+func (im IBCMiddleware) call(
+	ctx sdk.Context,
+	from, contract common.Address,
+	data []byte,
+	value *big.Int,
+	gasLimit uint64,
+) ([]byte, error) {
+	msg := core.Message{
+		From:              from,
+		To:                &contract,
+		Nonce:             im.evmKeeper.GetNonce(ctx, from),
+		Value:             value,
+		GasLimit:          gasLimit,
+		GasPrice:          big.NewInt(0),
+		GasFeeCap:         big.NewInt(0),
+		GasTipCap:         big.NewInt(0),
+		Data:              data,
+		SkipAccountChecks: true,
+	}
+
+	res, err := im.evmKeeper.ApplyMessage(ctx, msg, nil, true)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to apply message")
+	}
+
+	ctx.GasMeter().ConsumeGas(res.GasUsed, "ibc evm call")
+
+	if res.Failed() {
+		vmErr := res.VmError
+		if vmErr == vm.ErrExecutionReverted.Error() {
+			vmErr = evmtypes.NewExecErrorWithReason(res.Ret).Error()
+		}
+		return nil, errorsmod.Wrap(types.ErrEVMCall, vmErr)
+	}
+
+	return res.Ret, nil
+}
+
+// callbackTarget returns the callback contract of the memo of a sent packet and the address of
+// the sender of the packet.
+func callbackTarget(packet channeltypes.Packet) (contract, sender common.Address, found bool) {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return common.Address{}, common.Address{}, false
+	}
+
+	memo, err := types.ParseMemo(data.Memo)
+	if err != nil || memo == nil {
+		return common.Address{}, common.Address{}, false
+	}
+
+	contract, found = memo.CallbackAddress()
+	if !found {
+		return common.Address{}, common.Address{}, false
+	}
+
+	sender, err = parseAddress(data.Sender)
+	if err != nil {
+		return common.Address{}, common.Address{}, false
+	}
+
+	return contract, sender, true
+}
+
+// receivedDenom returns the denom of the coins received from the packet, following the denom
+// trace logic of the transfer keeper.
+func receivedDenom(packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData) string {
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		voucherPrefix := transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		unprefixedDenom := data.Denom[len(voucherPrefix):]
+
+		denomTrace := transfertypes.ParseDenomTrace(unprefixedDenom)
+		if denomTrace.Path != "" {
+			return denomTrace.IBCDenom()
+		}
+		return unprefixedDenom
+	}
+
+	prefixedDenom := transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), data.Denom)
+	return transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
+}
+
+// parseAddress parses either a hex or a bech32 address.
+func parseAddress(address string) (common.Address, error) {
+	if common.IsHexAddress(address) {
+		return common.HexToAddress(address), nil
+	}
+
+	accAddr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return common.BytesToAddress(accAddr), nil
+}
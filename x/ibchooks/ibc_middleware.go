@@ -0,0 +1,255 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package ibchooks
+
+import (
+	"encoding/json"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+
+	"github.com/evmos/ethermint/x/ibchooks/types"
+)
+
+var _ porttypes.Middleware = IBCMiddleware{}
+
+// IBCMiddleware wraps the ICS-20 transfer application. It executes the EVM calls of the memos of
+// the received packets and calls back the contracts of the memos of the sent packets once they
+// are acknowledged or time out.
+type IBCMiddleware struct {
+	app         porttypes.IBCModule
+	ics4Wrapper porttypes.ICS4Wrapper
+	evmKeeper   types.EVMKeeper
+	erc20Keeper types.ERC20Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware wrapping the transfer application.
+func NewIBCMiddleware(
+	app porttypes.IBCModule,
+	ics4Wrapper porttypes.ICS4Wrapper,
+	evmKeeper types.EVMKeeper,
+	erc20Keeper types.ERC20Keeper,
+) IBCMiddleware {
+	return IBCMiddleware{
+		app:         app,
+		ics4Wrapper: ics4Wrapper,
+		evmKeeper:   evmKeeper,
+		erc20Keeper: erc20Keeper,
+	}
+}
+
+// OnChanOpenInit implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	channelCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, channelCap, counterparty, version)
+}
+
+// OnChanOpenTry implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	channelCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	return im.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, channelCap, counterparty, counterpartyVersion)
+}
+
+// OnChanOpenAck implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+}
+
+// OnChanOpenConfirm implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+// OnChanCloseInit implements the IBCModule interface
+func (im IBCMiddleware) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseInit(ctx, portID, channelID)
+}
+
+// OnChanCloseConfirm implements the IBCModule interface
+func (im IBCMiddleware) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+// OnRecvPacket implements the IBCModule interface. The tokens of a packet whose memo contains an
+// EVM call are received by an intermediate sender derived from the channel and the original
+// sender, which then calls the contract with them. The receiver of the packet must be the called
+// contract. A failed call returns an error acknowledgement, reverting the transfer.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) exported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	memo, err := types.ParseMemo(data.Memo)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	if memo == nil || memo.EVM == nil {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	contract := memo.EVM.ContractAddress()
+	if receiver, err := parseAddress(data.Receiver); err != nil || receiver != contract {
+		return channeltypes.NewErrorAcknowledgement(
+			errorsmod.Wrapf(types.ErrInvalidReceiver, "receiver %s, contract %s", data.Receiver, memo.EVM.Contract),
+		)
+	}
+
+	sender := types.IntermediateSender(packet.GetDestChannel(), data.Sender)
+	data.Receiver = sdk.AccAddress(sender.Bytes()).String()
+	packet.Data = data.GetBytes()
+
+	ack := im.app.OnRecvPacket(ctx, packet, relayer)
+	if !ack.Success() {
+		return ack
+	}
+
+	ret, err := im.executeCall(ctx, packet, data, sender, *memo.EVM)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEVMCall,
+			sdk.NewAttribute(types.AttributeKeyChannel, packet.GetDestChannel()),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.GetSequence(), 10)),
+			sdk.NewAttribute(types.AttributeKeySender, sender.Hex()),
+			sdk.NewAttribute(types.AttributeKeyContract, contract.Hex()),
+		),
+	)
+
+	bz, err := json.Marshal(types.ContractAck{
+		ContractResult: ret,
+		IBCAck:         ack.Acknowledgement(),
+	})
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	return channeltypes.NewResultAcknowledgement(bz)
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface. The callback contract of the packet
+// memo, if any, is called with the acknowledgement once the transfer application processed it.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	contract, sender, found := callbackTarget(packet)
+	if !found {
+		return nil
+	}
+
+	var ack channeltypes.Acknowledgement
+	success := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack) == nil && ack.Success()
+
+	im.callback(
+		ctx, packet, contract, sender, types.MethodOnAcknowledgement,
+		packet.GetSourceChannel(), packet.GetSequence(), sender, acknowledgement, success,
+	)
+	return nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface. The callback contract of the packet memo,
+// if any, is called once the transfer application refunded the tokens.
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.app.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	contract, sender, found := callbackTarget(packet)
+	if !found {
+		return nil
+	}
+
+	im.callback(
+		ctx, packet, contract, sender, types.MethodOnTimeout,
+		packet.GetSourceChannel(), packet.GetSequence(), sender,
+	)
+	return nil
+}
+
+// SendPacket implements the ICS4Wrapper interface
+func (im IBCMiddleware) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (uint64, error) {
+	return im.ics4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+}
+
+// WriteAcknowledgement implements the ICS4Wrapper interface
+func (im IBCMiddleware) WriteAcknowledgement(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet exported.PacketI,
+	ack exported.Acknowledgement,
+) error {
+	return im.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// GetAppVersion implements the ICS4Wrapper interface
+func (im IBCMiddleware) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return im.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
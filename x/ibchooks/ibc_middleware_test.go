@@ -0,0 +1,430 @@
+package ibchooks_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/asm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/evmos/ethermint/app"
+	"github.com/evmos/ethermint/tests"
+	erc20types "github.com/evmos/ethermint/x/erc20/types"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	feemarkettypes "github.com/evmos/ethermint/x/feemarket/types"
+	"github.com/evmos/ethermint/x/ibchooks/types"
+)
+
+// recorderAsm is a contract counting its calls in slot 0 and recording the caller, the value and
+// the hash of the calldata of the last call in slots 1, 2 and 3. It reverts if the calldata
+// starts with 0xff.
+const recorderAsm = `
+PUSH 0
+SLOAD
+PUSH 1
+ADD
+PUSH 0
+SSTORE
+CALLER
+PUSH 1
+SSTORE
+CALLVALUE
+PUSH 2
+SSTORE
+PUSH 0
+CALLDATALOAD
+PUSH 248
+SHR
+PUSH 0xff
+EQ
+JUMPI @fail
+CALLDATASIZE
+PUSH 0
+PUSH 0
+CALLDATACOPY
+CALLDATASIZE
+PUSH 0
+KECCAK256
+PUSH 3
+SSTORE
+STOP
+fail:
+PUSH 0
+DUP1
+REVERT
+`
+
+// reverterAsm is a contract reverting every call.
+const reverterAsm = `
+PUSH 0
+DUP1
+REVERT
+`
+
+func setupTestingApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	ethermintApp := app.NewEthermintApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.AppOptionsMap{
+		flags.FlagHome:            app.DefaultNodeHome,
+		server.FlagInvCheckPeriod: 5,
+	})
+	cdc := ethermintApp.AppCodec()
+	genesis := app.NewDefaultGenesisState()
+
+	// the test chains use the staking denom for the EVM and don't charge fees
+	var evmGenesis evmtypes.GenesisState
+	cdc.MustUnmarshalJSON(genesis[evmtypes.ModuleName], &evmGenesis)
+	evmGenesis.Params.EvmDenom = sdk.DefaultBondDenom
+	genesis[evmtypes.ModuleName] = cdc.MustMarshalJSON(&evmGenesis)
+
+	var feemarketGenesis feemarkettypes.GenesisState
+	cdc.MustUnmarshalJSON(genesis[feemarkettypes.ModuleName], &feemarketGenesis)
+	feemarketGenesis.Params.NoBaseFee = true
+	genesis[feemarkettypes.ModuleName] = cdc.MustMarshalJSON(&feemarketGenesis)
+
+	return ethermintApp, genesis
+}
+
+type MiddlewareTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// chainB executes the EVM calls of the packets sent by chainA
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+	path   *ibctesting.Path
+}
+
+func TestMiddlewareTestSuite(t *testing.T) {
+	suite.Run(t, new(MiddlewareTestSuite))
+}
+
+func (suite *MiddlewareTestSuite) SetupTest() {
+	ibctesting.DefaultTestingAppInit = setupTestingApp
+
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 0)
+	suite.chainA = ibctesting.NewTestChain(suite.T(), suite.coordinator, "ethermint_9000-1")
+	suite.chainB = ibctesting.NewTestChain(suite.T(), suite.coordinator, "ethermint_9001-1")
+	for _, chain := range []*ibctesting.TestChain{suite.chainA, suite.chainB} {
+		// the EVM requires a block proposer to derive the coinbase
+		chain.CurrentHeader.ProposerAddress = chain.Vals.Proposer.Address
+		suite.coordinator.Chains[chain.ChainID] = chain
+	}
+
+	suite.path = ibctesting.NewPath(suite.chainA, suite.chainB)
+	for _, endpoint := range []*ibctesting.Endpoint{suite.path.EndpointA, suite.path.EndpointB} {
+		endpoint.ChannelConfig.PortID = ibctesting.TransferPort
+		endpoint.ChannelConfig.Version = transfertypes.Version
+		endpoint.ChannelConfig.Order = channeltypes.UNORDERED
+	}
+	suite.coordinator.Setup(suite.path)
+}
+
+func (suite *MiddlewareTestSuite) appA() *app.EthermintApp {
+	return suite.chainA.App.(*app.EthermintApp)
+}
+
+func (suite *MiddlewareTestSuite) appB() *app.EthermintApp {
+	return suite.chainB.App.(*app.EthermintApp)
+}
+
+// deployContract sets the runtime code compiled from the assembly at a new address of chainB.
+func (suite *MiddlewareTestSuite) deployContract(src string) common.Address {
+	compiler := asm.NewCompiler(false)
+	compiler.Feed(asm.Lex([]byte(src), false))
+	bin, errs := compiler.Compile()
+	suite.Require().Empty(errs)
+	code, err := hex.DecodeString(bin)
+	suite.Require().NoError(err)
+
+	ctx := suite.chainB.GetContext()
+	address := tests.GenerateAddress()
+	db := statedb.New(ctx, suite.appB().EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+	db.SetCode(address, code)
+	suite.Require().NoError(db.Commit())
+	return address
+}
+
+func (suite *MiddlewareTestSuite) slot(contract common.Address, slot int64) common.Hash {
+	return suite.appB().EvmKeeper.GetState(suite.chainB.GetContext(), contract, common.BigToHash(big.NewInt(slot)))
+}
+
+// transfer sends the coin from the sender account of the chain of the endpoint.
+func (suite *MiddlewareTestSuite) transfer(
+	endpoint *ibctesting.Endpoint,
+	coin sdk.Coin,
+	receiver, memo string,
+	timeoutHeight clienttypes.Height,
+) channeltypes.Packet {
+	chain := endpoint.Chain
+	msg := transfertypes.NewMsgTransfer(
+		endpoint.ChannelConfig.PortID, endpoint.ChannelID, coin,
+		chain.SenderAccount.GetAddress().String(), receiver, timeoutHeight, 0, memo,
+	)
+	res, err := chain.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	return packet
+}
+
+// relay receives the packet on the destination endpoint and acknowledges it on the source one.
+func (suite *MiddlewareTestSuite) relay(src, dst *ibctesting.Endpoint, packet channeltypes.Packet) channeltypes.Acknowledgement {
+	suite.Require().NoError(dst.UpdateClient())
+	res, err := dst.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+
+	bz, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().NoError(src.AcknowledgePacket(packet, bz))
+
+	var ack channeltypes.Acknowledgement
+	suite.Require().NoError(transfertypes.ModuleCdc.UnmarshalJSON(bz, &ack))
+	return ack
+}
+
+// transferAToB transfers the coin from chainA to chainB and returns the acknowledgement.
+func (suite *MiddlewareTestSuite) transferAToB(coin sdk.Coin, receiver, memo string) channeltypes.Acknowledgement {
+	timeoutHeight := clienttypes.NewHeight(1, uint64(suite.chainB.GetContext().BlockHeight())+100)
+	packet := suite.transfer(suite.path.EndpointA, coin, receiver, memo, timeoutHeight)
+	return suite.relay(suite.path.EndpointA, suite.path.EndpointB, packet)
+}
+
+func evmMemo(contract common.Address, data string) string {
+	return fmt.Sprintf(`{"evm":{"contract":"%s","data":"%s"}}`, contract.Hex(), data)
+}
+
+// voucherDenom returns the denom of the vouchers of the base denom received on the endpoint.
+func voucherDenom(endpoint *ibctesting.Endpoint, baseDenom string) string {
+	prefixed := transfertypes.GetPrefixedDenom(endpoint.ChannelConfig.PortID, endpoint.ChannelID, baseDenom)
+	return transfertypes.ParseDenomTrace(prefixed).IBCDenom()
+}
+
+func (suite *MiddlewareTestSuite) intermediateSender() common.Address {
+	return types.IntermediateSender(suite.path.EndpointB.ChannelID, suite.chainA.SenderAccount.GetAddress().String())
+}
+
+func (suite *MiddlewareTestSuite) TestRecvWithoutHook() {
+	receiver := suite.chainB.SenderAccount.GetAddress()
+	coin := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+
+	ack := suite.transferAToB(coin, receiver.String(), `{"forward":{}}`)
+	suite.Require().True(ack.Success())
+
+	balance := suite.appB().BankKeeper.GetBalance(suite.chainB.GetContext(), receiver, voucherDenom(suite.path.EndpointB, coin.Denom))
+	suite.Require().Equal(coin.Amount, balance.Amount)
+}
+
+func (suite *MiddlewareTestSuite) TestRecvEVMCall() {
+	recorder := suite.deployContract(recorderAsm)
+	amount := sdk.NewInt(1000)
+
+	// send the EVM denom of chainB to chainA, then back to the contract
+	timeoutHeight := clienttypes.NewHeight(1, uint64(suite.chainA.GetContext().BlockHeight())+100)
+	packet := suite.transfer(
+		suite.path.EndpointB, sdk.NewCoin(sdk.DefaultBondDenom, amount),
+		suite.chainA.SenderAccount.GetAddress().String(), "", timeoutHeight,
+	)
+	suite.Require().True(suite.relay(suite.path.EndpointB, suite.path.EndpointA, packet).Success())
+
+	voucher := sdk.NewCoin(voucherDenom(suite.path.EndpointA, sdk.DefaultBondDenom), amount)
+	ack := suite.transferAToB(voucher, recorder.Hex(), evmMemo(recorder, "0x0102"))
+	suite.Require().True(ack.Success(), ack.GetError())
+
+	var contractAck types.ContractAck
+	suite.Require().NoError(json.Unmarshal(ack.GetResult(), &contractAck))
+	suite.Require().Empty(contractAck.ContractResult)
+	suite.Require().Equal(channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement(), contractAck.IBCAck)
+
+	sender := suite.intermediateSender()
+	suite.Require().Equal(common.BigToHash(big.NewInt(1)), suite.slot(recorder, 0))
+	suite.Require().Equal(common.BytesToHash(sender.Bytes()), suite.slot(recorder, 1))
+	suite.Require().Equal(crypto.Keccak256Hash([]byte{1, 2}), suite.slot(recorder, 3))
+
+	// the coins are sent as the value of the call
+	suite.Require().Equal(common.BigToHash(amount.BigInt()), suite.slot(recorder, 2))
+	balance := suite.appB().EvmKeeper.GetBalance(suite.chainB.GetContext(), recorder.Bytes(), sdk.DefaultBondDenom)
+	suite.Require().Equal(amount.BigInt(), balance)
+}
+
+func (suite *MiddlewareTestSuite) TestRecvEVMCallWithERC20() {
+	recorder := suite.deployContract(recorderAsm)
+	coin := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+	denom := voucherDenom(suite.path.EndpointB, coin.Denom)
+
+	// the vouchers need a supply to be registered
+	suite.Require().True(suite.transferAToB(coin, suite.chainB.SenderAccount.GetAddress().String(), "").Success())
+	res, err := suite.appB().Erc20Keeper.RegisterCoin(sdk.WrapSDKContext(suite.chainB.GetContext()), &erc20types.MsgRegisterCoin{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Metadata: banktypes.Metadata{
+			DenomUnits: []*banktypes.DenomUnit{{Denom: denom}},
+			Base:       denom,
+			Display:    denom,
+			Name:       "Chain A stake",
+			Symbol:     "STAKEA",
+		},
+	})
+	suite.Require().NoError(err)
+	token := res.TokenPair.GetERC20Contract()
+
+	ack := suite.transferAToB(coin, recorder.Hex(), evmMemo(recorder, "0x01"))
+	suite.Require().True(ack.Success(), ack.GetError())
+
+	ctx := suite.chainB.GetContext()
+	sender := suite.intermediateSender()
+	k := suite.appB().Erc20Keeper
+
+	balance, err := k.BalanceOf(ctx, token, sender)
+	suite.Require().NoError(err)
+	suite.Require().Equal(coin.Amount.BigInt(), balance)
+
+	allowanceRes, err := k.CallEVM(ctx, erc20types.ERC20WrapperContract.ABI, sender, token, false, "allowance", sender, recorder)
+	suite.Require().NoError(err)
+	suite.Require().Equal(coin.Amount.BigInt(), new(big.Int).SetBytes(allowanceRes.Ret))
+}
+
+func (suite *MiddlewareTestSuite) TestRecvFailures() {
+	recorder := suite.deployContract(recorderAsm)
+	coin := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+
+	// send the EVM denom of chainB to chainA, so that the calls can be funded
+	timeoutHeight := clienttypes.NewHeight(1, uint64(suite.chainA.GetContext().BlockHeight())+100)
+	packet := suite.transfer(suite.path.EndpointB, coin, suite.chainA.SenderAccount.GetAddress().String(), "", timeoutHeight)
+	suite.Require().True(suite.relay(suite.path.EndpointB, suite.path.EndpointA, packet).Success())
+	voucher := sdk.NewCoin(voucherDenom(suite.path.EndpointA, sdk.DefaultBondDenom), coin.Amount)
+
+	testCases := []struct {
+		name     string
+		coin     sdk.Coin
+		receiver string
+		memo     string
+	}{
+		{"call reverted", voucher, recorder.Hex(), evmMemo(recorder, "0xff")},
+		{"receiver is not the contract", voucher, suite.chainB.SenderAccount.GetAddress().String(), evmMemo(recorder, "0x01")},
+		{"unsupported denom", coin, recorder.Hex(), evmMemo(recorder, "0x01")},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			sender := suite.chainA.SenderAccount.GetAddress()
+			before := suite.appA().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, tc.coin.Denom)
+
+			ack := suite.transferAToB(tc.coin, tc.receiver, tc.memo)
+			suite.Require().False(ack.Success())
+
+			// the transfer is reverted and the tokens are refunded
+			suite.Require().Equal(common.Hash{}, suite.slot(recorder, 0))
+			intermediate := sdk.AccAddress(suite.intermediateSender().Bytes())
+			suite.Require().True(suite.appB().BankKeeper.GetAllBalances(suite.chainB.GetContext(), intermediate).IsZero())
+			suite.Require().Equal(before, suite.appA().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, tc.coin.Denom))
+		})
+	}
+}
+
+func (suite *MiddlewareTestSuite) TestAcknowledgementCallback() {
+	recorder := suite.deployContract(recorderAsm)
+	coin := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+	memo := fmt.Sprintf(`{"ibc_callback":"%s"}`, recorder.Hex())
+
+	timeoutHeight := clienttypes.NewHeight(1, uint64(suite.chainA.GetContext().BlockHeight())+100)
+	packet := suite.transfer(suite.path.EndpointB, coin, suite.chainA.SenderAccount.GetAddress().String(), memo, timeoutHeight)
+
+	suite.Require().NoError(suite.path.EndpointA.UpdateClient())
+	res, err := suite.path.EndpointA.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+	ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.path.EndpointB.AcknowledgePacket(packet, ack))
+
+	sender := common.BytesToAddress(suite.chainB.SenderAccount.GetAddress())
+	data, err := types.CallbackABI.Pack(types.MethodOnAcknowledgement, suite.path.EndpointB.ChannelID, packet.GetSequence(), sender, ack, true)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(common.BigToHash(big.NewInt(1)), suite.slot(recorder, 0))
+	suite.Require().Equal(common.BytesToHash(types.ModuleAddress.Bytes()), suite.slot(recorder, 1))
+	suite.Require().Equal(crypto.Keccak256Hash(data), suite.slot(recorder, 3))
+}
+
+func (suite *MiddlewareTestSuite) TestTimeoutCallback() {
+	recorder := suite.deployContract(recorderAsm)
+	coin := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+	memo := fmt.Sprintf(`{"ibc_callback":"%s"}`, recorder.Hex())
+	sender := suite.chainB.SenderAccount.GetAddress()
+	before := suite.appB().BankKeeper.GetBalance(suite.chainB.GetContext(), sender, coin.Denom)
+
+	timeoutHeight := clienttypes.NewHeight(1, uint64(suite.chainA.GetContext().BlockHeight())+1)
+	packet := suite.transfer(suite.path.EndpointB, coin, suite.chainA.SenderAccount.GetAddress().String(), memo, timeoutHeight)
+
+	suite.coordinator.CommitNBlocks(suite.chainA, 3)
+	suite.Require().NoError(suite.path.EndpointB.UpdateClient())
+	suite.Require().NoError(suite.path.EndpointB.TimeoutPacket(packet))
+
+	data, err := types.CallbackABI.Pack(types.MethodOnTimeout, suite.path.EndpointB.ChannelID, packet.GetSequence(), common.BytesToAddress(sender))
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(common.BigToHash(big.NewInt(1)), suite.slot(recorder, 0))
+	suite.Require().Equal(crypto.Keccak256Hash(data), suite.slot(recorder, 3))
+	suite.Require().Equal(before, suite.appB().BankKeeper.GetBalance(suite.chainB.GetContext(), sender, coin.Denom))
+}
+
+func (suite *MiddlewareTestSuite) TestFailedCallback() {
+	reverter := suite.deployContract(reverterAsm)
+	coin := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+	memo := fmt.Sprintf(`{"ibc_callback":"%s"}`, reverter.Hex())
+
+	timeoutHeight := clienttypes.NewHeight(1, uint64(suite.chainA.GetContext().BlockHeight())+100)
+	packet := suite.transfer(suite.path.EndpointB, coin, suite.chainA.SenderAccount.GetAddress().String(), memo, timeoutHeight)
+
+	// the acknowledgement is processed despite the reverted callback
+	suite.Require().True(suite.relay(suite.path.EndpointB, suite.path.EndpointA, packet).Success())
+	commitment := suite.appB().IBCKeeper.ChannelKeeper.GetPacketCommitment(
+		suite.chainB.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(),
+	)
+	suite.Require().Nil(commitment)
+}
+
+func (suite *MiddlewareTestSuite) TestSendInvalidMemo() {
+	contract := tests.GenerateAddress()
+
+	testCases := []struct {
+		name string
+		memo string
+	}{
+		{"invalid callback", `{"ibc_callback":"contract"}`},
+		{"invalid contract", `{"evm":{"contract":"contract"}}`},
+		{"gas limit too high", fmt.Sprintf(`{"evm":{"contract":"%s","gas_limit":%d}}`, contract.Hex(), types.MaxGasLimit+1)},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			timeoutHeight := clienttypes.NewHeight(1, uint64(suite.chainA.GetContext().BlockHeight())+100)
+			msg := transfertypes.NewMsgTransfer(
+				suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID,
+				sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), suite.chainB.SenderAccount.GetAddress().String(),
+				contract.Hex(), timeoutHeight, 0, tc.memo,
+			)
+
+			_, err := suite.appB().TransferKeeper.Transfer(sdk.WrapSDKContext(suite.chainB.GetContext()), msg)
+			suite.Require().ErrorIs(err, types.ErrInvalidMemo)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package ibchooks
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+
+	"github.com/evmos/ethermint/x/ibchooks/types"
+)
+
+var _ porttypes.ICS4Wrapper = ICS4Middleware{}
+
+// ICS4Middleware wraps the channel keeper used by the transfer keeper to send the packets,
+// rejecting the ICS-20 packets with an invalid hook memo before they leave the chain.
+type ICS4Middleware struct {
+	channel porttypes.ICS4Wrapper
+}
+
+// NewICS4Middleware creates a new ICS4Middleware wrapping the channel keeper.
+func NewICS4Middleware(channel porttypes.ICS4Wrapper) ICS4Middleware {
+	return ICS4Middleware{
+		channel: channel,
+	}
+}
+
+// SendPacket implements the ICS4Wrapper interface
+func (i ICS4Middleware) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (uint64, error) {
+	var packetData transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(data, &packetData); err == nil {
+		if _, err := types.ParseMemo(packetData.Memo); err != nil {
+			return 0, err
+		}
+	}
+
+	return i.channel.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+}
+
+// WriteAcknowledgement implements the ICS4Wrapper interface
+func (i ICS4Middleware) WriteAcknowledgement(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet exported.PacketI,
+	ack exported.Acknowledgement,
+) error {
+	return i.channel.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// GetAppVersion implements the ICS4Wrapper interface
+func (i ICS4Middleware) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return i.channel.GetAppVersion(ctx, portID, channelID)
+}
@@ -0,0 +1,74 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+const (
+	// MethodOnAcknowledgement is the callback method called when a sent packet is acknowledged.
+	MethodOnAcknowledgement = "onIBCAcknowledgement"
+
+	// MethodOnTimeout is the callback method called when a sent packet times out.
+	MethodOnTimeout = "onIBCTimeout"
+)
+
+const callbackABIJSON = `[
+	{
+		"type": "function",
+		"name": "onIBCAcknowledgement",
+		"stateMutability": "nonpayable",
+		"inputs": [
+			{"name": "channel", "type": "string"},
+			{"name": "sequence", "type": "uint64"},
+			{"name": "sender", "type": "address"},
+			{"name": "acknowledgement", "type": "bytes"},
+			{"name": "success", "type": "bool"}
+		],
+		"outputs": []
+	},
+	{
+		"type": "function",
+		"name": "onIBCTimeout",
+		"stateMutability": "nonpayable",
+		"inputs": [
+			{"name": "channel", "type": "string"},
+			{"name": "sequence", "type": "uint64"},
+			{"name": "sender", "type": "address"}
+		],
+		"outputs": []
+	}
+]`
+
+// CallbackABI is the interface implemented by the callback contracts.
+var CallbackABI abi.ABI
+
+func init() {
+	var err error
+	CallbackABI, err = abi.JSON(strings.NewReader(callbackABIJSON))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// ContractAck is the result of a successful acknowledgement of a hooked packet.
+type ContractAck struct {
+	ContractResult []byte `json:"contract_result"`
+	IBCAck         []byte `json:"ibc_ack"`
+}
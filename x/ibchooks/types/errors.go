@@ -0,0 +1,41 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+const (
+	codeErrInvalidMemo = uint32(iota) + 2 // NOTE: code 1 is reserved for internal errors
+	codeErrInvalidReceiver
+	codeErrEVMCall
+	codeErrUnsupportedDenom
+)
+
+var (
+	// ErrInvalidMemo returns an error if the memo of a packet addressed to the middleware is invalid.
+	ErrInvalidMemo = errorsmod.Register(ModuleName, codeErrInvalidMemo, "invalid ibc hook memo")
+
+	// ErrInvalidReceiver returns an error if the receiver of a packet isn't the called contract.
+	ErrInvalidReceiver = errorsmod.Register(ModuleName, codeErrInvalidReceiver, "packet receiver must be the called contract")
+
+	// ErrEVMCall returns an error if the EVM call of a packet failed.
+	ErrEVMCall = errorsmod.Register(ModuleName, codeErrEVMCall, "evm call failed")
+
+	// ErrUnsupportedDenom returns an error if the coins of a packet can't fund the EVM call.
+	ErrUnsupportedDenom = errorsmod.Register(ModuleName, codeErrUnsupportedDenom, "denom can't fund the evm call")
+)
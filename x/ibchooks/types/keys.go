@@ -0,0 +1,52 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/address"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// ModuleName string name of module
+	ModuleName = "ibchooks"
+
+	// senderPrefix is the derivation prefix of the intermediate senders
+	senderPrefix = ModuleName + "/sender"
+)
+
+const (
+	// DefaultGasLimit is the gas limit of the EVM calls of the memos which don't specify one.
+	DefaultGasLimit = 300_000
+
+	// MaxGasLimit is the maximum gas limit of the EVM calls of the memos.
+	MaxGasLimit = 3_000_000
+
+	// CallbackGasLimit is the gas limit of the acknowledgement and timeout callbacks.
+	CallbackGasLimit = 500_000
+)
+
+// ModuleAddress is the ethereum address calling the acknowledgement and timeout callbacks, which
+// the callback contracts can check to authenticate the calls.
+var ModuleAddress = common.BytesToAddress(authtypes.NewModuleAddress(ModuleName))
+
+// IntermediateSender returns the address executing the EVM call of a packet received on the
+// channel, derived from the channel and the sender on the counterparty chain. No local key
+// controls it and distinct remote senders never share it.
+func IntermediateSender(channel, originalSender string) common.Address {
+	return common.BytesToAddress(address.Hash(senderPrefix, []byte(channel+"/"+originalSender)))
+}
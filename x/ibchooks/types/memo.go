@@ -0,0 +1,144 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"encoding/json"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const (
+	// MemoKeyEVM is the memo key of the EVM call executed when a packet is received.
+	MemoKeyEVM = "evm"
+
+	// MemoKeyCallback is the memo key of the contract called back when a sent packet is
+	// acknowledged or times out.
+	MemoKeyCallback = "ibc_callback"
+)
+
+// Memo is the part of an ICS-20 memo read by the middleware, for instance:
+//
+//	{"evm": {"contract": "0x...", "data": "0x...", "gas_limit": 500000}, "ibc_callback": "0x..."}
+type Memo struct {
+	EVM         *EVMCall `json:"evm,omitempty"`
+	IBCCallback string   `json:"ibc_callback,omitempty"`
+}
+
+// EVMCall is the EVM call executed with the tokens of a received packet.
+type EVMCall struct {
+	Contract string        `json:"contract"`
+	Data     hexutil.Bytes `json:"data,omitempty"`
+	GasLimit uint64        `json:"gas_limit,omitempty"`
+}
+
+// ParseMemo parses the memo of an ICS-20 packet. It returns nil if the memo is not a JSON object
+// or doesn't contain any of the middleware keys, in which case the packet is not hooked.
+func ParseMemo(memo string) (*Memo, error) {
+	if !strings.HasPrefix(strings.TrimSpace(memo), "{") {
+		return nil, nil
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &fields); err != nil {
+		return nil, nil
+	}
+
+	_, hasEVM := fields[MemoKeyEVM]
+	_, hasCallback := fields[MemoKeyCallback]
+	if !hasEVM && !hasCallback {
+		return nil, nil
+	}
+
+	var m Memo
+	if err := json.Unmarshal([]byte(memo), &m); err != nil {
+		return nil, errorsmod.Wrap(ErrInvalidMemo, err.Error())
+	}
+
+	if hasEVM && m.EVM == nil {
+		return nil, errorsmod.Wrapf(ErrInvalidMemo, "%s must be an object", MemoKeyEVM)
+	}
+
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+// Validate performs a stateless validation of the memo.
+func (m Memo) Validate() error {
+	if m.EVM != nil {
+		if err := m.EVM.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if m.IBCCallback != "" {
+		if err := validateContract(m.IBCCallback); err != nil {
+			return errorsmod.Wrapf(err, "invalid %s", MemoKeyCallback)
+		}
+	}
+
+	return nil
+}
+
+// Validate performs a stateless validation of the EVM call.
+func (c EVMCall) Validate() error {
+	if err := validateContract(c.Contract); err != nil {
+		return errorsmod.Wrap(err, "invalid evm contract")
+	}
+
+	if c.GasLimit > MaxGasLimit {
+		return errorsmod.Wrapf(ErrInvalidMemo, "gas limit %d exceeds the maximum %d", c.GasLimit, MaxGasLimit)
+	}
+
+	return nil
+}
+
+// ContractAddress returns the address of the called contract.
+func (c EVMCall) ContractAddress() common.Address {
+	return common.HexToAddress(c.Contract)
+}
+
+// GetGasLimit returns the gas limit of the call, defaulting to DefaultGasLimit.
+func (c EVMCall) GetGasLimit() uint64 {
+	if c.GasLimit == 0 {
+		return DefaultGasLimit
+	}
+	return c.GasLimit
+}
+
+// CallbackAddress returns the address of the callback contract, if any.
+func (m Memo) CallbackAddress() (common.Address, bool) {
+	if m.IBCCallback == "" {
+		return common.Address{}, false
+	}
+	return common.HexToAddress(m.IBCCallback), true
+}
+
+func validateContract(contract string) error {
+	if !common.IsHexAddress(contract) {
+		return errorsmod.Wrapf(ErrInvalidMemo, "%s is not a hex address", contract)
+	}
+	if common.HexToAddress(contract) == (common.Address{}) {
+		return errorsmod.Wrap(ErrInvalidMemo, "contract is the zero address")
+	}
+	return nil
+}
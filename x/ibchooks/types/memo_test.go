@@ -0,0 +1,58 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMemo(t *testing.T) {
+	contract := "0x5a443704dd4B594B382c22a083e2BD3090A6feF3"
+
+	testCases := []struct {
+		name     string
+		memo     string
+		expHook  bool
+		expPass  bool
+		gasLimit uint64
+	}{
+		{"empty", "", false, true, 0},
+		{"text", "hello", false, true, 0},
+		{"invalid json", `{"evm":`, false, true, 0},
+		{"other keys", `{"forward":{"receiver":"cosmos1"}}`, false, true, 0},
+		{"evm call", `{"evm":{"contract":"` + contract + `","data":"0x01"}}`, true, true, DefaultGasLimit},
+		{"evm call with gas limit", `{"evm":{"contract":"` + contract + `","gas_limit":1000000}}`, true, true, 1_000_000},
+		{"callback", `{"ibc_callback":"` + contract + `"}`, true, true, 0},
+		{"null evm call", `{"evm":null}`, false, false, 0},
+		{"invalid evm call", `{"evm":"call"}`, false, false, 0},
+		{"invalid contract", `{"evm":{"contract":"contract"}}`, false, false, 0},
+		{"zero contract", `{"evm":{"contract":"0x0000000000000000000000000000000000000000"}}`, false, false, 0},
+		{"invalid data", `{"evm":{"contract":"` + contract + `","data":"01"}}`, false, false, 0},
+		{"gas limit too high", `{"evm":{"contract":"` + contract + `","gas_limit":3000001}}`, false, false, 0},
+		{"invalid callback", `{"ibc_callback":"contract"}`, false, false, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			memo, err := ParseMemo(tc.memo)
+			if !tc.expPass {
+				require.ErrorIs(t, err, ErrInvalidMemo)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expHook, memo != nil)
+			if memo != nil && memo.EVM != nil {
+				require.Equal(t, contract, memo.EVM.ContractAddress().Hex())
+				require.Equal(t, tc.gasLimit, memo.EVM.GetGasLimit())
+			}
+		})
+	}
+}
+
+func TestIntermediateSender(t *testing.T) {
+	sender := IntermediateSender("channel-0", "cosmos1sender")
+	require.Equal(t, sender, IntermediateSender("channel-0", "cosmos1sender"))
+	require.NotEqual(t, sender, IntermediateSender("channel-1", "cosmos1sender"))
+	require.NotEqual(t, sender, IntermediateSender("channel-0", "cosmos1other"))
+}